@@ -6,6 +6,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/meschbach/mcp-vikunja/internal/config"
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/spf13/cobra"
 )
@@ -53,7 +54,7 @@ func runHealth(cmd *cobra.Command, _ []string) error {
 	defer cancel()
 
 	cmd.Printf("Fetching projects...\n")
-	projects, err := vikunjaClient.GetProjects(ctx)
+	projects, err := vikunjaClient.GetProjects(ctx, false)
 	if err != nil {
 		return fmt.Errorf("failed to connect to Vikunja: %w", err)
 	}
@@ -89,10 +90,13 @@ func resolveVikunjaHost(cmd *cobra.Command) (string, error) {
 func resolveVikunjaToken(cmd *cobra.Command) (string, error) {
 	token := cmd.Flag("vikunja-token").Value.String()
 	if token == "" {
-		token = os.Getenv("VIKUNJA_TOKEN")
+		var err error
+		if token, err = config.LoadVikunjaToken(); err != nil {
+			return "", err
+		}
 	}
 	if token == "" {
-		return "", fmt.Errorf("vikunja token is required (use --vikunja-token or VIKUNJA_TOKEN)")
+		return "", fmt.Errorf("vikunja token is required (use --vikunja-token, VIKUNJA_TOKEN or VIKUNJA_TOKEN_FILE)")
 	}
 	return token, nil
 }
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/internal/logging"
+	"github.com/spf13/cobra"
+)
+
+// transportLogConfig loads the logging configuration for a server on the given transport.
+// The stdio transport carries MCP messages on stdout, so its logs go to stderr instead.
+func transportLogConfig(cmd *cobra.Command, transport config.TransportType) logging.Config {
+	logConfig := logging.LoadConfig()
+	if verbose, err := cmd.Flags().GetBool("verbose"); err == nil && verbose {
+		logConfig.Level = logging.LevelDebug
+	}
+	if transport == config.TransportStdio {
+		logConfig = logConfig.ForStdio()
+	}
+	return logConfig
+}
+
+// newTransportLogger builds the logger for a server on the given transport
+func newTransportLogger(cmd *cobra.Command, transport config.TransportType) (*slog.Logger, error) {
+	logConfig := transportLogConfig(cmd, transport)
+	if err := logConfig.Validate(); err != nil {
+		return nil, err
+	}
+	logger, err := logging.NewLogger(logConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize logger: %w", err)
+	}
+	return logger, nil
+}
+
+// initLogging builds the server logger, makes it the default for tool handlers and tags it with component
+func initLogging(cmd *cobra.Command, transport config.TransportType, component string) (*slog.Logger, error) {
+	logger, err := newTransportLogger(cmd, transport)
+	if err != nil {
+		return nil, err
+	}
+	slog.SetDefault(logger)
+	return logging.WithComponent(logger, component), nil
+}
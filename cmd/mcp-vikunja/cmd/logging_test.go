@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/internal/logging"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLoggingTestCommand(verbose bool) *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().Bool("verbose", verbose, "")
+	return cmd
+}
+
+func TestTransportLogConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport config.TransportType
+		logOutput string
+		want      string
+	}{
+		{name: "stdio default", transport: config.TransportStdio, want: "stderr"},
+		{name: "stdio explicit stdout", transport: config.TransportStdio, logOutput: "stdout", want: "stderr"},
+		{name: "stdio file", transport: config.TransportStdio, logOutput: "/var/log/mcp-vikunja.log", want: "/var/log/mcp-vikunja.log"},
+		{name: "http default", transport: config.TransportHTTP, want: "stdout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LOG_OUTPUT", tt.logOutput)
+			logConfig := transportLogConfig(newLoggingTestCommand(true), tt.transport)
+			assert.Equal(t, tt.want, logConfig.Output)
+			assert.Equal(t, logging.LevelDebug, logConfig.Level)
+		})
+	}
+}
+
+func TestNewTransportLogger_StdioWritesToStderr(t *testing.T) {
+	stdout, stderr := redirectStdStreams(t)
+
+	logger, err := newTransportLogger(newLoggingTestCommand(false), config.TransportStdio)
+	require.NoError(t, err)
+	logger.Info("hello from stdio")
+
+	assert.Contains(t, stderr(), "hello from stdio")
+	assert.Empty(t, stdout(), "stdout carries the MCP protocol and must stay free of log lines")
+}
+
+func TestNewTransportLogger_InvalidLevel(t *testing.T) {
+	t.Setenv("MCP_LOG_LEVEL", "verbose")
+
+	_, err := newTransportLogger(newLoggingTestCommand(false), config.TransportStdio)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid log level")
+}
+
+// redirectStdStreams points os.Stdout and os.Stderr at pipes for the rest of the test.
+// The returned functions restore the streams and return what was written to each.
+func redirectStdStreams(t *testing.T) (stdout, stderr func() string) {
+	t.Helper()
+	return redirectStream(t, &os.Stdout), redirectStream(t, &os.Stderr)
+}
+
+func redirectStream(t *testing.T, stream **os.File) func() string {
+	t.Helper()
+	original := *stream
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	*stream = w
+	t.Cleanup(func() { *stream = original })
+
+	return func() string {
+		*stream = original
+		require.NoError(t, w.Close())
+		var buf bytes.Buffer
+		_, err := buf.ReadFrom(r)
+		require.NoError(t, err)
+		return buf.String()
+	}
+}
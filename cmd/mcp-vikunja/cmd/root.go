@@ -81,6 +81,6 @@ func init() {
 	rootCmd.PersistentFlags().String("vikunja-host", "", "Vikunja instance URL (env: VIKUNJA_HOST)")
 	rootCmd.PersistentFlags().String("vikunja-token", "", "Vikunja API token (env: VIKUNJA_TOKEN)")
 	rootCmd.PersistentFlags().Bool("verbose", false, "Enable verbose logging")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output-format", "o", "", "Output format: json (legacy), markdown (default), both (CLI overrides VIKUNJA_OUTPUT_FORMAT)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output-format", "o", "", "Output format: json (legacy), markdown (default), both, both-json, jsonl (CLI overrides VIKUNJA_OUTPUT_FORMAT)")
 	rootCmd.PersistentFlags().BoolVar(&readonly, "readonly", false, "Enable readonly mode to prevent write operations (env: MCP_READONLY)")
 }
@@ -12,8 +12,9 @@ import (
 	"github.com/meschbach/mcp-vikunja/internal/config"
 	"github.com/meschbach/mcp-vikunja/internal/handlers"
 	"github.com/meschbach/mcp-vikunja/internal/health"
-	"github.com/meschbach/mcp-vikunja/internal/logging"
+	"github.com/meschbach/mcp-vikunja/internal/metrics"
 	"github.com/meschbach/mcp-vikunja/internal/transport"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/spf13/cobra"
 )
@@ -68,16 +69,20 @@ func runServer(cmd *cobra.Command, _ []string) error {
 	ctx, cancel := setupShutdownHandler(logger)
 	defer cancel()
 
+	if err := checkTokenOnStart(ctx, cfg, logger); err != nil {
+		return err
+	}
+
 	return runServerTransport(ctx, cfg, logger)
 }
 
 func initServer(cmd *cobra.Command) (*slog.Logger, *config.Config, error) {
-	logger, err := initServerLogging(cmd)
+	cfg, err := initServerConfig()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cfg, err := initServerConfig()
+	logger, err := initLogging(cmd, cfg.Transport, "server")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,8 +143,18 @@ view, and buckets as you need.
 		},
 	)
 
+	// Collect metrics when they are enabled
+	var registry *metrics.Registry
+	if cfg.HTTP.MetricsEnabled {
+		registry = metrics.New()
+	}
+
 	// Register Vikunja tool handlers
-	handlers.Register(s, cfg)
+	toolHandlers, err := handlers.RegisterWithMetrics(s, cfg, registry)
+	if err != nil {
+		return fmt.Errorf("failed to register tools: %w", err)
+	}
+	reloadTokenOnHangup(ctx, toolHandlers)
 
 	// Create transport server
 	transportServer, err := transport.CreateTransportServer(s, cfg)
@@ -147,17 +162,10 @@ view, and buckets as you need.
 		return fmt.Errorf("failed to create transport server: %w", err)
 	}
 
-	// Setup health checks for HTTP transport
-	if cfg.Transport == config.TransportHTTP {
-		hc := health.New()
-		hc.Register(&health.ServerCheck{})
-
-		// Try to set health checker on HTTP server
-		if httpServer, ok := transportServer.(*transport.HTTPServer); ok {
-			httpServer.SetHealthChecker(hc)
-			logger.Info("health check endpoints registered",
-				"endpoints", []string{"/health", "/health/live", "/health/ready"},
-			)
+	// Setup health checks and metrics for HTTP transport
+	if httpServer, ok := transportServer.(*transport.HTTPServer); ok {
+		if err := registerHTTPEndpoints(httpServer, cfg, registry, logger); err != nil {
+			return err
 		}
 	}
 
@@ -174,16 +182,38 @@ view, and buckets as you need.
 	return nil
 }
 
-func initServerLogging(cmd *cobra.Command) (*slog.Logger, error) {
-	logConfig := logging.LoadConfig()
-	if verbose, err := cmd.Flags().GetBool("verbose"); err == nil && verbose {
-		logConfig.Level = logging.LevelDebug
-	}
-	logger, err := logging.NewLogger(logConfig)
+// reloadTokenOnHangup reloads the Vikunja token each time the process receives SIGHUP, so a
+// rotated token takes effect without a restart
+func reloadTokenOnHangup(ctx context.Context, h *handlers.Handlers) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hangups)
+		h.ReloadTokenOn(ctx, hangups)
+	}()
+}
+
+// registerHTTPEndpoints adds the health check endpoints, and the metrics endpoint when
+// registry is not nil, to the HTTP server. Readiness requires Vikunja to be reachable.
+func registerHTTPEndpoints(httpServer *transport.HTTPServer, cfg *config.Config, registry *metrics.Registry, logger *slog.Logger) error {
+	client, err := vikunja.NewClientWithOptions(cfg.Vikunja.Host, cfg.Vikunja.Token, cfg.Vikunja.Insecure, cfg.Vikunja.ClientOptions())
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize logger: %w", err)
+		return fmt.Errorf("failed to create readiness client: %w", err)
 	}
-	return logging.WithComponent(logger, "server"), nil
+
+	hc := health.New()
+	hc.Register(&health.ServerCheck{})
+	hc.Register(health.NewCachedCheck(health.NewVikunjaInfoCheck(client), health.DefaultReadinessCacheTTL))
+	httpServer.SetHealthChecker(hc)
+	logger.Info("health check endpoints registered",
+		"endpoints", []string{"/health", "/health/live", "/health/ready", "/healthz", "/readyz"},
+	)
+
+	if registry != nil {
+		httpServer.SetMetrics(registry)
+		logger.Info("metrics endpoint registered", "endpoint", "/metrics")
+	}
+	return nil
 }
 
 func initServerConfig() (*config.Config, error) {
@@ -9,7 +9,6 @@ import (
 
 	"github.com/meschbach/mcp-vikunja/internal/config"
 	"github.com/meschbach/mcp-vikunja/internal/handlers"
-	"github.com/meschbach/mcp-vikunja/internal/logging"
 	"github.com/meschbach/mcp-vikunja/internal/transport"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/spf13/cobra"
@@ -38,17 +37,6 @@ func init() {
 }
 
 func runStdio(cmd *cobra.Command, _ []string) error {
-	// Setup logging
-	logConfig := logging.LoadConfig()
-	if verbose, err := cmd.Flags().GetBool("verbose"); err == nil && verbose {
-		logConfig.Level = logging.LevelDebug
-	}
-	logger, err := logging.NewLogger(logConfig)
-	if err != nil {
-		return fmt.Errorf("failed to initialize logger: %w", err)
-	}
-	logger = logging.WithComponent(logger, "stdio")
-
 	// Get output format from CLI flag
 	var cliFormat *string
 	if format := cmd.Flag("output-format").Value.String(); format != "" {
@@ -69,6 +57,12 @@ func runStdio(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("configuration validation failed: %w", err)
 	}
 
+	// Setup logging once the transport is known, so stdio never logs onto the protocol stream
+	logger, err := initLogging(cmd, cfg.Transport, "stdio")
+	if err != nil {
+		return err
+	}
+
 	// Setup context for graceful shutdown
 	//nolint
 	ctx, cancel := context.WithCancel(context.Background())
@@ -89,6 +83,10 @@ func runStdio(cmd *cobra.Command, _ []string) error {
 		"version", "0.1.0",
 	)
 
+	if err := checkTokenOnStart(ctx, cfg, logger); err != nil {
+		return err
+	}
+
 	// Create MCP server
 	s := mcp.NewServer(
 		&mcp.Implementation{
@@ -99,7 +97,9 @@ func runStdio(cmd *cobra.Command, _ []string) error {
 	)
 
 	// Register Vikunja tool handlers
-	handlers.Register(s, cfg)
+	if err := handlers.Register(s, cfg); err != nil {
+		return fmt.Errorf("failed to register tools: %w", err)
+	}
 
 	// Create transport server
 	transportServer, err := transport.CreateTransportServer(s, cfg)
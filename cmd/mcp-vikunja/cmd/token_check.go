@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// tokenCheckTimeout bounds the start-up request checking the Vikunja token
+const tokenCheckTimeout = 10 * time.Second
+
+// checkTokenOnStart reads the current user to confirm the configured token authenticates, so a bad
+// token surfaces at start-up instead of on the first tool call. Over HTTP a failed check refuses
+// to start; over stdio it only warns, as clients may spawn the server long before using it.
+func checkTokenOnStart(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
+	if !cfg.ValidatesTokenOnStart() {
+		return nil
+	}
+
+	err := authenticate(ctx, cfg)
+	if err == nil {
+		logger.Debug("Vikunja token authenticated", "vikunja_host", cfg.Vikunja.Host)
+		return nil
+	}
+
+	if cfg.Transport == config.TransportHTTP {
+		logger.Error("Vikunja token check failed, refusing to start", "vikunja_host", cfg.Vikunja.Host, "error", err)
+		return fmt.Errorf("vikunja token check failed (set MCP_VALIDATE_TOKEN_ON_START=false to skip it): %w", err)
+	}
+	logger.Warn("Vikunja token check failed, tool calls will fail until it is fixed", "vikunja_host", cfg.Vikunja.Host, "error", err)
+	return nil
+}
+
+// authenticate makes one authenticated request to Vikunja with the configured token
+func authenticate(ctx context.Context, cfg *config.Config) error {
+	client, err := vikunja.NewClientWithOptions(cfg.Vikunja.Host, cfg.Vikunja.Token, cfg.Vikunja.Insecure, cfg.Vikunja.ClientOptions())
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenCheckTimeout)
+	defer cancel()
+	_, err = client.GetCurrentUser(ctx)
+	return err
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenCheckConfig points a configuration for transport at a Vikunja server rejecting every token
+func tokenCheckConfig(t *testing.T, transport config.TransportType, requests *int) *config.Config {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		assert.Equal(t, "/api/v1/user", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"code":11,"message":"invalid token"}`) //nolint:errcheck
+	}))
+	t.Cleanup(ts.Close)
+	return &config.Config{Transport: transport, Vikunja: config.VikunjaConfig{Host: ts.URL, Token: "stale"}}
+}
+
+func TestCheckTokenOnStart_RejectedTokenStopsHTTP(t *testing.T) {
+	t.Parallel()
+	var requests int
+	cfg := tokenCheckConfig(t, config.TransportHTTP, &requests)
+
+	err := checkTokenOnStart(t.Context(), cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vikunja token check failed")
+	assert.Equal(t, 1, requests)
+}
+
+func TestCheckTokenOnStart_Stdio(t *testing.T) {
+	t.Parallel()
+	enabled := true
+	tests := []struct {
+		name         string
+		validate     *bool
+		wantRequests int
+	}{
+		{"skipped by default", nil, 0},
+		{"warns when enabled", &enabled, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var requests int
+			cfg := tokenCheckConfig(t, config.TransportStdio, &requests)
+			cfg.ValidateTokenOnStart = tt.validate
+
+			require.NoError(t, checkTokenOnStart(t.Context(), cfg, slog.New(slog.NewTextHandler(io.Discard, nil))))
+			assert.Equal(t, tt.wantRequests, requests)
+		})
+	}
+}
@@ -48,7 +48,7 @@ func runBucketsList(ctx context.Context, args []string) error {
 		return fmt.Errorf("invalid project ID: %s (must be a number)", args[0])
 	}
 
-	formatter := vikunja.NewFormatter(!noColor, outputWriter)
+	formatter := vikunja.NewFormatter(useColor(), outputWriter)
 
 	if len(args) == 1 {
 		return listViews(ctx, projectID, formatter)
@@ -99,7 +99,7 @@ func runBucketsTasks(ctx context.Context, args []string) error {
 		return err
 	}
 
-	formatter := vikunja.NewFormatter(!noColor, outputWriter)
+	formatter := vikunja.NewFormatter(useColor(), outputWriter)
 
 	logger.Debug("fetching view details", "projectID", projectID, "viewID", viewID)
 	views, err := client.GetProjectViews(ctx, projectID)
@@ -156,8 +156,9 @@ func buildViewTasks(ctx context.Context, projectID, viewID int64, currentView *v
 
 	for _, b := range buckets {
 		vt.Buckets = append(vt.Buckets, vikunja.BucketTasks{
-			Bucket: *b,
-			Tasks:  b.Tasks,
+			Bucket:       *b,
+			Tasks:        b.Tasks,
+			IsDoneBucket: b.ID == currentView.DoneBucketID,
 		})
 	}
 	return vt, nil
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// outputFormatNames are the values vikunja-cli accepts for --output-format
+var outputFormatNames = []string{"json", "markdown"}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
+
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate a shell completion script",
+	Long: `Generate a completion script for vikunja-cli in the given shell. For example:
+
+  source <(vikunja-cli completion bash)
+  vikunja-cli completion zsh > "${fpath[1]}/_vikunja-cli"
+  vikunja-cli completion fish > ~/.config/fish/completions/vikunja-cli.fish
+  vikunja-cli completion powershell | Out-String | Invoke-Expression`,
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	DisableFlagsInUseLine: true,
+	// Generating a script needs no Vikunja connection, so skip the root command's setup
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error { return nil },
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return writeCompletion(cmd.Root(), args[0])
+	},
+}
+
+func writeCompletion(root *cobra.Command, shell string) error {
+	switch shell {
+	case "bash":
+		return root.GenBashCompletionV2(outputWriter, true)
+	case "zsh":
+		return root.GenZshCompletion(outputWriter)
+	case "fish":
+		return root.GenFishCompletion(outputWriter, true)
+	case "powershell":
+		return root.GenPowerShellCompletionWithDesc(outputWriter)
+	default:
+		return fmt.Errorf("unsupported shell: %s", shell)
+	}
+}
+
+func completeOutputFormat(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return outputFormatNames, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletionCmd_Structure(t *testing.T) {
+	cmd, _, err := rootCmd.Find([]string{"completion"})
+	require.NoError(t, err)
+	assert.Equal(t, completionCmd, cmd)
+	assert.ElementsMatch(t, []string{"bash", "zsh", "fish", "powershell"}, cmd.ValidArgs)
+}
+
+func TestCompletionCmd_GeneratesScriptWithoutConnection(t *testing.T) {
+	for _, shell := range completionCmd.ValidArgs {
+		t.Run(shell, func(t *testing.T) {
+			saveAndResetGlobals()
+			defer resetGlobals()
+
+			buf := &bytes.Buffer{}
+			outputWriter = buf
+
+			// No --host or --token: generating a script must not require them
+			rootCmd.SetArgs([]string{"completion", shell})
+			require.NoError(t, rootCmd.Execute())
+			assert.Contains(t, buf.String(), "vikunja-cli")
+		})
+	}
+}
+
+func TestCompletionCmd_RejectsUnknownShell(t *testing.T) {
+	rootCmd.SetArgs([]string{"completion", "tcsh"})
+	require.Error(t, rootCmd.Execute())
+}
+
+func TestOutputFormatFlag_Completion(t *testing.T) {
+	saveAndResetGlobals()
+	defer resetGlobals()
+
+	complete, ok := rootCmd.GetFlagCompletionFunc("output-format")
+	require.True(t, ok, "--output-format must have a registered completion function")
+
+	formats, directive := complete(rootCmd, nil, "")
+	assert.Equal(t, []string{"json", "markdown"}, formats)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	for _, format := range formats {
+		outputFormat = format
+		assert.NoError(t, applyOutputFormat(), "completed value %q must be accepted", format)
+	}
+}
@@ -41,13 +41,13 @@ func getByID(ctx context.Context, arg, itemType string, fetch itemFetcher, isPro
 		return printNotFound(itemType, id)
 	}
 
-	formatter := vikunja.NewFormatter(!noColor, outputWriter)
+	formatter := vikunja.NewFormatter(useColor(), outputWriter)
 	return formatItem(formatter, item, isProject)
 }
 
 func printNotFound(itemType string, id int64) error {
 	msg := fmt.Sprintf("%s not found: %d\n", capitalize(itemType), id)
-	if !noColor {
+	if useColor() {
 		color.Red(msg)
 		return nil
 	}
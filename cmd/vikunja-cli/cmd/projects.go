@@ -35,12 +35,12 @@ var projectsGetCmd = newGetCmd("project", func(ctx context.Context, id int64) (i
 
 func listProjects(ctx context.Context) error {
 	logger.Debug("listing projects")
-	projects, err := client.GetProjects(ctx)
+	projects, err := client.GetProjects(ctx, false)
 	if err != nil {
 		return fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	formatter := vikunja.NewFormatter(!noColor, outputWriter)
+	formatter := vikunja.NewFormatter(useColor(), outputWriter)
 
 	if jsonFmt {
 		return formatter.FormatProjectsAsJSON(projects)
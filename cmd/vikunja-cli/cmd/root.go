@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 
 	"github.com/fatih/color"
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +23,8 @@ var (
 	output   string
 	verbose  bool
 	noColor  bool
+
+	outputFormat string
 )
 
 var (
@@ -49,6 +53,9 @@ var rootCmd = &cobra.Command{
 		}
 
 		// Validate format flags
+		if err := applyOutputFormat(); err != nil {
+			return err
+		}
 		if jsonFmt && markdown {
 			return fmt.Errorf("cannot specify both --json and --markdown flags")
 		}
@@ -82,6 +89,27 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// applyOutputFormat lets --output-format override --json and --markdown for this invocation
+func applyOutputFormat() error {
+	if outputFormat == "" {
+		return nil
+	}
+	format, err := config.ParseOutputFormat(outputFormat)
+	if err != nil {
+		return fmt.Errorf("invalid --output-format value: %w", err)
+	}
+
+	switch format {
+	case vikunja.OutputFormatJSON:
+		jsonFmt, markdown = true, false
+	case vikunja.OutputFormatMarkdown:
+		jsonFmt, markdown = false, true
+	default:
+		return fmt.Errorf("output format %q is not supported by vikunja-cli (use 'json' or 'markdown')", outputFormat)
+	}
+	return nil
+}
+
 // Execute runs the root command of the CLI.
 func Execute() error {
 	return rootCmd.Execute()
@@ -92,6 +120,23 @@ func GetClient() *vikunja.Client {
 	return client
 }
 
+// newResolver resolves projects, views and buckets through the client with the settings the MCP
+// server reads from the environment
+func newResolver() (*resolution.Resolver, error) {
+	resolver, err := config.LoadResolver()
+	if err != nil {
+		return nil, err
+	}
+	resolver.Client = client
+	return resolver, nil
+}
+
+// useColor reports whether output should be colorized, honoring --no-color, NO_COLOR and
+// whether the output writer is a terminal
+func useColor() bool {
+	return !noColor && vikunja.ShouldUseColor(outputWriter)
+}
+
 // GetOutputWriter returns the output writer (stdout or file).
 func GetOutputWriter() io.Writer {
 	return outputWriter
@@ -122,6 +167,9 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&token, "token", "t", token, "API token for authentication (or VIKUNJA_TOKEN env)")
 	rootCmd.PersistentFlags().BoolVarP(&jsonFmt, "json", "j", false, "Output as JSON")
 	rootCmd.PersistentFlags().BoolVarP(&markdown, "markdown", "m", false, "Output as Markdown")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output-format", "", "Output format for this invocation: json or markdown (overrides --json and --markdown)")
+	// Registration only fails for an unknown flag, which the line above rules out
+	_ = rootCmd.RegisterFlagCompletionFunc("output-format", completeOutputFormat)
 	rootCmd.PersistentFlags().BoolVarP(&insecure, "insecure", "k", false, "Skip TLS certificate verification")
 	rootCmd.PersistentFlags().StringVarP(&output, "output", "o", "", "Write output to file instead of stdout")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose logging to stderr")
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCmd_OutputFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		check  func(t *testing.T, output string)
+	}{
+		{
+			name:   "json",
+			format: "json",
+			check: func(t *testing.T, output string) {
+				var task map[string]interface{}
+				require.NoError(t, json.Unmarshal([]byte(output), &task))
+				assert.Equal(t, "Format Task", task["title"])
+			},
+		},
+		{
+			name:   "markdown alias",
+			format: "MD",
+			check: func(t *testing.T, output string) {
+				assert.Contains(t, output, "# Format Task")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saveAndResetGlobals()
+			defer resetGlobals()
+
+			buf := &bytes.Buffer{}
+			outputWriter = buf
+			logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			ts := setupOutputTestServer(t, &outputTestCase{
+				taskID:    400,
+				taskTitle: "Format Task",
+				projectID: 1,
+				bucketIDs: []int64{},
+			})
+			defer ts.Close()
+
+			rootCmd.SetArgs([]string{
+				"--host", ts.URL[7:],
+				"--token", "dummy",
+				"--insecure",
+				"--output-format", tt.format,
+				"tasks", "create", "Format Task",
+			})
+			require.NoError(t, rootCmd.Execute())
+			tt.check(t, buf.String())
+		})
+	}
+}
+
+func TestRootCmd_OutputFormatInvalid(t *testing.T) {
+	saveAndResetGlobals()
+	defer resetGlobals()
+	logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	rootCmd.SetArgs([]string{
+		"--host", "example.invalid",
+		"--token", "dummy",
+		"--output-format", "yaml",
+		"tasks", "create", "Never Created",
+	})
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --output-format value: invalid output format: yaml (must be 'json', 'markdown', 'both', 'both-json' or 'jsonl')")
+}
@@ -9,7 +9,9 @@ import (
 )
 
 var (
-	projectID int64
+	tasksListProjectFlag string
+	tasksListViewFlag    string
+	tasksListBucketFlag  string
 )
 
 func init() {
@@ -17,7 +19,9 @@ func init() {
 	tasksCmd.AddCommand(tasksListCmd)
 	tasksCmd.AddCommand(tasksGetCmd)
 
-	tasksListCmd.Flags().Int64VarP(&projectID, "project", "p", 0, "Filter tasks by project ID")
+	tasksListCmd.Flags().StringVarP(&tasksListProjectFlag, "project", "p", "", "Filter tasks by project ID or title (defaults to the default project when --view or --bucket is set)")
+	tasksListCmd.Flags().StringVar(&tasksListViewFlag, "view", "", "List the tasks of a project view, by ID or title (defaults to Kanban when --bucket is set)")
+	tasksListCmd.Flags().StringVar(&tasksListBucketFlag, "bucket", "", "Only list the tasks in this bucket, by ID or title")
 }
 
 var tasksCmd = &cobra.Command{
@@ -29,7 +33,10 @@ var tasksCmd = &cobra.Command{
 var tasksListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List tasks",
-	Long:  `List all tasks, optionally filtered by project.`,
+	Long: `List all tasks, optionally filtered by project. With --view or --bucket the tasks of that
+project view are listed grouped by bucket, resolving IDs and titles the same way as the
+list_tasks MCP tool: without --project the default project is used, and VIKUNJA_DEFAULT_PROJECT,
+VIKUNJA_VIEW_FALLBACK and VIKUNJA_DUPLICATE_PROJECT_TITLES apply.`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		return listTasks(cmd.Context())
 	},
@@ -40,7 +47,21 @@ var tasksGetCmd = newGetCmd("task", func(ctx context.Context, id int64) (interfa
 }, false)
 
 func listTasks(ctx context.Context) error {
-	if projectID > 0 {
+	if tasksListViewFlag != "" || tasksListBucketFlag != "" {
+		return listViewTasks(ctx)
+	}
+
+	var projectID int64
+	if tasksListProjectFlag != "" {
+		resolver, err := newResolver()
+		if err != nil {
+			return err
+		}
+		project, err := resolver.Project(ctx, tasksListProjectFlag)
+		if err != nil {
+			return err
+		}
+		projectID = project.ID
 		logger.Debug("listing tasks for project", "project_id", projectID)
 	} else {
 		logger.Debug("listing all tasks")
@@ -51,7 +72,7 @@ func listTasks(ctx context.Context) error {
 		return fmt.Errorf("failed to list tasks: %w", err)
 	}
 
-	formatter := vikunja.NewFormatter(!noColor, outputWriter)
+	formatter := vikunja.NewFormatter(useColor(), outputWriter)
 
 	if jsonFmt {
 		return formatter.FormatTasksAsJSON(tasks)
@@ -93,7 +93,7 @@ func executeCreateTask(ctx context.Context, title string, projectID int64, descr
 }
 
 func formatTaskOutput(task *vikunja.Task) error {
-	formatter := vikunja.NewFormatter(!noColor, outputWriter)
+	formatter := vikunja.NewFormatter(useColor(), outputWriter)
 	if jsonFmt {
 		return formatter.FormatTaskAsJSON(task)
 	}
@@ -104,8 +104,12 @@ func resetGlobals() {
 	markdown = origMarkdown
 	noColor = origNoColor
 	insecure = origInsecure
+	outputFormat = ""
 	tasksCreateProjectFlag = ""
 	tasksCreateBucketFlag = ""
+	tasksListProjectFlag = ""
+	tasksListViewFlag = ""
+	tasksListBucketFlag = ""
 }
 
 func TestTasksCreateCmd_Structure(t *testing.T) {
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBoardTestServer serves project "Work" (5), but not project 6, whose "Board" kanban view (9) has Todo (10) and
+// Done (11) buckets, and records the paths requested
+func setupBoardTestServer(t *testing.T, requested *[]string) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requested = append(*requested, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":1,"title":"Inbox"},{"id":5,"title":"Work"}]`) //nolint:errcheck
+		case "/api/v1/projects/5":
+			fmt.Fprint(w, `{"id":5,"title":"Work"}`) //nolint:errcheck
+		case "/api/v1/projects/6":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":3001,"message":"The project does not exist."}`) //nolint:errcheck
+		case "/api/v1/projects/5/views":
+			fmt.Fprint(w, `[{"id":8,"title":"List","view_kind":"list"},{"id":9,"title":"Board","view_kind":"kanban"}]`) //nolint:errcheck
+		case "/api/v1/projects/5/views/9/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":11,"title":"Done"}]`) //nolint:errcheck
+		case "/api/v1/projects/5/views/9/tasks":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","tasks":[{"id":1,"title":"Draft"}]},{"id":11,"title":"Done","tasks":[{"id":2,"title":"Ship"}]}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestTasksListCmd_ViewAndBucketByTitle(t *testing.T) {
+	saveAndResetGlobals()
+	defer resetGlobals()
+
+	buf := &bytes.Buffer{}
+	outputWriter = buf
+	logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var requested []string
+	ts := setupBoardTestServer(t, &requested)
+
+	rootCmd.SetArgs([]string{
+		"--host", ts.URL[7:],
+		"--token", "dummy",
+		"--insecure",
+		"--json",
+		"tasks", "list", "--project", "Work", "--view", "Board", "--bucket", "Done",
+	})
+	require.NoError(t, rootCmd.Execute())
+
+	assert.Equal(t, "Work", tasksListProjectFlag)
+	assert.Equal(t, "Board", tasksListViewFlag)
+	assert.Equal(t, "Done", tasksListBucketFlag)
+	assert.Contains(t, requested, "/api/v1/projects", "project title must be resolved")
+	assert.Contains(t, requested, "/api/v1/projects/5/views/9/buckets", "bucket title must be resolved")
+
+	var vt vikunja.ViewTasks
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &vt))
+	assert.Equal(t, int64(9), vt.ViewID)
+	require.Len(t, vt.Buckets, 1)
+	assert.Equal(t, "Done", vt.Buckets[0].Bucket.Title)
+	require.Len(t, vt.Buckets[0].Tasks, 1)
+	assert.Equal(t, "Ship", vt.Buckets[0].Tasks[0].Title)
+}
+
+func TestTasksListCmd_ResolutionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"unknown project ID", []string{"--project", "6", "--view", "Board"}, "project with ID 6 not found"},
+		{"unknown view", []string{"--project", "5", "--view", "Gantt"}, `view with title "Gantt" not found in project 5`},
+		{"unknown bucket", []string{"--project", "5", "--view", "9", "--bucket", "Doing"}, `bucket with title "Doing" not found in view 9`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saveAndResetGlobals()
+			defer resetGlobals()
+			logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			var requested []string
+			ts := setupBoardTestServer(t, &requested)
+
+			rootCmd.SetArgs(append([]string{"--host", ts.URL[7:], "--token", "dummy", "--insecure", "tasks", "list"}, tt.args...))
+			err := rootCmd.Execute()
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestTasksListCmd_DefaultProjectAndViewFallback(t *testing.T) {
+	saveAndResetGlobals()
+	defer resetGlobals()
+	t.Setenv("VIKUNJA_DEFAULT_PROJECT", "Work")
+	t.Setenv("VIKUNJA_VIEW_FALLBACK", "kanban")
+
+	buf := &bytes.Buffer{}
+	outputWriter = buf
+	logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var requested []string
+	ts := setupBoardTestServer(t, &requested)
+
+	rootCmd.SetArgs([]string{
+		"--host", ts.URL[7:],
+		"--token", "dummy",
+		"--insecure",
+		"--json",
+		"tasks", "list", "--bucket", "Todo",
+	})
+	require.NoError(t, rootCmd.Execute())
+
+	var vt vikunja.ViewTasks
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &vt))
+	assert.Equal(t, int64(9), vt.ViewID, "the project has no Kanban view, so the fallback picks its kanban view")
+	require.Len(t, vt.Buckets, 1)
+	assert.Equal(t, "Todo", vt.Buckets[0].Bucket.Title)
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// listViewTasks lists the tasks of a project view, optionally narrowed to one bucket
+func listViewTasks(ctx context.Context) error {
+	resolver, err := newResolver()
+	if err != nil {
+		return err
+	}
+	project, err := resolver.Project(ctx, tasksListProjectFlag)
+	if err != nil {
+		return err
+	}
+	view, err := resolver.View(ctx, project.ID, tasksListViewFlag)
+	if err != nil {
+		return err
+	}
+	var bucket *vikunja.Bucket
+	if tasksListBucketFlag != "" {
+		if bucket, err = resolver.Bucket(ctx, project.ID, view.ID, tasksListBucketFlag); err != nil {
+			return err
+		}
+	}
+
+	logger.Debug("listing view tasks", "project_id", project.ID, "view_id", view.ID)
+	vt, err := fetchViewTasks(ctx, project.ID, view, bucket)
+	if err != nil {
+		return err
+	}
+	return formatViewTasks(vikunja.NewFormatter(useColor(), outputWriter), vt)
+}
+
+// fetchViewTasks groups a view's tasks by bucket, keeping only the given bucket when one is set
+func fetchViewTasks(ctx context.Context, projectID int64, view *vikunja.ProjectView, bucket *vikunja.Bucket) (*vikunja.ViewTasks, error) {
+	response, err := client.GetViewTasks(ctx, projectID, view.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get view tasks: %w", err)
+	}
+
+	vt := &vikunja.ViewTasks{ViewID: view.ID, ViewTitle: view.Title, Buckets: []vikunja.BucketTasks{}}
+	if len(response.Buckets) == 0 {
+		switch {
+		case bucket == nil:
+			vt.Buckets = append(vt.Buckets, vikunja.BucketTasks{Bucket: vikunja.Bucket{Title: "All Tasks"}, Tasks: response.Tasks})
+		case len(response.Tasks) > 0:
+			return nil, fmt.Errorf("bucket filtering not supported for non-kanban views")
+		default:
+			// Vikunja answers fresh kanban boards with a flat empty list
+			vt.Buckets = append(vt.Buckets, vikunja.BucketTasks{Bucket: *bucket, IsDoneBucket: bucket.ID == view.DoneBucketID})
+		}
+		return vt, nil
+	}
+
+	for _, b := range response.Buckets {
+		if bucket == nil || b.ID == bucket.ID {
+			vt.Buckets = append(vt.Buckets, vikunja.BucketTasks{Bucket: *b, Tasks: b.Tasks, IsDoneBucket: b.ID == view.DoneBucketID})
+		}
+	}
+	return vt, nil
+}
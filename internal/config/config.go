@@ -5,11 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"strconv"
-	"strings"
 	"time"
 
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 )
 
@@ -30,6 +31,37 @@ type Config struct {
 	Vikunja      VikunjaConfig        `json:"vikunja"`
 	OutputFormat vikunja.OutputFormat `json:"output_format"`
 	Readonly     bool                 `json:"readonly"`
+	// ReadonlyAllow lists the mutating tools still permitted in readonly mode
+	ReadonlyAllow []string `json:"readonly_allow,omitempty"`
+	// EnabledTools, when not empty, lists the only tools registered with the MCP server
+	EnabledTools []string `json:"enabled_tools,omitempty"`
+	// DisabledTools lists tools left out of the MCP server
+	DisabledTools []string `json:"disabled_tools,omitempty"`
+	DryRun        bool     `json:"dry_run"`
+	// ToolTimeout bounds the total time a single tool call may take; zero disables the bound
+	ToolTimeout time.Duration `json:"tool_timeout"`
+	// RetryBudget is how many retries all Vikunja requests of a single tool call may share; zero disables retries
+	RetryBudget int `json:"retry_budget"`
+	// DiscoverMaxProjects is how many projects discover_vikunja describes unless the call asks for more or fewer
+	DiscoverMaxProjects int `json:"discover_max_projects"`
+	// AssistantNotes is operator guidance discover_vikunja passes on to the assistant
+	AssistantNotes []string `json:"assistant_notes,omitempty"`
+	// MaxOutputBytes bounds the size of a tool's result, text and structured output together; zero disables the bound
+	MaxOutputBytes int `json:"max_output_bytes"`
+	// HumanizeTimes renders markdown timestamps relative to now, e.g. "2 days ago"; JSON stays absolute
+	HumanizeTimes bool `json:"humanize_times"`
+	// CompactJSON drops the indentation of JSON output, which keeps large results small
+	CompactJSON bool `json:"compact_json"`
+	// DescriptionPreviewChars trims descriptions in markdown listings to this many characters; zero keeps them whole
+	DescriptionPreviewChars int `json:"description_preview_chars"`
+	// FormatFailure decides whether a result that cannot be formatted falls back to JSON or fails the call
+	FormatFailure FormatFailureMode `json:"format_failure"`
+	// DuplicateProjectTitles decides whether a title shared by several projects is rejected or resolves to the first
+	DuplicateProjectTitles DuplicateTitlePolicy `json:"duplicate_project_titles"`
+	// ViewFallback picks another view when a project lacks the default Kanban view; nil keeps lookups strict
+	ViewFallback *resolution.ViewFallback `json:"view_fallback,omitempty"`
+	// ValidateTokenOnStart checks the token authenticates before serving; nil follows the transport's default
+	ValidateTokenOnStart *bool `json:"validate_token_on_start,omitempty"`
 }
 
 // HTTPConfig contains HTTP server specific configuration.
@@ -41,6 +73,8 @@ type HTTPConfig struct {
 	ReadTimeout    time.Duration `json:"read_timeout"`
 	WriteTimeout   time.Duration `json:"write_timeout"`
 	IdleTimeout    time.Duration `json:"idle_timeout"`
+	// MetricsEnabled serves Prometheus metrics on /metrics
+	MetricsEnabled bool `json:"metrics_enabled"`
 }
 
 // VikunjaConfig contains Vikunja client specific configuration.
@@ -48,6 +82,16 @@ type VikunjaConfig struct {
 	Host     string `json:"host"`
 	Token    string `json:"token"`
 	Insecure bool   `json:"insecure"`
+	// MaxIdleConns bounds the idle connections kept across all hosts
+	MaxIdleConns int `json:"max_idle_conns"`
+	// MaxIdleConnsPerHost bounds the idle connections kept to the Vikunja host
+	MaxIdleConnsPerHost int `json:"max_idle_conns_per_host"`
+	// IdleConnTimeout is how long an idle connection to Vikunja is kept
+	IdleConnTimeout time.Duration `json:"idle_conn_timeout"`
+	// DefaultProject is the project ID or title tools use when none is given; empty discovers it
+	DefaultProject string `json:"default_project,omitempty"`
+	// ExtraHeaders are sent with every request, e.g. for a reverse proxy; values may be credentials
+	ExtraHeaders http.Header `json:"-"`
 }
 
 // Load loads configuration from environment variables with sensible defaults.
@@ -63,7 +107,17 @@ func Load(cliFormat *string, cliReadonly *bool) (*Config, error) {
 			WriteTimeout:   30 * time.Second,
 			IdleTimeout:    120 * time.Second,
 		},
-		OutputFormat: vikunja.OutputFormatMarkdown, // Default to Markdown for better AI/LLM compatibility
+		Vikunja: VikunjaConfig{
+			MaxIdleConns:        vikunja.DefaultMaxIdleConns,
+			MaxIdleConnsPerHost: vikunja.DefaultMaxIdleConnsPerHost,
+			IdleConnTimeout:     vikunja.DefaultIdleConnTimeout,
+		},
+		OutputFormat:           vikunja.OutputFormatMarkdown, // Default to Markdown for better AI/LLM compatibility
+		ToolTimeout:            DefaultToolTimeout,
+		RetryBudget:            DefaultRetryBudget,
+		DuplicateProjectTitles: DuplicateTitlesError,
+		FormatFailure:          FormatFailureJSON,
+		DiscoverMaxProjects:    DefaultDiscoverMaxProjects,
 	}
 
 	// Load transport type
@@ -78,6 +132,10 @@ func Load(cliFormat *string, cliReadonly *bool) (*Config, error) {
 		}
 	}
 
+	if err := loadValidateTokenOnStart(&cfg.ValidateTokenOnStart); err != nil {
+		return nil, fmt.Errorf("failed to load token check config: %w", err)
+	}
+
 	// Load HTTP configuration
 	if err := loadHTTPConfig(&cfg.HTTP); err != nil {
 		return nil, fmt.Errorf("failed to load HTTP config: %w", err)
@@ -92,11 +150,34 @@ func Load(cliFormat *string, cliReadonly *bool) (*Config, error) {
 	if err := loadOutputFormatConfig(&cfg.OutputFormat, cliFormat); err != nil {
 		return nil, fmt.Errorf("failed to load output format config: %w", err)
 	}
+	if err := loadCompactJSON(&cfg.CompactJSON); err != nil {
+		return nil, fmt.Errorf("failed to load compact JSON config: %w", err)
+	}
+	if err := loadDescriptionPreviewChars(&cfg.DescriptionPreviewChars); err != nil {
+		return nil, fmt.Errorf("failed to load description preview config: %w", err)
+	}
+	if err := loadFormatFailureMode(&cfg.FormatFailure); err != nil {
+		return nil, fmt.Errorf("failed to load format failure config: %w", err)
+	}
 
 	// Load readonly configuration
 	if err := loadReadonlyConfig(&cfg.Readonly, cliReadonly); err != nil {
 		return nil, fmt.Errorf("failed to load readonly config: %w", err)
 	}
+	loadToolList("VIKUNJA_READONLY_ALLOW", &cfg.ReadonlyAllow)
+
+	// Load the tools exposed by the server
+	loadToolList("MCP_ENABLED_TOOLS", &cfg.EnabledTools)
+	loadToolList("MCP_DISABLED_TOOLS", &cfg.DisabledTools)
+
+	// Load dry-run configuration
+	if err := loadDryRunConfig(&cfg.DryRun); err != nil {
+		return nil, fmt.Errorf("failed to load dry-run config: %w", err)
+	}
+
+	if err := loadToolSettings(cfg); err != nil {
+		return nil, err
+	}
 
 	return cfg, nil
 }
@@ -115,6 +196,9 @@ func loadHTTPConfig(cfg *HTTPConfig) error {
 	if err := loadHTTPStateless(cfg); err != nil {
 		errs = append(errs, err)
 	}
+	if err := loadHTTPMetrics(cfg); err != nil {
+		errs = append(errs, err)
+	}
 
 	return errors.Join(errs...)
 }
@@ -210,41 +294,6 @@ func loadHTTPStateless(cfg *HTTPConfig) error {
 	return nil
 }
 
-// loadVikunjaConfig loads Vikunja-specific configuration from environment variables.
-func loadVikunjaConfig(cfg *VikunjaConfig) error {
-	if host := os.Getenv("VIKUNJA_HOST"); host != "" {
-		cfg.Host = host
-	}
-
-	if token := os.Getenv("VIKUNJA_TOKEN"); token != "" {
-		cfg.Token = token
-	}
-
-	if insecure := os.Getenv("VIKUNJA_INSECURE"); insecure != "" {
-		s, err := strconv.ParseBool(insecure)
-		if err != nil {
-			return fmt.Errorf("invalid VIKUNJA_INSECURE flag: %s", insecure)
-		}
-		cfg.Insecure = s
-	}
-
-	return nil
-}
-
-// parseOutputFormat parses output format string into OutputFormat enum
-func parseOutputFormat(format string) (vikunja.OutputFormat, error) {
-	switch strings.ToLower(strings.TrimSpace(format)) {
-	case "json":
-		return vikunja.OutputFormatJSON, nil
-	case "markdown", "md":
-		return vikunja.OutputFormatMarkdown, nil
-	case "both":
-		return vikunja.OutputFormatBoth, nil
-	default:
-		return vikunja.OutputFormatJSON, fmt.Errorf("invalid output format: %s (must be 'json', 'markdown', or 'both')", format)
-	}
-}
-
 // loadReadonlyConfig loads readonly configuration from environment variable with CLI precedence
 func loadReadonlyConfig(cfg, cliReadonly *bool) error {
 	// Default to false (write operations enabled)
@@ -268,29 +317,15 @@ func loadReadonlyConfig(cfg, cliReadonly *bool) error {
 	return nil
 }
 
-// loadOutputFormatConfig loads output format configuration with precedence: CLI > Environment > Default
-func loadOutputFormatConfig(cfg *vikunja.OutputFormat, cliFormat *string) error {
-	// 1. CLI flag (highest priority)
-	if cliFormat != nil && *cliFormat != "" {
-		format, err := parseOutputFormat(*cliFormat)
+// loadDryRunConfig loads dry-run configuration from environment variable
+func loadDryRunConfig(cfg *bool) error {
+	if dryRun := os.Getenv("MCP_DRY_RUN"); dryRun != "" {
+		s, err := strconv.ParseBool(dryRun)
 		if err != nil {
-			return fmt.Errorf("invalid --output-format value: %w", err)
+			return fmt.Errorf("invalid MCP_DRY_RUN flag: %s", dryRun)
 		}
-		*cfg = format
-		return nil
-	}
-
-	// 2. Environment variable (middle priority)
-	if format := os.Getenv("VIKUNJA_OUTPUT_FORMAT"); format != "" {
-		format, err := parseOutputFormat(format)
-		if err != nil {
-			return fmt.Errorf("invalid VIKUNJA_OUTPUT_FORMAT value: %w", err)
-		}
-		*cfg = format
-		return nil
+		*cfg = s
 	}
-
-	// 3. Default (lowest priority) - already set in struct initialization
 	return nil
 }
 
@@ -344,6 +379,8 @@ func (c *VikunjaConfig) Validate() error {
 
 	if c.Host == "" {
 		errs = append(errs, fmt.Errorf("VIKUNJA_HOST is required"))
+	} else if err := validateVikunjaHost(c.Host); err != nil {
+		errs = append(errs, err)
 	}
 	if c.Token == "" {
 		errs = append(errs, fmt.Errorf("VIKUNJA_TOKEN is required"))
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// loadHTTPMetrics loads whether the HTTP transport serves /metrics from environment variable
+func loadHTTPMetrics(cfg *HTTPConfig) error {
+	if enabled := os.Getenv("MCP_METRICS_ENABLED"); enabled != "" {
+		b, err := strconv.ParseBool(enabled)
+		if err != nil {
+			return fmt.Errorf("invalid MCP_METRICS_ENABLED flag: %s", enabled)
+		}
+		cfg.MetricsEnabled = b
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// FormatFailureMode decides what a tool returns when its result cannot be formatted.
+type FormatFailureMode string
+
+const (
+	// FormatFailureJSON returns the result as JSON, annotated with the formatting error
+	FormatFailureJSON FormatFailureMode = "json"
+	// FormatFailureError fails the tool call, dropping the result
+	FormatFailureError FormatFailureMode = "error"
+)
+
+// ParseOutputFormat parses an output format name (json, markdown, both, both-json or jsonl) into an OutputFormat
+func ParseOutputFormat(format string) (vikunja.OutputFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "json":
+		return vikunja.OutputFormatJSON, nil
+	case "markdown", "md":
+		return vikunja.OutputFormatMarkdown, nil
+	case "both":
+		return vikunja.OutputFormatBoth, nil
+	case "both-json":
+		return vikunja.OutputFormatBothStructured, nil
+	case "jsonl", "ndjson":
+		return vikunja.OutputFormatJSONL, nil
+	default:
+		return vikunja.OutputFormatJSON, fmt.Errorf("invalid output format: %s (must be 'json', 'markdown', 'both', 'both-json' or 'jsonl')", format)
+	}
+}
+
+// loadOutputFormatConfig loads output format configuration with precedence: CLI > Environment > Default
+func loadOutputFormatConfig(cfg *vikunja.OutputFormat, cliFormat *string) error {
+	// 1. CLI flag (highest priority)
+	if cliFormat != nil && *cliFormat != "" {
+		format, err := ParseOutputFormat(*cliFormat)
+		if err != nil {
+			return fmt.Errorf("invalid --output-format value: %w", err)
+		}
+		*cfg = format
+		return nil
+	}
+
+	// 2. Environment variable (middle priority)
+	if format := os.Getenv("VIKUNJA_OUTPUT_FORMAT"); format != "" {
+		format, err := ParseOutputFormat(format)
+		if err != nil {
+			return fmt.Errorf("invalid VIKUNJA_OUTPUT_FORMAT value: %w", err)
+		}
+		*cfg = format
+		return nil
+	}
+
+	// 3. Default (lowest priority) - already set in struct initialization
+	return nil
+}
+
+// loadFormatFailureMode loads what a tool returns when formatting fails from environment variable
+func loadFormatFailureMode(cfg *FormatFailureMode) error {
+	value := strings.ToLower(strings.TrimSpace(os.Getenv("VIKUNJA_FORMAT_FAILURE")))
+	switch FormatFailureMode(value) {
+	case "":
+		return nil
+	case FormatFailureJSON, FormatFailureError:
+		*cfg = FormatFailureMode(value)
+		return nil
+	default:
+		return fmt.Errorf("invalid VIKUNJA_FORMAT_FAILURE: %s (must be 'json' or 'error')", value)
+	}
+}
+
+// loadCompactJSON loads whether JSON output drops its indentation from environment variable
+func loadCompactJSON(cfg *bool) error {
+	if compact := os.Getenv("VIKUNJA_JSON_COMPACT"); compact != "" {
+		b, err := strconv.ParseBool(compact)
+		if err != nil {
+			return fmt.Errorf("invalid VIKUNJA_JSON_COMPACT flag: %s", compact)
+		}
+		*cfg = b
+	}
+	return nil
+}
+
+// loadDescriptionPreviewChars loads how long descriptions in markdown listings may be from environment variable
+func loadDescriptionPreviewChars(cfg *int) error {
+	if chars := os.Getenv("VIKUNJA_DESCRIPTION_PREVIEW_CHARS"); chars != "" {
+		n, err := strconv.Atoi(chars)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid VIKUNJA_DESCRIPTION_PREVIEW_CHARS: %s (must be a non-negative integer)", chars)
+		}
+		*cfg = n
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// ClientOptions returns the connection pool settings for a Vikunja client.
+func (c VikunjaConfig) ClientOptions() vikunja.ClientOptions {
+	return vikunja.ClientOptions{
+		MaxIdleConns:        c.MaxIdleConns,
+		MaxIdleConnsPerHost: c.MaxIdleConnsPerHost,
+		IdleConnTimeout:     c.IdleConnTimeout,
+		ExtraHeaders:        c.ExtraHeaders,
+	}
+}
+
+// loadConnectionPoolConfig loads the Vikunja client connection pool settings from environment variables
+func loadConnectionPoolConfig(cfg *VikunjaConfig) error {
+	if maxIdle := os.Getenv("VIKUNJA_MAX_IDLE_CONNS"); maxIdle != "" {
+		n, err := strconv.Atoi(maxIdle)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid VIKUNJA_MAX_IDLE_CONNS: %s (must be a non-negative integer)", maxIdle)
+		}
+		cfg.MaxIdleConns = n
+	}
+	if perHost := os.Getenv("VIKUNJA_MAX_IDLE_CONNS_PER_HOST"); perHost != "" {
+		n, err := strconv.Atoi(perHost)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid VIKUNJA_MAX_IDLE_CONNS_PER_HOST: %s (must be a positive integer)", perHost)
+		}
+		cfg.MaxIdleConnsPerHost = n
+	}
+	if timeout := os.Getenv("VIKUNJA_IDLE_CONN_TIMEOUT"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("invalid VIKUNJA_IDLE_CONN_TIMEOUT: %w", err)
+		}
+		if d < 0 {
+			return fmt.Errorf("invalid VIKUNJA_IDLE_CONN_TIMEOUT: %s (must not be negative)", timeout)
+		}
+		cfg.IdleConnTimeout = d
+	}
+	return nil
+}
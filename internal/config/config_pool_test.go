@@ -0,0 +1,85 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+func TestLoad_ConnectionPoolDefaults(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, vikunja.DefaultClientOptions(), cfg.Vikunja.ClientOptions())
+}
+
+func TestLoad_ConnectionPool(t *testing.T) {
+	setEnv(t, "VIKUNJA_MAX_IDLE_CONNS", "50")
+	setEnv(t, "VIKUNJA_MAX_IDLE_CONNS_PER_HOST", "32")
+	setEnv(t, "VIKUNJA_IDLE_CONN_TIMEOUT", "45s")
+
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, vikunja.ClientOptions{
+		MaxIdleConns:        50,
+		MaxIdleConnsPerHost: 32,
+		IdleConnTimeout:     45 * time.Second,
+	}, cfg.Vikunja.ClientOptions())
+}
+
+func TestLoad_InvalidConnectionPool(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   string
+		value string
+	}{
+		{name: "max idle not a number", env: "VIKUNJA_MAX_IDLE_CONNS", value: "lots"},
+		{name: "max idle negative", env: "VIKUNJA_MAX_IDLE_CONNS", value: "-1"},
+		{name: "per host zero", env: "VIKUNJA_MAX_IDLE_CONNS_PER_HOST", value: "0"},
+		{name: "timeout not a duration", env: "VIKUNJA_IDLE_CONN_TIMEOUT", value: "soon"},
+		{name: "timeout negative", env: "VIKUNJA_IDLE_CONN_TIMEOUT", value: "-5s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, tt.env, tt.value)
+
+			_, err := Load(nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid "+tt.env)
+		})
+	}
+}
+
+func TestLoad_ExtraHeaders(t *testing.T) {
+	setEnv(t, "VIKUNJA_EXTRA_HEADERS", "CF-Access-Client-Id=client-1, CF-Access-Client-Secret = s3cr=t")
+
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	headers := cfg.Vikunja.ClientOptions().ExtraHeaders
+	assert.Equal(t, "client-1", headers.Get("CF-Access-Client-Id"))
+	assert.Equal(t, "s3cr=t", headers.Get("CF-Access-Client-Secret"))
+}
+
+func TestLoad_InvalidExtraHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{"reserved header", "authorization=Bearer other", "Authorization cannot be set"},
+		{"missing value", "CF-Access-Client-Id", `"CF-Access-Client-Id" is not a Name=value header`},
+		{"missing name", "=client-1", `"=client-1" is not a Name=value header`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, "VIKUNJA_EXTRA_HEADERS", tt.value)
+			_, err := Load(nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
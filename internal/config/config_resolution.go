@@ -0,0 +1,27 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
+)
+
+// LoadResolver loads the project and view resolution settings the MCP tools use from environment
+// variables, so other frontends such as vikunja-cli resolve projects and views alike. The caller
+// sets the returned resolver's Client.
+func LoadResolver() (*resolution.Resolver, error) {
+	r := &resolution.Resolver{DefaultProject: strings.TrimSpace(os.Getenv("VIKUNJA_DEFAULT_PROJECT"))}
+
+	if err := loadViewFallback(&r.ViewFallback); err != nil {
+		return nil, fmt.Errorf("failed to load view fallback config: %w", err)
+	}
+
+	duplicates := DuplicateTitlesError
+	if err := loadDuplicateProjectTitles(&duplicates); err != nil {
+		return nil, fmt.Errorf("failed to load duplicate project titles config: %w", err)
+	}
+	r.FirstOfDuplicateTitles = duplicates == DuplicateTitlesFirst
+	return r, nil
+}
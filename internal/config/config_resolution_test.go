@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadResolver(t *testing.T) {
+	r, err := LoadResolver()
+	require.NoError(t, err)
+	assert.Empty(t, r.DefaultProject)
+	assert.False(t, r.FirstOfDuplicateTitles)
+	assert.Nil(t, r.ViewFallback)
+
+	setEnv(t, "VIKUNJA_DEFAULT_PROJECT", " Work ")
+	setEnv(t, "VIKUNJA_DUPLICATE_PROJECT_TITLES", "first")
+	setEnv(t, "VIKUNJA_VIEW_FALLBACK", "list")
+	r, err = LoadResolver()
+	require.NoError(t, err)
+	assert.Equal(t, "Work", r.DefaultProject)
+	assert.True(t, r.FirstOfDuplicateTitles)
+	require.NotNil(t, r.ViewFallback)
+	assert.Equal(t, []string{"list"}, r.ViewFallback.Kinds)
+
+	setEnv(t, "VIKUNJA_DUPLICATE_PROJECT_TITLES", "newest")
+	_, err = LoadResolver()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid VIKUNJA_DUPLICATE_PROJECT_TITLES")
+}
@@ -38,6 +38,7 @@ func TestLoad_Defaults(t *testing.T) {
 	assert.Equal(t, "localhost", cfg.HTTP.Host)
 	assert.Equal(t, 8080, cfg.HTTP.Port)
 	assert.Equal(t, 30*time.Minute, cfg.HTTP.SessionTimeout)
+	assert.Equal(t, DefaultToolTimeout, cfg.ToolTimeout)
 	assert.False(t, cfg.HTTP.Stateless)
 	assert.Equal(t, 30*time.Second, cfg.HTTP.ReadTimeout)
 	assert.Equal(t, 30*time.Second, cfg.HTTP.WriteTimeout)
@@ -222,6 +223,41 @@ func TestValidate_MissingVikunjaToken(t *testing.T) {
 	assert.Contains(t, err.Error(), "VIKUNJA_TOKEN is required")
 }
 
+func TestValidate_VikunjaHostTrailingSlash(t *testing.T) {
+	setEnv(t, "VIKUNJA_HOST", "https://vikunja.example.com/ ")
+	setEnv(t, "VIKUNJA_TOKEN", "test-token")
+
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://vikunja.example.com", cfg.Vikunja.Host)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate_VikunjaHostWithAPIPath(t *testing.T) {
+	tests := []struct {
+		host     string
+		wantBase string
+	}{
+		{"https://vikunja.example.com/api/v1", "https://vikunja.example.com"},
+		{"https://vikunja.example.com/API/v1/", "https://vikunja.example.com"},
+		{"vikunja.example.com/api", "vikunja.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			setEnv(t, "VIKUNJA_HOST", tt.host)
+			setEnv(t, "VIKUNJA_TOKEN", "test-token")
+
+			cfg, err := Load(nil, nil)
+			require.NoError(t, err)
+			err = cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "VIKUNJA_HOST must not include the API path")
+			assert.Contains(t, err.Error(), "e.g. "+tt.wantBase+")")
+		})
+	}
+}
+
 func TestHTTPConfig_Address(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -278,12 +314,16 @@ func TestParseOutputFormat(t *testing.T) {
 		{"md", vikunja.OutputFormatMarkdown, false},
 		{"MARKDOWN", vikunja.OutputFormatMarkdown, false},
 		{"both", vikunja.OutputFormatBoth, false},
+		{"both-json", vikunja.OutputFormatBothStructured, false},
+		{"BOTH-JSON", vikunja.OutputFormatBothStructured, false},
+		{"jsonl", vikunja.OutputFormatJSONL, false},
+		{"ndjson", vikunja.OutputFormatJSONL, false},
 		{"invalid", vikunja.OutputFormatJSON, true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
-			result, err := parseOutputFormat(tt.input)
+			result, err := ParseOutputFormat(tt.input)
 			if tt.hasError {
 				require.Error(t, err)
 				assert.Equal(t, vikunja.OutputFormatJSON, result) // Should return default on error
@@ -329,3 +369,39 @@ func TestLoad_EnvironmentVariableFallback(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, vikunja.OutputFormatMarkdown, cfg.OutputFormat)
 }
+
+func TestLoad_DryRun(t *testing.T) {
+	setEnv(t, "MCP_DRY_RUN", "true")
+
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.DryRun)
+}
+
+func TestLoad_InvalidDryRun(t *testing.T) {
+	setEnv(t, "MCP_DRY_RUN", "maybe")
+
+	_, err := Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid MCP_DRY_RUN flag")
+}
+
+func TestLoad_ToolTimeout(t *testing.T) {
+	setEnv(t, "MCP_TOOL_TIMEOUT", "45s")
+
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 45*time.Second, cfg.ToolTimeout)
+}
+
+func TestLoad_InvalidToolTimeout(t *testing.T) {
+	for _, value := range []string{"soon", "-5s"} {
+		t.Run(value, func(t *testing.T) {
+			setEnv(t, "MCP_TOOL_TIMEOUT", value)
+
+			_, err := Load(nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid MCP_TOOL_TIMEOUT")
+		})
+	}
+}
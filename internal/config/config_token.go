@@ -0,0 +1,117 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// tokenFileScheme prefixes a token value naming a file that holds the token
+	tokenFileScheme = "file:"
+	// tokenEnvScheme prefixes a token value naming another environment variable that holds the token
+	tokenEnvScheme = "env:"
+)
+
+// loadVikunjaToken loads the API token with precedence: VIKUNJA_TOKEN > VIKUNJA_TOKEN_FILE.
+// VIKUNJA_TOKEN may itself be a file: or env: reference, which keeps the token out of process listings.
+func loadVikunjaToken(cfg *VikunjaConfig) error {
+	if token := os.Getenv("VIKUNJA_TOKEN"); token != "" {
+		resolved, err := resolveTokenReference(token)
+		if err != nil {
+			return fmt.Errorf("invalid VIKUNJA_TOKEN: %w", err)
+		}
+		cfg.Token = resolved
+		return nil
+	}
+
+	if path := os.Getenv("VIKUNJA_TOKEN_FILE"); path != "" {
+		token, err := readTokenFile(path)
+		if err != nil {
+			return fmt.Errorf("invalid VIKUNJA_TOKEN_FILE: %w", err)
+		}
+		cfg.Token = token
+	}
+	return nil
+}
+
+// LoadVikunjaToken returns the API token the server uses: VIKUNJA_TOKEN, which may be a file: or
+// env: reference, or else the contents of VIKUNJA_TOKEN_FILE. It returns "" when neither is set.
+func LoadVikunjaToken() (string, error) {
+	var cfg VikunjaConfig
+	if err := loadVikunjaToken(&cfg); err != nil {
+		return "", err
+	}
+	return cfg.Token, nil
+}
+
+// ReloadToken reads the API token again from VIKUNJA_TOKEN or VIKUNJA_TOKEN_FILE, so a rotated
+// token takes effect without a restart. It reports whether the token changed; a token that can no
+// longer be read is an error and leaves the current token in place.
+func (c *VikunjaConfig) ReloadToken() (bool, error) {
+	var reloaded VikunjaConfig
+	if err := loadVikunjaToken(&reloaded); err != nil {
+		return false, err
+	}
+	if reloaded.Token == "" || reloaded.Token == c.Token {
+		return false, nil
+	}
+	c.Token = reloaded.Token
+	return true, nil
+}
+
+// loadValidateTokenOnStart loads whether the token is checked at start-up from environment variable
+func loadValidateTokenOnStart(cfg **bool) error {
+	if validate := os.Getenv("MCP_VALIDATE_TOKEN_ON_START"); validate != "" {
+		b, err := strconv.ParseBool(validate)
+		if err != nil {
+			return fmt.Errorf("invalid MCP_VALIDATE_TOKEN_ON_START flag: %s", validate)
+		}
+		*cfg = &b
+	}
+	return nil
+}
+
+// ValidatesTokenOnStart reports whether the server checks that the token authenticates before it
+// starts serving. Unless configured, only the HTTP transport checks: stdio servers are often
+// spawned eagerly by clients that may never call a tool.
+func (c *Config) ValidatesTokenOnStart() bool {
+	if c.ValidateTokenOnStart != nil {
+		return *c.ValidateTokenOnStart
+	}
+	return c.Transport == TransportHTTP
+}
+
+// resolveTokenReference returns the token a value refers to: the contents of a file: path, the
+// value of an env: variable, or the value itself
+func resolveTokenReference(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, tokenFileScheme):
+		return readTokenFile(strings.TrimPrefix(value, tokenFileScheme))
+	case strings.HasPrefix(value, tokenEnvScheme):
+		name := strings.TrimPrefix(value, tokenEnvScheme)
+		token := os.Getenv(name)
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s referenced by %s is not set", name, value)
+		}
+		return token, nil
+	default:
+		return value, nil
+	}
+}
+
+// readTokenFile reads a token from a file, dropping the trailing newline editors and
+// secret managers commonly leave behind
+func readTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimRight(string(data), " \t\r\n")
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return token, nil
+}
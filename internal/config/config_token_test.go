@@ -0,0 +1,165 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTokenFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestLoad_TokenFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{"plain", "file-token"},
+		{"trailing newline", "file-token\n"},
+		{"trailing whitespace", "file-token \r\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, "VIKUNJA_TOKEN", "")
+			setEnv(t, "VIKUNJA_TOKEN_FILE", writeTokenFile(t, tt.contents))
+
+			cfg, err := Load(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, "file-token", cfg.Vikunja.Token)
+		})
+	}
+}
+
+func TestLoad_TokenPrecedence(t *testing.T) {
+	setEnv(t, "VIKUNJA_TOKEN", "explicit-token")
+	setEnv(t, "VIKUNJA_TOKEN_FILE", writeTokenFile(t, "file-token\n"))
+
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "explicit-token", cfg.Vikunja.Token)
+}
+
+func TestLoad_TokenReference(t *testing.T) {
+	setEnv(t, "VIKUNJA_TOKEN_FILE", "")
+	setEnv(t, "SECRET_VIKUNJA_TOKEN", "env-token")
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"file scheme", "file:" + writeTokenFile(t, "file-token\n"), "file-token"},
+		{"env scheme", "env:SECRET_VIKUNJA_TOKEN", "env-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, "VIKUNJA_TOKEN", tt.value)
+
+			cfg, err := Load(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cfg.Vikunja.Token)
+		})
+	}
+}
+
+func TestLoad_InvalidTokenSource(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name      string
+		token     string
+		tokenFile string
+		wantErr   string
+	}{
+		{"missing token file", "", missing, "invalid VIKUNJA_TOKEN_FILE: failed to read token file"},
+		{"empty token file", "", writeTokenFile(t, "\n"), "is empty"},
+		{"missing file reference", "file:" + missing, "", "invalid VIKUNJA_TOKEN: failed to read token file"},
+		{"unset env reference", "env:UNSET_VIKUNJA_TOKEN", "", "environment variable UNSET_VIKUNJA_TOKEN referenced by env:UNSET_VIKUNJA_TOKEN is not set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, "VIKUNJA_TOKEN", tt.token)
+			setEnv(t, "VIKUNJA_TOKEN_FILE", tt.tokenFile)
+
+			_, err := Load(nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestVikunjaConfig_ReloadToken(t *testing.T) {
+	setEnv(t, "VIKUNJA_TOKEN", "")
+	path := writeTokenFile(t, "old-token\n")
+	setEnv(t, "VIKUNJA_TOKEN_FILE", path)
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+
+	changed, err := cfg.Vikunja.ReloadToken()
+	require.NoError(t, err)
+	assert.False(t, changed, "an unchanged token file must not count as a rotation")
+
+	require.NoError(t, os.WriteFile(path, []byte("new-token\n"), 0o600))
+	changed, err = cfg.Vikunja.ReloadToken()
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "new-token", cfg.Vikunja.Token)
+
+	require.NoError(t, os.Remove(path))
+	_, err = cfg.Vikunja.ReloadToken()
+	require.Error(t, err)
+	assert.Equal(t, "new-token", cfg.Vikunja.Token, "an unreadable token file must keep the current token")
+}
+
+func TestLoadVikunjaToken(t *testing.T) {
+	setEnv(t, "VIKUNJA_TOKEN", "")
+	setEnv(t, "VIKUNJA_TOKEN_FILE", "")
+	token, err := LoadVikunjaToken()
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	setEnv(t, "VIKUNJA_TOKEN_FILE", writeTokenFile(t, "file-token\n"))
+	token, err = LoadVikunjaToken()
+	require.NoError(t, err)
+	assert.Equal(t, "file-token", token)
+
+	setEnv(t, "SECRET_VIKUNJA_TOKEN", "env-token")
+	setEnv(t, "VIKUNJA_TOKEN", "env:SECRET_VIKUNJA_TOKEN")
+	token, err = LoadVikunjaToken()
+	require.NoError(t, err)
+	assert.Equal(t, "env-token", token)
+}
+
+func TestConfig_ValidatesTokenOnStart(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       string
+		transport string
+		want      bool
+	}{
+		{"http by default", "", "http", true},
+		{"stdio by default", "", "stdio", false},
+		{"disabled for http", "false", "http", false},
+		{"enabled for stdio", "true", "stdio", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, "MCP_TRANSPORT", tt.transport)
+			setEnv(t, "MCP_VALIDATE_TOKEN_ON_START", tt.env)
+			cfg, err := Load(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cfg.ValidatesTokenOnStart())
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// DefaultToolTimeout is the default bound on a single tool call, covering every Vikunja request it makes.
+const DefaultToolTimeout = 2 * time.Minute
+
+// DefaultRetryBudget is the default number of retries shared by all Vikunja requests of one tool call.
+const DefaultRetryBudget = 3
+
+// DuplicateTitlePolicy decides what a project title shared by several projects resolves to.
+type DuplicateTitlePolicy string
+
+const (
+	// DuplicateTitlesError rejects an ambiguous title, listing the matching project IDs
+	DuplicateTitlesError DuplicateTitlePolicy = "error"
+	// DuplicateTitlesFirst resolves an ambiguous title to the first matching project
+	DuplicateTitlesFirst DuplicateTitlePolicy = "first"
+)
+
+// DefaultDiscoverMaxProjects is the default number of projects discover_vikunja describes.
+const DefaultDiscoverMaxProjects = 5
+
+// loadToolSettings loads the settings shaping how tool calls run and what they return
+func loadToolSettings(cfg *Config) error {
+	// Load tool call timeout
+	if err := loadToolTimeout(&cfg.ToolTimeout); err != nil {
+		return fmt.Errorf("failed to load tool timeout config: %w", err)
+	}
+
+	// Load per tool call retry budget
+	if err := loadRetryBudget(&cfg.RetryBudget); err != nil {
+		return fmt.Errorf("failed to load retry budget config: %w", err)
+	}
+
+	// Load discovery project cap
+	if err := loadDiscoverMaxProjects(&cfg.DiscoverMaxProjects); err != nil {
+		return fmt.Errorf("failed to load discovery config: %w", err)
+	}
+	loadAssistantNotes(&cfg.AssistantNotes)
+
+	// Load relative timestamp rendering
+	if err := loadHumanizeTimes(&cfg.HumanizeTimes); err != nil {
+		return fmt.Errorf("failed to load humanize times config: %w", err)
+	}
+
+	// Load default view fallback
+	if err := loadViewFallback(&cfg.ViewFallback); err != nil {
+		return fmt.Errorf("failed to load view fallback config: %w", err)
+	}
+
+	// Load ambiguous project title handling
+	if err := loadDuplicateProjectTitles(&cfg.DuplicateProjectTitles); err != nil {
+		return fmt.Errorf("failed to load duplicate project titles config: %w", err)
+	}
+
+	// Load tool output size limit
+	if err := loadMaxOutputBytes(&cfg.MaxOutputBytes); err != nil {
+		return fmt.Errorf("failed to load output limit config: %w", err)
+	}
+
+	return nil
+}
+
+// loadToolList loads a comma separated list of tool names from the named environment variable
+func loadToolList(name string, cfg *[]string) {
+	for _, tool := range strings.Split(os.Getenv(name), ",") {
+		if tool = strings.TrimSpace(tool); tool != "" {
+			*cfg = append(*cfg, tool)
+		}
+	}
+}
+
+// loadToolTimeout loads the per tool call timeout from environment variable
+func loadToolTimeout(cfg *time.Duration) error {
+	if timeout := os.Getenv("MCP_TOOL_TIMEOUT"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("invalid MCP_TOOL_TIMEOUT: %w", err)
+		}
+		if d < 0 {
+			return fmt.Errorf("invalid MCP_TOOL_TIMEOUT: %s (must not be negative)", timeout)
+		}
+		*cfg = d
+	}
+	return nil
+}
+
+// loadRetryBudget loads the retries shared by a tool call's Vikunja requests from environment variable
+func loadRetryBudget(cfg *int) error {
+	if budget := os.Getenv("MCP_RETRY_BUDGET"); budget != "" {
+		n, err := strconv.Atoi(budget)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid MCP_RETRY_BUDGET: %s (must be a non-negative integer)", budget)
+		}
+		*cfg = n
+	}
+	return nil
+}
+
+// loadDiscoverMaxProjects loads the default discover_vikunja project cap from environment variable
+func loadDiscoverMaxProjects(cfg *int) error {
+	if maxProjects := os.Getenv("MCP_DISCOVER_MAX_PROJECTS"); maxProjects != "" {
+		n, err := strconv.Atoi(maxProjects)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid MCP_DISCOVER_MAX_PROJECTS: %s (must be a positive integer)", maxProjects)
+		}
+		*cfg = n
+	}
+	return nil
+}
+
+// loadAssistantNotes loads the newline separated guidance for the assistant from environment variable
+func loadAssistantNotes(cfg *[]string) {
+	for _, note := range strings.Split(os.Getenv("VIKUNJA_ASSISTANT_NOTES"), "\n") {
+		if note = strings.TrimSpace(note); note != "" {
+			*cfg = append(*cfg, note)
+		}
+	}
+}
+
+// loadMaxOutputBytes loads the bound on a tool's result from environment variable
+func loadMaxOutputBytes(cfg *int) error {
+	if maxBytes := os.Getenv("VIKUNJA_MAX_OUTPUT_BYTES"); maxBytes != "" {
+		n, err := strconv.Atoi(maxBytes)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid VIKUNJA_MAX_OUTPUT_BYTES: %s (must be a non-negative integer)", maxBytes)
+		}
+		*cfg = n
+	}
+	return nil
+}
+
+// loadHumanizeTimes loads whether markdown output renders relative timestamps from environment variable
+func loadHumanizeTimes(cfg *bool) error {
+	if humanize := os.Getenv("VIKUNJA_HUMANIZE_TIMES"); humanize != "" {
+		b, err := strconv.ParseBool(humanize)
+		if err != nil {
+			return fmt.Errorf("invalid VIKUNJA_HUMANIZE_TIMES flag: %s", humanize)
+		}
+		*cfg = b
+	}
+	return nil
+}
+
+// loadViewFallback loads how a missing default view is replaced from environment variable.
+// "first" picks a project's default view, the lowest positioned one; a comma separated kind list such as "kanban,list,table"
+// picks the first view of the earliest listed kind.
+func loadViewFallback(cfg **resolution.ViewFallback) error {
+	value := strings.TrimSpace(os.Getenv("VIKUNJA_VIEW_FALLBACK"))
+	if value == "" {
+		return nil
+	}
+	if strings.EqualFold(value, "first") {
+		*cfg = &resolution.ViewFallback{}
+		return nil
+	}
+
+	fallback := &resolution.ViewFallback{}
+	for _, kind := range strings.Split(value, ",") {
+		kind = strings.ToLower(strings.TrimSpace(kind))
+		if !slices.Contains(vikunja.ViewKinds(), kind) {
+			return fmt.Errorf("invalid VIKUNJA_VIEW_FALLBACK: %s (must be 'first' or a comma separated list of %s)",
+				value, strings.Join(vikunja.ViewKinds(), ", "))
+		}
+		fallback.Kinds = append(fallback.Kinds, kind)
+	}
+	*cfg = fallback
+	return nil
+}
+
+// loadDuplicateProjectTitles loads how ambiguous project titles are resolved from environment variable
+func loadDuplicateProjectTitles(cfg *DuplicateTitlePolicy) error {
+	value := strings.ToLower(strings.TrimSpace(os.Getenv("VIKUNJA_DUPLICATE_PROJECT_TITLES")))
+	switch DuplicateTitlePolicy(value) {
+	case "":
+		return nil
+	case DuplicateTitlesError, DuplicateTitlesFirst:
+		*cfg = DuplicateTitlePolicy(value)
+		return nil
+	default:
+		return fmt.Errorf("invalid VIKUNJA_DUPLICATE_PROJECT_TITLES: %s (must be 'error' or 'first')", value)
+	}
+}
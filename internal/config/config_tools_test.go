@@ -0,0 +1,225 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_DiscoverMaxProjects(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultDiscoverMaxProjects, cfg.DiscoverMaxProjects)
+
+	setEnv(t, "MCP_DISCOVER_MAX_PROJECTS", "20")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 20, cfg.DiscoverMaxProjects)
+}
+
+func TestLoad_InvalidDiscoverMaxProjects(t *testing.T) {
+	for _, value := range []string{"many", "0", "-3"} {
+		t.Run(value, func(t *testing.T) {
+			setEnv(t, "MCP_DISCOVER_MAX_PROJECTS", value)
+
+			_, err := Load(nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid MCP_DISCOVER_MAX_PROJECTS")
+		})
+	}
+}
+
+func TestLoad_RetryBudget(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRetryBudget, cfg.RetryBudget)
+
+	setEnv(t, "MCP_RETRY_BUDGET", "0")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Zero(t, cfg.RetryBudget)
+
+	setEnv(t, "MCP_RETRY_BUDGET", "-1")
+	_, err = Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid MCP_RETRY_BUDGET")
+}
+
+func TestLoad_MaxOutputBytes(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Zero(t, cfg.MaxOutputBytes)
+
+	setEnv(t, "VIKUNJA_MAX_OUTPUT_BYTES", "65536")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 65536, cfg.MaxOutputBytes)
+}
+
+func TestLoad_InvalidMaxOutputBytes(t *testing.T) {
+	for _, value := range []string{"64k", "-1"} {
+		t.Run(value, func(t *testing.T) {
+			setEnv(t, "VIKUNJA_MAX_OUTPUT_BYTES", value)
+
+			_, err := Load(nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid VIKUNJA_MAX_OUTPUT_BYTES")
+		})
+	}
+}
+
+func TestLoad_HumanizeTimes(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.False(t, cfg.HumanizeTimes)
+
+	setEnv(t, "VIKUNJA_HUMANIZE_TIMES", "true")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.HumanizeTimes)
+
+	setEnv(t, "VIKUNJA_HUMANIZE_TIMES", "sometimes")
+	_, err = Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid VIKUNJA_HUMANIZE_TIMES")
+}
+
+func TestLoad_ViewFallback(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, cfg.ViewFallback)
+
+	setEnv(t, "VIKUNJA_VIEW_FALLBACK", "first")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.ViewFallback)
+	assert.Empty(t, cfg.ViewFallback.Kinds)
+
+	setEnv(t, "VIKUNJA_VIEW_FALLBACK", "Kanban, list,table")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.ViewFallback)
+	assert.Equal(t, []string{"kanban", "list", "table"}, cfg.ViewFallback.Kinds)
+
+	setEnv(t, "VIKUNJA_VIEW_FALLBACK", "kanban,calendar")
+	_, err = Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid VIKUNJA_VIEW_FALLBACK")
+}
+
+func TestLoad_MetricsEnabled(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.False(t, cfg.HTTP.MetricsEnabled)
+
+	setEnv(t, "MCP_METRICS_ENABLED", "true")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.HTTP.MetricsEnabled)
+
+	setEnv(t, "MCP_METRICS_ENABLED", "yes please")
+	_, err = Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid MCP_METRICS_ENABLED")
+}
+
+func TestLoad_ReadonlyAllow(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, cfg.ReadonlyAllow)
+
+	setEnv(t, "VIKUNJA_READONLY_ALLOW", "create_task, add_task_comment,")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create_task", "add_task_comment"}, cfg.ReadonlyAllow)
+}
+
+func TestLoad_ToolSelection(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, cfg.EnabledTools)
+	assert.Empty(t, cfg.DisabledTools)
+
+	setEnv(t, "MCP_ENABLED_TOOLS", "list_tasks,get_task")
+	setEnv(t, "MCP_DISABLED_TOOLS", " get_task ")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"list_tasks", "get_task"}, cfg.EnabledTools)
+	assert.Equal(t, []string{"get_task"}, cfg.DisabledTools)
+}
+
+func TestLoad_AssistantNotes(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, cfg.AssistantNotes)
+
+	setEnv(t, "VIKUNJA_ASSISTANT_NOTES", "Always confirm before deleting, even in bulk\n\n  Prefer the Work project \n")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Always confirm before deleting, even in bulk", "Prefer the Work project"}, cfg.AssistantNotes)
+}
+
+func TestLoad_DuplicateProjectTitles(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DuplicateTitlesError, cfg.DuplicateProjectTitles)
+
+	setEnv(t, "VIKUNJA_DUPLICATE_PROJECT_TITLES", "First")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DuplicateTitlesFirst, cfg.DuplicateProjectTitles)
+
+	setEnv(t, "VIKUNJA_DUPLICATE_PROJECT_TITLES", "newest")
+	_, err = Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid VIKUNJA_DUPLICATE_PROJECT_TITLES")
+}
+
+func TestLoad_FormatFailure(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, FormatFailureJSON, cfg.FormatFailure)
+
+	setEnv(t, "VIKUNJA_FORMAT_FAILURE", "Error")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, FormatFailureError, cfg.FormatFailure)
+
+	setEnv(t, "VIKUNJA_FORMAT_FAILURE", "ignore")
+	_, err = Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid VIKUNJA_FORMAT_FAILURE")
+}
+
+func TestLoad_CompactJSON(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.False(t, cfg.CompactJSON)
+
+	setEnv(t, "VIKUNJA_JSON_COMPACT", "true")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.CompactJSON)
+
+	setEnv(t, "VIKUNJA_JSON_COMPACT", "tiny")
+	_, err = Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid VIKUNJA_JSON_COMPACT flag")
+}
+
+func TestLoad_DescriptionPreviewChars(t *testing.T) {
+	cfg, err := Load(nil, nil)
+	require.NoError(t, err)
+	assert.Zero(t, cfg.DescriptionPreviewChars)
+
+	setEnv(t, "VIKUNJA_DESCRIPTION_PREVIEW_CHARS", "200")
+	cfg, err = Load(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 200, cfg.DescriptionPreviewChars)
+
+	setEnv(t, "VIKUNJA_DESCRIPTION_PREVIEW_CHARS", "-1")
+	_, err = Load(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid VIKUNJA_DESCRIPTION_PREVIEW_CHARS")
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// normalizeVikunjaHost drops surrounding whitespace and trailing slashes from a host, which would
+// otherwise end up in every request path
+func normalizeVikunjaHost(host string) string {
+	return strings.TrimRight(strings.TrimSpace(host), "/")
+}
+
+// validateVikunjaHost rejects a host including the API path, which the client adds itself, so
+// requests would go to /api/v1/api/v1
+func validateVikunjaHost(host string) error {
+	i := strings.Index(strings.ToLower(host+"/"), "/api/")
+	if i < 0 {
+		return nil
+	}
+	return fmt.Errorf("VIKUNJA_HOST must not include the API path: %s (set it to the server's address, e.g. %s)", host, host[:i])
+}
+
+// loadVikunjaConfig loads Vikunja-specific configuration from environment variables.
+func loadVikunjaConfig(cfg *VikunjaConfig) error {
+	if host := os.Getenv("VIKUNJA_HOST"); host != "" {
+		cfg.Host = normalizeVikunjaHost(host)
+	}
+
+	if err := loadVikunjaToken(cfg); err != nil {
+		return err
+	}
+
+	if insecure := os.Getenv("VIKUNJA_INSECURE"); insecure != "" {
+		s, err := strconv.ParseBool(insecure)
+		if err != nil {
+			return fmt.Errorf("invalid VIKUNJA_INSECURE flag: %s", insecure)
+		}
+		cfg.Insecure = s
+	}
+
+	cfg.DefaultProject = strings.TrimSpace(os.Getenv("VIKUNJA_DEFAULT_PROJECT"))
+
+	headers, err := parseExtraHeaders(os.Getenv("VIKUNJA_EXTRA_HEADERS"))
+	if err != nil {
+		return fmt.Errorf("invalid VIKUNJA_EXTRA_HEADERS: %w", err)
+	}
+	cfg.ExtraHeaders = headers
+
+	return loadConnectionPoolConfig(cfg)
+}
+
+// parseExtraHeaders parses a comma separated list of Name=value headers. Headers the client sets
+// itself, such as Authorization, are rejected rather than silently ignored.
+func parseExtraHeaders(value string) (http.Header, error) {
+	var headers http.Header
+	for _, entry := range strings.Split(value, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		name, val, found := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		switch {
+		case !found || name == "" || strings.ContainsAny(name, " \t:"):
+			return nil, fmt.Errorf("%q is not a Name=value header", strings.TrimSpace(entry))
+		case vikunja.IsReservedHeader(name):
+			return nil, fmt.Errorf("%s cannot be set, the client sets it itself", http.CanonicalHeaderKey(name))
+		}
+		if headers == nil {
+			headers = http.Header{}
+		}
+		headers.Add(name, strings.TrimSpace(val))
+	}
+	return headers, nil
+}
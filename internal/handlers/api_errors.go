@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// withAPIErrorHints adds what to check next to the error of a tool call Vikunja refused, such as
+// the API token when Vikunja does not accept it. A rejected token is reloaded, so a token rotated
+// since the client was built is used from the next call on.
+func withAPIErrorHints[In, Out any](h *Handlers, next mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		result, output, err := next(ctx, req, input)
+		hint := apiErrorHint(err)
+		if errors.Is(err, vikunja.ErrUnauthorized) {
+			if reloaded, _ := h.ReloadToken(); reloaded {
+				hint = "Vikunja rejected the API token; a rotated token has been loaded, so retry the call"
+			}
+		}
+		if hint == "" {
+			return result, output, err
+		}
+		err = fmt.Errorf("%w (%s)", err, hint)
+		return h.buildErrorResult(err.Error()), output, err
+	}
+}
+
+// apiErrorHint returns what to check for an error Vikunja answered with, or "" when there is
+// nothing more to suggest
+func apiErrorHint(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, vikunja.ErrUnauthorized):
+		return "Vikunja rejected the API token; check VIKUNJA_TOKEN"
+	case errors.Is(err, vikunja.ErrForbidden):
+		return "the API token may not access this; check the token's permissions and the project's sharing"
+	case errors.Is(err, vikunja.ErrNotFound):
+		return "it does not exist or is not visible to this token; check the ID, e.g. with discover_vikunja"
+	default:
+		return ""
+	}
+}
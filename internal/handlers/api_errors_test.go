@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAPIErrorHints(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		status   int
+		want     error
+		wantHint string
+	}{
+		{"unauthorized", http.StatusUnauthorized, vikunja.ErrUnauthorized, "check VIKUNJA_TOKEN"},
+		{"forbidden", http.StatusForbidden, vikunja.ErrForbidden, "check the token's permissions"},
+		{"not found", http.StatusNotFound, vikunja.ErrNotFound, "does not exist or is not visible"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"message":"nope"}`) //nolint:errcheck
+			})
+			handler := withAPIErrorHints(h, h.getTaskHandler)
+
+			result, _, err := handler(t.Context(), nil, GetTaskInput{TaskID: "7"})
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.want)
+			assert.Contains(t, err.Error(), tt.wantHint)
+			require.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, tt.wantHint)
+		})
+	}
+}
+
+func TestWithAPIErrorHints_LeavesOtherErrors(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+	handler := withAPIErrorHints(h, h.getTaskHandler)
+
+	result, _, err := handler(t.Context(), nil, GetTaskInput{TaskID: "abc"})
+	require.Error(t, err)
+	require.True(t, result.IsError)
+	assert.NotContains(t, result.Content[0].(*mcp.TextContent).Text, "check")
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// getBoardHandler handles the get_board tool. Unlike list_tasks it returns each task in full,
+// including its description, due date and priority, and marks the view's done bucket.
+func (h *Handlers) getBoardHandler(ctx context.Context, _ *mcp.CallToolRequest, input GetBoardInput) (*mcp.CallToolResult, GetBoardOutput, error) {
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, GetBoardOutput{}, err
+	}
+
+	project, projectID, err := h.resolveProjectByValue(ctx, client, input.Project)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetBoardOutput{}, err
+	}
+
+	view, err := h.resolveView(ctx, client, projectID, input.View)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetBoardOutput{}, err
+	}
+
+	bucketID, bucketTitle, err := h.resolveBucketByValue(ctx, client, projectID, view.ID, input.Bucket)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetBoardOutput{}, err
+	}
+
+	response, err := h.getViewTasks(ctx, client, projectID, view, bucketID, bucketTitle)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetBoardOutput{}, err
+	}
+
+	vt := buildBoard(view, response)
+	data, err := h.deps.OutputFormatter.Format(vt)
+	if err != nil {
+		return nil, GetBoardOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(data)},
+		},
+	}, GetBoardOutput{Project: project, Board: toBoard(vt)}, nil
+}
+
+// buildBoard groups a view's tasks by bucket. Views without buckets are shown as a single
+// "All Tasks" bucket, as list_tasks does.
+func buildBoard(view *vikunja.ProjectView, response *vikunja.ViewTasksResponse) *vikunja.ViewTasks {
+	vt := &vikunja.ViewTasks{
+		ViewID:    view.ID,
+		ViewTitle: view.Title,
+		Buckets:   make([]vikunja.BucketTasks, 0, len(response.Buckets)),
+	}
+
+	if len(response.Buckets) == 0 {
+		vt.Buckets = append(vt.Buckets, vikunja.BucketTasks{
+			Bucket: vikunja.Bucket{Title: "All Tasks"},
+			Tasks:  response.Tasks,
+		})
+		return vt
+	}
+
+	for _, b := range response.Buckets {
+		vt.Buckets = append(vt.Buckets, vikunja.BucketTasks{
+			Bucket:       *b,
+			Tasks:        b.Tasks,
+			IsDoneBucket: view.DoneBucketID != 0 && b.ID == view.DoneBucketID,
+		})
+	}
+	return vt
+}
+
+// toBoard converts vikunja.ViewTasks into the schema-safe Board output
+func toBoard(vt *vikunja.ViewTasks) Board {
+	board := Board{
+		ViewID:    vt.ViewID,
+		ViewTitle: vt.ViewTitle,
+		Buckets:   make([]BoardBucket, 0, len(vt.Buckets)),
+	}
+	for i := range vt.Buckets {
+		board.Buckets = append(board.Buckets, toBoardBucket(&vt.Buckets[i]))
+	}
+	return board
+}
+
+// toBoardBucket converts a bucket with its tasks into the schema-safe BoardBucket output
+func toBoardBucket(bt *vikunja.BucketTasks) BoardBucket {
+	tasks := make([]Task, 0, len(bt.Tasks))
+	for _, t := range bt.Tasks {
+		tasks = append(tasks, toTask(t))
+	}
+	return BoardBucket{
+		Bucket:       toBucket(&bt.Bucket),
+		IsDoneBucket: bt.IsDoneBucket,
+		Tasks:        tasks,
+	}
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/sync/errgroup"
+)
+
+// boardSummaryHandler handles the board_summary tool. It counts the tasks in each bucket of every
+// kanban view of a project without listing the tasks themselves.
+func (h *Handlers) boardSummaryHandler(ctx context.Context, _ *mcp.CallToolRequest, input BoardSummaryInput) (*mcp.CallToolResult, BoardSummaryOutput, error) {
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, BoardSummaryOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	project, projectID, err := h.resolveProjectByValue(ctx, client, input.Project)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), BoardSummaryOutput{}, err
+	}
+
+	views, err := client.GetProjectViews(ctx, projectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), BoardSummaryOutput{}, fmt.Errorf("failed to get project views: %w", err)
+	}
+
+	summaries, err := summarizeKanbanViews(ctx, client, projectID, views)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), BoardSummaryOutput{}, err
+	}
+
+	result, err := h.formatResult(vikunja.BoardSummary{ProjectID: projectID, ProjectTitle: project.Title, Views: summaries})
+	if err != nil {
+		return nil, BoardSummaryOutput{}, err
+	}
+	return result, BoardSummaryOutput{Project: *project, Views: summaries}, nil
+}
+
+// summarizeKanbanViews counts the tasks of each kanban view among views, reading the views concurrently
+func summarizeKanbanViews(ctx context.Context, client *vikunja.Client, projectID int64, views []*vikunja.ProjectView) ([]vikunja.ViewSummary, error) {
+	var kanban []*vikunja.ProjectView
+	for _, v := range views {
+		if v.ViewKind == string(vikunja.ViewKindKanban) {
+			kanban = append(kanban, v)
+		}
+	}
+
+	summaries := make([]vikunja.ViewSummary, len(kanban))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(discoverViewFetches)
+	for i, view := range kanban {
+		g.Go(func() error {
+			response, err := client.GetViewTasks(ctx, projectID, view.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get tasks of view %q: %w", view.Title, err)
+			}
+			summaries[i] = vikunja.NewViewSummary(view, response.Buckets)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
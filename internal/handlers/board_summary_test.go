@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardSummaryHandler_CountsPerBucket(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/7":
+			fmt.Fprint(w, `{"id":7,"title":"Launch"}`) //nolint:errcheck
+		case "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":2,"title":"List","view_kind":"list"},{"id":3,"title":"Kanban","view_kind":"kanban","done_bucket_id":12}]`) //nolint:errcheck
+		case "/api/v1/projects/7/views/3/tasks":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","tasks":[{"id":1,"title":"Write report"},{"id":2,"title":"Book venue"}]},`+ //nolint:errcheck
+				`{"id":12,"title":"Done","tasks":[{"id":3,"title":"Pick a date","done":true}]}]`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+	result, output, err := h.boardSummaryHandler(t.Context(), nil, BoardSummaryInput{Project: "7"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Launch", output.Project.Title)
+	require.Len(t, output.Views, 1, "only kanban views are summarized")
+	view := output.Views[0]
+	assert.Equal(t, int64(3), view.ViewID)
+	assert.Equal(t, []vikunja.BucketCount{
+		{BucketID: 10, Title: "Todo", Tasks: 2},
+		{BucketID: 12, Title: "Done", Tasks: 1, IsDoneBucket: true},
+	}, view.Buckets)
+	assert.Equal(t, 3, view.Total)
+	assert.Equal(t, 1, view.Done)
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "| Todo | 2 |")
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// boardServer serves project 7 with kanban view 3, whose done bucket is 12
+func boardServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/7":
+			fmt.Fprint(w, `{"id":7,"title":"Board"}`) //nolint:errcheck
+		case "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":3,"title":"Kanban","project_id":7,"view_kind":"kanban","done_bucket_id":12}]`) //nolint:errcheck
+		case "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":12,"title":"Done"}]`) //nolint:errcheck
+		case "/api/v1/projects/7/views/3/tasks":
+			fmt.Fprint(w, `[`+ //nolint:errcheck
+				`{"id":10,"title":"Todo","tasks":[{"id":1,"title":"Write report","description":"Quarterly numbers","due_date":"2026-03-10T12:00:00Z","priority":3,"project_id":7}]},`+
+				`{"id":12,"title":"Done","tasks":[{"id":2,"title":"Book venue","done":true,"project_id":7}]}]`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestGetBoardHandler_ReturnsFullTasks(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, boardServer(t))
+
+	_, output, err := h.getBoardHandler(t.Context(), nil, GetBoardInput{Project: "7", View: "Kanban"})
+	require.NoError(t, err)
+
+	require.NotNil(t, output.Project)
+	assert.Equal(t, int64(7), output.Project.ID)
+	assert.Equal(t, int64(3), output.Board.ViewID)
+	require.Len(t, output.Board.Buckets, 2)
+
+	todo := output.Board.Buckets[0]
+	assert.Equal(t, "Todo", todo.Bucket.Title)
+	assert.False(t, todo.IsDoneBucket)
+	require.Len(t, todo.Tasks, 1)
+	assert.Equal(t, "Write report", todo.Tasks[0].Title)
+	assert.Equal(t, "Quarterly numbers", todo.Tasks[0].Description)
+	assert.Equal(t, "2026-03-10T12:00:00Z", todo.Tasks[0].DueDate)
+	assert.Equal(t, int64(3), todo.Tasks[0].Priority)
+
+	done := output.Board.Buckets[1]
+	assert.True(t, done.IsDoneBucket)
+	require.Len(t, done.Tasks, 1)
+	assert.True(t, done.Tasks[0].Done)
+}
+
+func TestGetBoardHandler_SingleBucket(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, boardServer(t))
+
+	_, output, err := h.getBoardHandler(t.Context(), nil, GetBoardInput{Project: "7", Bucket: "Done"})
+	require.NoError(t, err)
+
+	require.Len(t, output.Board.Buckets, 1)
+	assert.Equal(t, "Done", output.Board.Buckets[0].Bucket.Title)
+	assert.True(t, output.Board.Buckets[0].IsDoneBucket)
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// checkBucketLimit returns a warning when moving taskID into bucket would leave it holding more
+// tasks than its limit, counting the tasks it holds now. Buckets without a limit are not read.
+// With strict set the warning is returned as an error instead, so the move can be refused.
+func checkBucketLimit(ctx context.Context, client *vikunja.Client, projectID, viewID int64, bucket *vikunja.Bucket, taskID int64, strict bool) (string, error) {
+	if bucket.Limit == nil || *bucket.Limit <= 0 {
+		return "", nil
+	}
+
+	held, err := countBucketTasks(ctx, client, projectID, viewID, bucket.ID, taskID)
+	if err != nil {
+		return "", err
+	}
+	if int64(held) < *bucket.Limit {
+		return "", nil
+	}
+
+	warning := fmt.Sprintf("bucket %q already holds %d tasks and has a limit of %d; moving task %d exceeds it", bucket.Title, held, *bucket.Limit, taskID)
+	if strict {
+		return "", fmt.Errorf("WIP limit exceeded: %s", warning)
+	}
+	return warning, nil
+}
+
+// countBucketTasks counts the tasks in a bucket of a kanban view other than taskID, which does
+// not add to the bucket when it is moved to where it already is
+func countBucketTasks(ctx context.Context, client *vikunja.Client, projectID, viewID, bucketID, taskID int64) (int, error) {
+	response, err := client.GetViewTasks(ctx, projectID, viewID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count the tasks in bucket %d: %w", bucketID, err)
+	}
+
+	held := 0
+	for _, b := range response.Buckets {
+		if b.ID != bucketID {
+			continue
+		}
+		for _, t := range b.Tasks {
+			if t.ID != taskID {
+				held++
+			}
+		}
+	}
+	return held, nil
+}
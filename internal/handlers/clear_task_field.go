@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Task fields clear_task_field can remove
+const (
+	clearFieldDueDate   = "due_date"
+	clearFieldAssignees = "assignees"
+	clearFieldLabels    = "labels"
+)
+
+// unsetTaskDate is how Vikunja represents a date that is not set
+const unsetTaskDate = "0001-01-01T00:00:00Z"
+
+// clearableTaskFields returns the task fields clear_task_field can remove
+func clearableTaskFields() []string {
+	return []string{clearFieldDueDate, clearFieldAssignees, clearFieldLabels}
+}
+
+func validateClearTaskFieldInput(input ClearTaskFieldInput) (int64, error) {
+	var errs ValidationErrors
+	taskID, err := parseID("task_id", input.TaskID)
+	if err != nil {
+		errs.add(err)
+	}
+	if !slices.Contains(clearableTaskFields(), input.Field) {
+		errs.add(ValidationError{Field: "clear_field", Message: fmt.Sprintf("must be one of: %s. Got: %q", strings.Join(clearableTaskFields(), ", "), input.Field)})
+	}
+	return taskID, errs.err()
+}
+
+// clearTaskFieldHandler handles the clear_task_field tool
+func (h *Handlers) clearTaskFieldHandler(ctx context.Context, _ *mcp.CallToolRequest, input ClearTaskFieldInput) (*mcp.CallToolResult, ClearTaskFieldOutput, error) {
+	if h.isReadonlyFor("clear_task_field") {
+		return h.buildErrorResult("Operation not available in readonly mode"), ClearTaskFieldOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	taskID, err := validateClearTaskFieldInput(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ClearTaskFieldOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ClearTaskFieldOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	cleared, err := clearTaskField(ctx, client, taskID, input.Field)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ClearTaskFieldOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, ClearTaskFieldOutput{Planned: planned}, err
+	}
+
+	result, err := h.formatResult(cleared)
+	if err != nil {
+		return nil, ClearTaskFieldOutput{}, err
+	}
+	return result, ClearTaskFieldOutput{Task: toTask(cleared)}, nil
+}
+
+// clearTaskField removes field from a task and returns the task as stored afterwards. A dry-run
+// client returns no task for the relations, which are cleared without reading the task.
+func clearTaskField(ctx context.Context, client *vikunja.Client, taskID int64, field string) (*vikunja.Task, error) {
+	var err error
+	switch field {
+	case clearFieldDueDate:
+		// An omitted due date would also be cleared, but the zero date says so in the request
+		return updateStoredTask(ctx, client, taskID, func(task *vikunja.Task) error {
+			task.DueDate = unsetTaskDate
+			return nil
+		})
+	case clearFieldAssignees:
+		err = client.ClearTaskAssignees(ctx, taskID)
+	default:
+		err = client.ClearTaskLabels(ctx, taskID)
+	}
+	if err != nil || client.IsDryRun() {
+		return nil, err
+	}
+	return client.GetTask(ctx, taskID)
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clearableTaskServer serves task 5 with a due date, an assignee and a label, records the write
+// sent for it by path, and serves the task without them once anything has been written
+func clearableTaskServer(t *testing.T, sent map[string]map[string]any) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/5" && len(sent) == 0:
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","priority":3,"due_date":"2026-03-20T00:00:00Z","assignees":[{"id":2,"username":"alex"}],"labels":[{"id":7,"title":"urgent"}]}`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/5":
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","priority":3,"due_date":"0001-01-01T00:00:00Z"}`) //nolint:errcheck
+		case r.Method == http.MethodPost:
+			body := map[string]any{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			sent[r.URL.Path] = body
+			if strings.HasSuffix(r.URL.Path, "/bulk") {
+				w.WriteHeader(http.StatusCreated)
+			}
+			json.NewEncoder(w).Encode(body) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestClearTaskFieldHandler(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		field    string
+		wantPath string
+		check    func(t *testing.T, body map[string]any)
+	}{
+		{"due_date", "/api/v1/tasks/5", func(t *testing.T, body map[string]any) {
+			assert.Equal(t, "0001-01-01T00:00:00Z", body["due_date"])
+			assert.InDelta(t, 3, body["priority"], 0.0001, "unchanged fields must be sent back")
+		}},
+		{"assignees", "/api/v1/tasks/5/assignees/bulk", func(t *testing.T, body map[string]any) {
+			assert.Equal(t, map[string]any{"assignees": []any{}}, body)
+		}},
+		{"labels", "/api/v1/tasks/5/labels/bulk", func(t *testing.T, body map[string]any) {
+			assert.Equal(t, map[string]any{"labels": []any{}}, body)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			t.Parallel()
+			sent := map[string]map[string]any{}
+			h := newTestHandlers(t, nil, clearableTaskServer(t, sent))
+
+			_, output, err := h.clearTaskFieldHandler(t.Context(), nil, ClearTaskFieldInput{TaskID: "5", Field: tt.field})
+			require.NoError(t, err)
+
+			require.Len(t, sent, 1)
+			require.Contains(t, sent, tt.wantPath)
+			tt.check(t, sent[tt.wantPath])
+			assert.Equal(t, int64(5), output.Task.ID)
+		})
+	}
+}
+
+func TestClearTaskFieldHandler_DryRun(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+	h.deps.DryRun = true
+
+	_, output, err := h.clearTaskFieldHandler(t.Context(), nil, ClearTaskFieldInput{TaskID: "5", Field: "labels"})
+	require.NoError(t, err)
+
+	require.Len(t, output.Planned, 1)
+	assert.Equal(t, http.MethodPost, output.Planned[0].Method)
+	assert.Equal(t, "/api/v1/tasks/5/labels/bulk", output.Planned[0].Endpoint)
+}
+
+func TestClearTaskFieldHandler_Rejected(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		input   ClearTaskFieldInput
+		wantErr string
+	}{
+		{"readonly", &config.Config{Readonly: true}, ClearTaskFieldInput{TaskID: "5", Field: "labels"}, "readonly mode"},
+		{"unknown field", nil, ClearTaskFieldInput{TaskID: "5", Field: "title"}, "clear_field: must be one of: due_date, assignees, labels"},
+		{"missing task", nil, ClearTaskFieldInput{Field: "labels"}, "task_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, tt.cfg, unexpectedRequest(t))
+
+			result, _, err := h.clearTaskFieldHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.True(t, result.IsError)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var hexColorPattern = regexp.MustCompile(`^#?[0-9a-fA-F]{6}$`)
+
+// parseHexColor validates a #rrggbb or rrggbb color and returns it the way Vikunja stores it:
+// lower case and without the leading #
+func parseHexColor(fieldName, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", ValidationError{Field: fieldName, Message: "is required"}
+	}
+	if !hexColorPattern.MatchString(value) {
+		return "", ValidationError{Field: fieldName, Message: fmt.Sprintf("must be a hex color like #1973ff or 1973ff, got: %q", value)}
+	}
+	return strings.ToLower(strings.TrimPrefix(value, "#")), nil
+}
+
+// setTaskColorHandler handles the set_task_color tool
+func (h *Handlers) setTaskColorHandler(ctx context.Context, _ *mcp.CallToolRequest, input SetTaskColorInput) (*mcp.CallToolResult, SetTaskColorOutput, error) {
+	if h.isReadonlyFor("set_task_color") {
+		return h.buildErrorResult("Operation not available in readonly mode"), SetTaskColorOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	taskID, err := parseID("task_id", input.TaskID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskColorOutput{}, err
+	}
+	color, err := parseHexColor("hex_color", input.HexColor)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskColorOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, SetTaskColorOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	updated, err := updateStoredTask(ctx, client, taskID, func(task *vikunja.Task) error {
+		task.HexColor = color
+		return nil
+	})
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskColorOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, SetTaskColorOutput{Planned: planned}, err
+	}
+
+	result, err := h.formatResult(updated)
+	if err != nil {
+		return nil, SetTaskColorOutput{}, err
+	}
+	return result, SetTaskColorOutput{Task: toTask(updated)}, nil
+}
+
+// setProjectColorHandler handles the set_project_color tool
+func (h *Handlers) setProjectColorHandler(ctx context.Context, _ *mcp.CallToolRequest, input SetProjectColorInput) (*mcp.CallToolResult, SetProjectColorOutput, error) {
+	if h.isReadonlyFor("set_project_color") {
+		return h.buildErrorResult("Operation not available in readonly mode"), SetProjectColorOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	if err := validateRequiredString("project", input.Project); err != nil {
+		return h.buildErrorResult(err.Error()), SetProjectColorOutput{}, err
+	}
+	color, err := parseHexColor("hex_color", input.HexColor)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetProjectColorOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, SetProjectColorOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	// Vikunja replaces the whole project on update, so start from the stored project
+	project, err := h.getProjectByValue(ctx, client, input.Project)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetProjectColorOutput{}, err
+	}
+	project.HexColor = color
+	updated, err := client.UpdateProject(ctx, project)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetProjectColorOutput{}, err
+	}
+	h.projects.invalidate()
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, SetProjectColorOutput{Planned: planned}, err
+	}
+
+	result, err := h.formatResult(updated)
+	if err != nil {
+		return nil, SetProjectColorOutput{}, err
+	}
+	return result, SetProjectColorOutput{Project: toProject(updated)}, nil
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHexColor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"#1973FF", "1973ff", false},
+		{"1973ff", "1973ff", false},
+		{" #e8e8e8 ", "e8e8e8", false},
+		{"", "", true},
+		{"#fff", "", true},
+		{"1973ffaa", "", true},
+		{"##1973ff", "", true},
+		{"#19g3ff", "", true},
+		{"blue", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseHexColor("hex_color", tt.value)
+			if tt.wantErr {
+				var validationErr ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, "hex_color", validationErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetTaskColorHandler(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/5":
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","description":"keep me","project_id":1}`) //nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tasks/5":
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","description":"keep me","project_id":1,"hex_color":"1973ff"}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.setTaskColorHandler(t.Context(), nil, SetTaskColorInput{TaskID: "5", HexColor: "#1973FF"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "1973ff", sent["hex_color"])
+	assert.Equal(t, "keep me", sent["description"], "unchanged fields must be sent back")
+	assert.Equal(t, "1973ff", output.Task.HexColor)
+}
+
+func TestSetTaskColorHandler_InvalidColor(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.setTaskColorHandler(t.Context(), nil, SetTaskColorInput{TaskID: "5", HexColor: "#12345"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hex_color: must be a hex color")
+	assert.True(t, result.IsError)
+}
+
+func TestSetProjectColorHandler_Readonly(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{Readonly: true}, unexpectedRequest(t))
+
+	result, _, err := h.setProjectColorHandler(t.Context(), nil, SetProjectColorInput{Project: "7", HexColor: "1973ff"})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestGetProjectHandler_HexColor(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":1,"title":"Inbox"},{"id":7,"title":"Board"}]`) //nolint:errcheck
+		case "/api/v1/projects/7":
+			fmt.Fprint(w, `{"id":7,"title":"Board","hex_color":"e8e8e8"}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.getProjectHandler(t.Context(), nil, GetProjectInput{Project: "Board"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(7), output.Project.ID)
+	assert.Equal(t, "e8e8e8", output.Project.HexColor)
+}
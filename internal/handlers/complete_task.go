@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// completeTaskHandler handles the complete_task tool
+func (h *Handlers) completeTaskHandler(ctx context.Context, _ *mcp.CallToolRequest, input CompleteTaskInput) (*mcp.CallToolResult, CompleteTaskOutput, error) {
+	if h.isReadonlyFor("complete_task") {
+		return h.buildErrorResult("Operation not available in readonly mode"), CompleteTaskOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	taskID, err := parseID("task_id", input.TaskID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CompleteTaskOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, CompleteTaskOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	task, err := client.GetTask(ctx, taskID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CompleteTaskOutput{}, err
+	}
+	// The done bucket is looked up before the task changes, so a view without one fails cleanly
+	view, err := h.doneBucketView(ctx, client, task.ProjectID, input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CompleteTaskOutput{}, err
+	}
+
+	updated, err := h.completeTask(ctx, client, task, view)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CompleteTaskOutput{}, err
+	}
+	h.taskCounts.invalidate(task.ProjectID)
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, CompleteTaskOutput{Planned: planned}, err
+	}
+
+	result, err := h.formatResult(updated)
+	if err != nil {
+		return nil, CompleteTaskOutput{}, err
+	}
+	output := CompleteTaskOutput{Task: toTask(updated)}
+	if view != nil {
+		output.DoneBucketID = view.DoneBucketID
+	}
+	return result, output, nil
+}
+
+// doneBucketView returns the view whose done bucket the completed task moves to, or nil when the
+// call did not ask for the move
+func (h *Handlers) doneBucketView(ctx context.Context, client *vikunja.Client, projectID int64, input CompleteTaskInput) (*vikunja.ProjectView, error) {
+	if !input.MoveToDoneBucket {
+		return nil, nil
+	}
+
+	view, err := h.resolveView(ctx, client, projectID, input.View)
+	if err != nil {
+		return nil, err
+	}
+	if view.DoneBucketID == 0 {
+		return nil, fmt.Errorf("view %q (ID: %d) has no done bucket. Try: update_view() to choose one", view.Title, view.ID)
+	}
+	return view, nil
+}
+
+// completeTask marks task done and, when a view is given, moves it to the view's done bucket
+func (h *Handlers) completeTask(ctx context.Context, client *vikunja.Client, task *vikunja.Task, view *vikunja.ProjectView) (*vikunja.Task, error) {
+	task.Done = true
+	updated, err := client.UpdateTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if view == nil {
+		return updated, nil
+	}
+
+	if _, err := client.MoveTaskToBucket(ctx, task.ProjectID, view.ID, view.DoneBucketID, task.ID); err != nil {
+		return nil, fmt.Errorf("task %d was marked done but not moved to done bucket %d: %w", task.ID, view.DoneBucketID, err)
+	}
+	updated.BucketID = view.DoneBucketID
+	return updated, nil
+}
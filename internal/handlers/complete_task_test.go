@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// completeTaskServer serves task 42 of project 7, whose Kanban view 3 has done bucket 12 and whose
+// List view 4 has none, and records the writes sent to it
+func completeTaskServer(t *testing.T, writes *[]string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/42":
+			fmt.Fprint(w, `{"id":42,"title":"Finish me","project_id":7,"bucket_id":10}`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":3,"title":"Kanban","view_kind":"kanban","done_bucket_id":12},`+ //nolint:errcheck
+				`{"id":4,"title":"List","view_kind":"list"}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tasks/42":
+			var task map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&task))
+			assert.Equal(t, true, task["done"])
+			*writes = append(*writes, r.URL.Path)
+			json.NewEncoder(w).Encode(task) //nolint:errcheck,gosec
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/projects/7/views/3/buckets/12/tasks":
+			body, _ := io.ReadAll(r.Body) //nolint:errcheck
+			assert.Contains(t, string(body), `"task_id":42`)
+			*writes = append(*writes, r.URL.Path)
+			fmt.Fprint(w, `{"task_id":42,"bucket_id":12,"project_view_id":3}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestCompleteTaskHandler(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		input          CompleteTaskInput
+		wantWrites     []string
+		wantDoneBucket int64
+	}{
+		{"done only", CompleteTaskInput{TaskID: "42"}, []string{"/api/v1/tasks/42"}, 0},
+		{
+			"moved to done bucket", CompleteTaskInput{TaskID: "42", MoveToDoneBucket: true, View: "3"},
+			[]string{"/api/v1/tasks/42", "/api/v1/projects/7/views/3/buckets/12/tasks"}, 12,
+		},
+		{
+			"default view", CompleteTaskInput{TaskID: "42", MoveToDoneBucket: true},
+			[]string{"/api/v1/tasks/42", "/api/v1/projects/7/views/3/buckets/12/tasks"}, 12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var writes []string
+			h := newTestHandlers(t, nil, completeTaskServer(t, &writes))
+
+			_, output, err := h.completeTaskHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantWrites, writes)
+			assert.True(t, output.Task.Done)
+			assert.Equal(t, tt.wantDoneBucket, output.DoneBucketID)
+		})
+	}
+}
+
+func TestCompleteTaskHandler_Rejected(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		input   CompleteTaskInput
+		wantErr string
+	}{
+		{"readonly", &config.Config{Readonly: true}, CompleteTaskInput{TaskID: "42"}, "readonly"},
+		{"invalid task", nil, CompleteTaskInput{TaskID: "first"}, "task_id"},
+		{"view without done bucket", nil, CompleteTaskInput{TaskID: "42", MoveToDoneBucket: true, View: "List"}, `view "List" (ID: 4) has no done bucket`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var writes []string
+			h := newTestHandlers(t, tt.cfg, completeTaskServer(t, &writes))
+
+			result, _, err := h.completeTaskHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, writes, "a rejected call changes nothing")
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// countTasksHandler handles the count_tasks tool
+func (h *Handlers) countTasksHandler(ctx context.Context, _ *mcp.CallToolRequest, input CountTasksInput) (*mcp.CallToolResult, CountTasksOutput, error) {
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, CountTasksOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	project, err := h.findProjectByIDOrTitle(ctx, client, input.ProjectID, input.ProjectTitle)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CountTasksOutput{}, err
+	}
+
+	output := CountTasksOutput{Project: *project}
+	for _, done := range countedDoneStates(input.Done) {
+		count, err := client.CountTasks(ctx, fmt.Sprintf("project = %d && done = %t", project.ID, done))
+		if err != nil {
+			return h.buildErrorResult(err.Error()), CountTasksOutput{}, err
+		}
+		output.Total += count
+		if done {
+			output.Done = &count
+		} else {
+			output.Open = &count
+		}
+	}
+
+	data, err := h.deps.OutputFormatter.Format(vikunja.TaskCounts{
+		ProjectID:    project.ID,
+		ProjectTitle: project.Title,
+		Total:        output.Total,
+		Done:         output.Done,
+		Open:         output.Open,
+	})
+	if err != nil {
+		return nil, CountTasksOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// countedDoneStates returns the done states to count: the requested one, or both
+func countedDoneStates(done *bool) []bool {
+	if done != nil {
+		return []bool{*done}
+	}
+	return []bool{true, false}
+}
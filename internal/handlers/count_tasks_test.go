@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countServer answers task counts for project 7 from the pagination header only
+func countServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tasks" || r.URL.Query().Get("per_page") != "1" {
+			t.Errorf("unexpected request: %s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery)
+		}
+		total := "4"
+		if strings.HasSuffix(r.URL.Query().Get("filter"), "done = true") {
+			total = "9"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-pagination-total-pages", total)
+		w.Write([]byte(`[]`)) //nolint:errcheck
+	}
+}
+
+func TestCountTasksHandler(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, countServer(t))
+	h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+	result, output, err := h.countTasksHandler(t.Context(), nil, CountTasksInput{ProjectID: "7"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(13), output.Total)
+	require.NotNil(t, output.Done)
+	require.NotNil(t, output.Open)
+	assert.Equal(t, int64(9), *output.Done)
+	assert.Equal(t, int64(4), *output.Open)
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.Contains(t, text, "- **Total**: 13\n")
+}
+
+func TestCountTasksHandler_OnlyOpen(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, countServer(t))
+	done := false
+
+	_, output, err := h.countTasksHandler(t.Context(), nil, CountTasksInput{ProjectID: "7", Done: &done})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(4), output.Total)
+	assert.Nil(t, output.Done)
+	require.NotNil(t, output.Open)
+}
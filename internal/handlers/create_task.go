@@ -13,7 +13,7 @@ import (
 
 // createTaskHandler handles the create_task tool
 func (h *Handlers) createTaskHandler(ctx context.Context, _ *mcp.CallToolRequest, input CreateTaskInput) (*mcp.CallToolResult, CreateTaskOutput, error) {
-	if h.isReadonly() {
+	if h.isReadonlyFor("create_task") {
 		return h.buildErrorResult("Operation not available in readonly mode"), CreateTaskOutput{}, fmt.Errorf("operation not available in readonly mode")
 	}
 
@@ -21,9 +21,9 @@ func (h *Handlers) createTaskHandler(ctx context.Context, _ *mcp.CallToolRequest
 		return h.buildErrorResult(err.Error()), CreateTaskOutput{}, err
 	}
 
-	client := h.deps.Client
-	if client == nil {
-		return h.buildErrorResult("Vikunja client not configured"), CreateTaskOutput{}, fmt.Errorf("client not configured")
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, CreateTaskOutput{}, fmt.Errorf("failed to create client: %w", err)
 	}
 
 	project, err := resolution.ResolveProject(ctx, client, input.ProjectID)
@@ -31,32 +31,62 @@ func (h *Handlers) createTaskHandler(ctx context.Context, _ *mcp.CallToolRequest
 		return h.buildErrorResult(err.Error()), CreateTaskOutput{}, err
 	}
 
-	bucketID, err := h.resolveBucketForTask(ctx, client, project.ID, input.BucketID)
+	bucketID, placement, err := h.resolveCreateTaskBuckets(ctx, client, project.ID, input)
 	if err != nil {
 		return h.buildErrorResult(err.Error()), CreateTaskOutput{}, err
 	}
 
-	task, err := h.createTask(ctx, client, input, project.ID, bucketID)
+	task, err := h.createTask(ctx, client, input, project.ID, bucketID, placement)
 	if err != nil {
 		return h.buildErrorResult(err.Error()), CreateTaskOutput{}, err
 	}
+	h.taskCounts.invalidate(project.ID)
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, CreateTaskOutput{Planned: planned}, err
+	}
 
 	return h.formatTaskOutput(task)
 }
 
 func validateCreateTaskInput(input CreateTaskInput) error {
-	if err := validateRequiredString("title", input.Title); err != nil {
-		return err
-	}
-	if err := validateRequiredString("project_id", input.ProjectID); err != nil {
-		return err
-	}
+	var errs ValidationErrors
+	errs.add(validateRequiredString("title", input.Title))
+	errs.add(validateRequiredString("project_id", input.ProjectID))
 	if input.BucketID != "" {
 		if id, err := strconv.ParseInt(input.BucketID, 10, 64); err == nil && id <= 0 {
-			return ValidationError{Field: "bucket_id", Message: "must be a positive integer"}
+			errs.add(ValidationError{Field: "bucket_id", Message: "must be a positive integer"})
 		}
 	}
-	return nil
+	if input.BucketID != "" && input.Bucket != "" {
+		errs.add(ValidationError{Field: "bucket", Message: "specify either bucket_id or bucket, not both"})
+	}
+	return errs.err()
+}
+
+// taskPlacement is the Kanban bucket a task is moved to once it has been created
+type taskPlacement struct {
+	viewID   int64
+	bucketID int64
+}
+
+// resolveCreateTaskBuckets resolves both ways of choosing a new task's bucket before the task is
+// created, so an unknown bucket fails the call without leaving a task behind
+func (h *Handlers) resolveCreateTaskBuckets(ctx context.Context, client *vikunja.Client, projectID int64, input CreateTaskInput) (*int64, *taskPlacement, error) {
+	bucketID, err := h.resolveBucketForTask(ctx, client, projectID, input.BucketID)
+	if err != nil || input.Bucket == "" {
+		return bucketID, nil, err
+	}
+	view, err := resolution.FindKanbanView(ctx, client, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	bucket, err := resolution.ResolveBucket(ctx, client, projectID, view.ID, input.Bucket)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, &taskPlacement{viewID: view.ID, bucketID: bucket.ID}, nil
 }
 
 func (h *Handlers) resolveBucketForTask(ctx context.Context, client *vikunja.Client, projectID int64, bucketID string) (*int64, error) {
@@ -70,8 +100,26 @@ func (h *Handlers) resolveBucketForTask(ctx context.Context, client *vikunja.Cli
 	return bucket, nil
 }
 
-func (h *Handlers) createTask(ctx context.Context, client *vikunja.Client, input CreateTaskInput, projectID int64, bucketID *int64) (*vikunja.Task, error) {
-	return client.CreateTask(ctx, input.Title, projectID, input.Description, bucketID, time.Time{})
+// createTask creates the task and, when a bucket was asked for, moves it there and re-reads it so
+// the output reports where it landed rather than where it was asked to go
+func (h *Handlers) createTask(ctx context.Context, client *vikunja.Client, input CreateTaskInput, projectID int64, bucketID *int64, placement *taskPlacement) (*vikunja.Task, error) {
+	task, err := client.CreateTask(ctx, input.Title, projectID, input.Description, bucketID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	if placement != nil {
+		if _, err := client.MoveTaskToBucket(ctx, projectID, placement.viewID, placement.bucketID, task.ID); err != nil {
+			return nil, fmt.Errorf("task %d was created but not moved to bucket %d: %w", task.ID, placement.bucketID, err)
+		}
+	}
+	if (placement == nil && bucketID == nil) || client.IsDryRun() {
+		return task, nil
+	}
+	if placed, err := client.GetTask(ctx, task.ID, vikunja.TaskExpandBuckets); err == nil {
+		return placed, nil
+	}
+	// the task exists either way; only its reported placement is missing
+	return task, nil
 }
 
 func (h *Handlers) formatTaskOutput(task *vikunja.Task) (*mcp.CallToolResult, CreateTaskOutput, error) {
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createInBucketServer creates task 101 in project 7, whose kanban view 3 has the buckets Todo (10)
+// and Doing (11); the task starts in Todo and the paths of all writes are recorded
+func createInBucketServer(t *testing.T, writes *[]string) http.HandlerFunc {
+	t.Helper()
+	bucket := `{"id":10,"title":"Todo","project_view_id":3}`
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/projects/7/tasks":
+			*writes = append(*writes, r.URL.Path)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":101,"title":"Write report","project_id":7}`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":2,"title":"List","view_kind":"list"},{"id":3,"title":"Kanban","view_kind":"kanban"}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":11,"title":"Doing"}]`) //nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/projects/7/views/3/buckets/11/tasks":
+			*writes = append(*writes, r.URL.Path)
+			bucket = `{"id":11,"title":"Doing","project_view_id":3}`
+			fmt.Fprint(w, `{"task_id":101,"bucket_id":11,"project_view_id":3}`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/101":
+			assert.Equal(t, "buckets", r.URL.Query().Get("expand"))
+			fmt.Fprintf(w, `{"id":101,"title":"Write report","project_id":7,"buckets":[%s]}`, bucket) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestCreateTaskHandler_MovesToBucket(t *testing.T) {
+	t.Parallel()
+	var writes []string
+	h := newTestHandlers(t, nil, createInBucketServer(t, &writes))
+
+	_, output, err := h.createTaskHandler(t.Context(), nil, CreateTaskInput{Title: "Write report", ProjectID: "7", Bucket: "Doing"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/api/v1/projects/7/tasks", "/api/v1/projects/7/views/3/buckets/11/tasks"}, writes)
+	assert.Equal(t, int64(101), output.Task.ID)
+	require.Len(t, output.Task.Buckets, 1)
+	assert.Equal(t, Bucket{ID: 11, ProjectViewID: 3, Title: "Doing"}, output.Task.Buckets[0])
+}
+
+func TestCreateTaskHandler_UnknownBucketCreatesNothing(t *testing.T) {
+	t.Parallel()
+	var writes []string
+	h := newTestHandlers(t, nil, createInBucketServer(t, &writes))
+
+	result, _, err := h.createTaskHandler(t.Context(), nil, CreateTaskInput{Title: "Write report", ProjectID: "7", Bucket: "Archive"})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), `bucket with title "Archive" not found in view 3`)
+	assert.Empty(t, writes)
+}
+
+func TestCreateTaskHandler_BucketAndBucketID(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	_, _, err := h.createTaskHandler(t.Context(), nil, CreateTaskInput{Title: "Write report", ProjectID: "7", BucketID: "10", Bucket: "Doing"})
+	require.EqualError(t, err, "bucket: specify either bucket_id or bucket, not both")
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// createTasksInBucketsHandler handles the create_tasks_in_buckets tool. The view's buckets are read
+// once up front; an item whose bucket is unknown, or that fails to create or move, is reported and
+// the rest are still created.
+func (h *Handlers) createTasksInBucketsHandler(ctx context.Context, _ *mcp.CallToolRequest, input CreateTasksInBucketsInput) (*mcp.CallToolResult, CreateTasksInBucketsOutput, error) {
+	if h.isReadonlyFor("create_tasks_in_buckets") {
+		return h.buildErrorResult("Operation not available in readonly mode"), CreateTasksInBucketsOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	if err := validateCreateTasksInBucketsInput(input); err != nil {
+		return h.buildErrorResult(err.Error()), CreateTasksInBucketsOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, CreateTasksInBucketsOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	project, err := resolution.ResolveProject(ctx, client, input.ProjectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateTasksInBucketsOutput{}, err
+	}
+
+	board, err := resolveBoard(ctx, client, project.ID, input.ViewID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateTasksInBucketsOutput{}, err
+	}
+
+	output := board.createTasks(ctx, client, input.Tasks)
+	h.taskCounts.invalidate(project.ID)
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, CreateTasksInBucketsOutput{ProjectID: project.ID, ViewID: board.viewID, Errors: output.Errors, Planned: planned}, err
+	}
+
+	result, err := h.formatResult(output)
+	if err != nil {
+		return nil, CreateTasksInBucketsOutput{}, err
+	}
+	return result, output, nil
+}
+
+// validateCreateTasksInBucketsInput checks the call as a whole; items are validated one by one as they are created
+func validateCreateTasksInBucketsInput(input CreateTasksInBucketsInput) error {
+	var errs ValidationErrors
+	errs.add(validateRequiredString("project_id", input.ProjectID))
+	errs.add(validateRequiredString("view_id", input.ViewID))
+	switch {
+	case len(input.Tasks) == 0:
+		errs.add(ValidationError{Field: "tasks", Message: "at least one task is required"})
+	case len(input.Tasks) > maxImportTasks:
+		errs.add(ValidationError{Field: "tasks", Message: fmt.Sprintf("at most %d tasks can be created at once, got: %d", maxImportTasks, len(input.Tasks))})
+	}
+	return errs.err()
+}
+
+// board is a kanban view whose buckets are indexed by title
+type board struct {
+	projectID int64
+	viewID    int64
+	buckets   []*vikunja.Bucket
+	byTitle   map[string]*vikunja.Bucket
+}
+
+// resolveBoard reads the buckets of a project's kanban view, named by ID or title
+func resolveBoard(ctx context.Context, client *vikunja.Client, projectID int64, viewID string) (*board, error) {
+	view, err := resolution.ResolveView(ctx, client, projectID, viewID)
+	if err != nil {
+		return nil, err
+	}
+	if view.ViewKind != string(vikunja.ViewKindKanban) {
+		return nil, fmt.Errorf("view %d is a %s view; tasks can only be placed in the buckets of a kanban view", view.ID, view.ViewKind)
+	}
+
+	buckets, err := client.GetViewBuckets(ctx, projectID, view.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get view buckets: %w", err)
+	}
+	b := &board{projectID: projectID, viewID: view.ID, buckets: buckets, byTitle: make(map[string]*vikunja.Bucket, len(buckets))}
+	for _, bucket := range buckets {
+		if _, seen := b.byTitle[bucket.Title]; !seen {
+			b.byTitle[bucket.Title] = bucket
+		}
+	}
+	return b, nil
+}
+
+// createTasks creates each item in its bucket, collecting the placed tasks and the failed items
+func (b *board) createTasks(ctx context.Context, client *vikunja.Client, items []BucketTaskItem) CreateTasksInBucketsOutput {
+	output := CreateTasksInBucketsOutput{ProjectID: b.projectID, ViewID: b.viewID, Created: []BucketTask{}}
+	for i, item := range items {
+		placed, err := b.createTask(ctx, client, item)
+		if err != nil {
+			output.Errors = append(output.Errors, ImportTaskError{Index: i, Title: item.Title, Error: err.Error()})
+			continue
+		}
+		placed.Index = i
+		output.Created = append(output.Created, *placed)
+	}
+	return output
+}
+
+// createTask validates one item, creates its task and moves the task to the item's bucket, warning
+// when the move exceeds the bucket's limit
+func (b *board) createTask(ctx context.Context, client *vikunja.Client, item BucketTaskItem) (*BucketTask, error) {
+	var errs ValidationErrors
+	errs.add(validateRequiredString("title", item.Title))
+	errs.add(validateRequiredString("bucket_title", item.BucketTitle))
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	bucket, ok := b.byTitle[item.BucketTitle]
+	if !ok {
+		return nil, enhancedBucketTitleNotFoundError(item.BucketTitle, b.viewID, bucketLabels(b.buckets))
+	}
+
+	task, err := client.CreateTask(ctx, item.Title, b.projectID, "", nil, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	warning, err := checkBucketLimit(ctx, client, b.projectID, b.viewID, bucket, task.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("task %d was created but not moved to bucket %q: %w", task.ID, bucket.Title, err)
+	}
+	if _, err := client.MoveTaskToBucket(ctx, b.projectID, b.viewID, bucket.ID, task.ID); err != nil {
+		return nil, fmt.Errorf("task %d was created but not moved to bucket %q: %w", task.ID, bucket.Title, err)
+	}
+	return &BucketTask{ID: task.ID, Title: task.Title, URI: vikunja.TaskURI(task.ID), BucketID: bucket.ID, BucketTitle: bucket.Title, Warning: warning}, nil
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scaffoldServer serves project 7 with list view 2 and kanban view 3, whose buckets are Todo (10) and
+// Doing (11). Created tasks are numbered from 101 and the paths of posted moves are recorded.
+func scaffoldServer(t *testing.T, moves *[]string) http.HandlerFunc {
+	t.Helper()
+	created, bucketReads := 0, 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":2,"title":"List","view_kind":"list"},{"id":3,"title":"Kanban","view_kind":"kanban"}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			bucketReads++
+			assert.Equal(t, 1, bucketReads, "buckets must be read once")
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":11,"title":"Doing"}]`) //nolint:errcheck
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/projects/7/tasks":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			created++
+			body["id"] = 100 + created
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(body) //nolint:errcheck
+		case r.Method == http.MethodPost:
+			*moves = append(*moves, r.URL.Path)
+			fmt.Fprint(w, `{}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestCreateTasksInBucketsHandler_UnknownBucketFailsOnlyItsItem(t *testing.T) {
+	t.Parallel()
+	var moves []string
+	h := newTestHandlers(t, nil, scaffoldServer(t, &moves))
+
+	_, output, err := h.createTasksInBucketsHandler(t.Context(), nil, CreateTasksInBucketsInput{
+		ProjectID: "7",
+		ViewID:    "Kanban",
+		Tasks: []BucketTaskItem{
+			{Title: "Write report", BucketTitle: "Todo"},
+			{Title: "Ship it", BucketTitle: "Released"},
+			{Title: "Review draft", BucketTitle: "Doing"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), output.ViewID)
+	require.Len(t, output.Created, 2)
+	assert.Equal(t, BucketTask{Index: 0, ID: 101, Title: "Write report", URI: "vikunja://task/101", BucketID: 10, BucketTitle: "Todo"}, output.Created[0])
+	assert.Equal(t, BucketTask{Index: 2, ID: 102, Title: "Review draft", URI: "vikunja://task/102", BucketID: 11, BucketTitle: "Doing"}, output.Created[1])
+
+	require.Len(t, output.Errors, 1)
+	assert.Equal(t, 1, output.Errors[0].Index)
+	assert.Contains(t, output.Errors[0].Error, `bucket with title "Released" not found in view 3`)
+
+	assert.Equal(t, []string{"/api/v1/projects/7/views/3/buckets/10/tasks", "/api/v1/projects/7/views/3/buckets/11/tasks"}, moves)
+}
+
+func TestCreateTasksInBucketsHandler_RequiresKanbanView(t *testing.T) {
+	t.Parallel()
+	var moves []string
+	h := newTestHandlers(t, nil, scaffoldServer(t, &moves))
+
+	result, _, err := h.createTasksInBucketsHandler(t.Context(), nil, CreateTasksInBucketsInput{
+		ProjectID: "7",
+		ViewID:    "2",
+		Tasks:     []BucketTaskItem{{Title: "Write report", BucketTitle: "Todo"}},
+	})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "view 2 is a list view")
+}
+
+func TestCreateTasksInBucketsHandler_Readonly(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{Readonly: true}, unexpectedRequest(t))
+
+	_, _, err := h.createTasksInBucketsHandler(t.Context(), nil, CreateTasksInBucketsInput{ProjectID: "7", ViewID: "3"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "readonly mode")
+}
+
+func TestCreateTasksInBucketsHandler_WarnsPastBucketLimit(t *testing.T) {
+	t.Parallel()
+	var moves []string
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":3,"title":"Kanban","view_kind":"kanban"}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":11,"title":"Doing","limit":1}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/tasks":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","tasks":[]},{"id":11,"title":"Doing","tasks":[{"id":5,"title":"Busy"}]}]`) //nolint:errcheck
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/projects/7/tasks":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":101,"title":"Review draft","project_id":7}`) //nolint:errcheck
+		case r.Method == http.MethodPost:
+			moves = append(moves, r.URL.Path)
+			fmt.Fprint(w, `{}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.createTasksInBucketsHandler(t.Context(), nil, CreateTasksInBucketsInput{
+		ProjectID: "7",
+		ViewID:    "Kanban",
+		Tasks:     []BucketTaskItem{{Title: "Review draft", BucketTitle: "Doing"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/api/v1/projects/7/views/3/buckets/11/tasks"}, moves, "the task is still placed")
+	require.Len(t, output.Created, 1)
+	assert.Contains(t, output.Created[0].Warning, `bucket "Doing" already holds 1 tasks and has a limit of 1`)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// defaultProjectCache remembers the project tools fall back to when a call names none, so it is
+// discovered once per process rather than on every call.
+type defaultProjectCache struct {
+	mu      sync.Mutex
+	project *Project
+}
+
+func newDefaultProjectCache() *defaultProjectCache {
+	return &defaultProjectCache{}
+}
+
+// lookup returns the cached default project, and false when it was never discovered
+func (c *defaultProjectCache) lookup() (*Project, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.project, c.project != nil
+}
+
+// store remembers project as the default project
+func (c *defaultProjectCache) store(project *Project) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.project = project
+}
+
+// defaultProject returns the project tools use when a call names none. In order of preference it
+// is the configured VIKUNJA_DEFAULT_PROJECT, the default project of the user's settings, the
+// project titled "Inbox", and finally the user's first real project.
+func (h *Handlers) defaultProject(ctx context.Context, client *vikunja.Client) (*Project, error) {
+	if project, ok := h.defaultProjects.lookup(); ok {
+		return project, nil
+	}
+
+	project, err := h.discoverDefaultProject(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	h.defaultProjects.store(project)
+	return project, nil
+}
+
+// discoverDefaultProject finds the default project without consulting the cache
+func (h *Handlers) discoverDefaultProject(ctx context.Context, client *vikunja.Client) (*Project, error) {
+	project, err := h.resolver(client).FindDefaultProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p := toProject(project)
+	return &p, nil
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultProjectServer serves an account without an "Inbox" project whose settings name settingsID
+// as the default project, and counts how often the user's settings are read
+func defaultProjectServer(t *testing.T, settingsID int64, userReads *atomic.Int64) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/user":
+			userReads.Add(1)
+			fmt.Fprintf(w, `{"id":1,"settings":{"default_project_id":%d}}`, settingsID) //nolint:errcheck
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":-1,"title":"Favorites"},{"id":3,"title":"Work"},{"id":4,"title":"Home"}]`) //nolint:errcheck
+		case "/api/v1/projects/3":
+			fmt.Fprint(w, `{"id":3,"title":"Work"}`) //nolint:errcheck
+		case "/api/v1/projects/4":
+			fmt.Fprint(w, `{"id":4,"title":"Home"}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestResolveProjectByValue_DefaultProject(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		configured string
+		settingsID int64
+		wantID     int64
+	}{
+		{"user settings", "", 4, 4},
+		{"configured title", "Home", 3, 4},
+		{"configured ID", "3", 4, 3},
+		{"first real project", "", 0, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var userReads atomic.Int64
+			cfg := &config.Config{Vikunja: config.VikunjaConfig{DefaultProject: tt.configured}}
+			h := newTestHandlers(t, cfg, defaultProjectServer(t, tt.settingsID, &userReads))
+			client, err := h.vikunjaClient()
+			require.NoError(t, err)
+
+			project, id, err := h.resolveProjectByValue(t.Context(), client, "")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantID, project.ID)
+
+			_, again, err := h.resolveProjectByValue(t.Context(), client, "")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, again)
+			assert.LessOrEqual(t, userReads.Load(), int64(1), "the default project must be discovered once")
+		})
+	}
+}
+
+func TestResolveProjectByValue_PrefersInbox(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/user":
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"Link shares cannot read user settings"}`) //nolint:errcheck
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":3,"title":"Work"},{"id":9,"title":"Inbox"}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	client, err := h.vikunjaClient()
+	require.NoError(t, err)
+
+	project, id, err := h.resolveProjectByValue(t.Context(), client, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), id)
+	assert.Equal(t, "Inbox", project.Title)
+}
@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxDiscoverProjects bounds max_projects so one call cannot fetch views for every project
+	maxDiscoverProjects = 100
+	// discoverViewFetches bounds the concurrent view requests of one discovery
+	discoverViewFetches = 4
+	// maxDiscoverViews bounds max_views and max_views_per_project
+	maxDiscoverViews = 1000
+	// defaultDiscoverViewsPerProject is how many views of each project are listed when a call does not say
+	defaultDiscoverViewsPerProject = 25
+	// defaultDiscoverViews is how many views are listed across all projects when a call does not say
+	defaultDiscoverViews = 250
+)
+
+// Discovery profiles: full describes the server along with its projects, minimal only the projects
+// and their views
+const (
+	discoverProfileFull    = "full"
+	discoverProfileMinimal = "minimal"
+)
+
+// discoverProfiles lists the values of discover_vikunja's profile
+func discoverProfiles() []string {
+	return []string{discoverProfileFull, discoverProfileMinimal}
+}
+
+// discoverOptions bounds what one discovery describes
+type discoverOptions struct {
+	maxProjects        int
+	maxViewsPerProject int
+	maxViews           int
+	includeCounts      bool
+	// minimal skips the server details, describing only projects and their views
+	minimal bool
+}
+
+// discoverHandler handles the discover_vikunja tool
+func (h *Handlers) discoverHandler(ctx context.Context, _ *mcp.CallToolRequest, input DiscoverInput) (*mcp.CallToolResult, DiscoverOutput, error) {
+	opts, err := h.discoverOptions(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), DiscoverOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, DiscoverOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	output, err := h.buildDiscoveryOutput(ctx, client, opts)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), DiscoverOutput{}, err
+	}
+
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, DiscoverOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// discoverOptions validates the bounds a call sets on its discovery, filling in the defaults
+func (h *Handlers) discoverOptions(input DiscoverInput) (discoverOptions, error) {
+	var errs ValidationErrors
+	maxProjects, err := h.discoverMaxProjects(input.MaxProjects)
+	errs.add(err)
+	maxViewsPerProject, err := discoverViewLimit("max_views_per_project", input.MaxViewsPerProject, defaultDiscoverViewsPerProject)
+	errs.add(err)
+	maxViews, err := discoverViewLimit("max_views", input.MaxViews, defaultDiscoverViews)
+	errs.add(err)
+	if input.Profile != "" && !slices.Contains(discoverProfiles(), input.Profile) {
+		errs.add(ValidationError{Field: "profile", Message: fmt.Sprintf("must be one of: %s. Got: %s", strings.Join(discoverProfiles(), ", "), input.Profile)})
+	}
+	return discoverOptions{
+		maxProjects:        maxProjects,
+		maxViewsPerProject: maxViewsPerProject,
+		maxViews:           maxViews,
+		includeCounts:      input.IncludeCounts,
+		minimal:            input.Profile == discoverProfileMinimal,
+	}, errs.err()
+}
+
+// discoverViewLimit returns the requested view cap, or def when the call does not set one
+func discoverViewLimit(field string, requested, def int) (int, error) {
+	switch {
+	case requested < 0 || requested > maxDiscoverViews:
+		return 0, ValidationError{Field: field, Message: fmt.Sprintf("must be between 1 and %d, got: %d", maxDiscoverViews, requested)}
+	case requested > 0:
+		return requested, nil
+	default:
+		return def, nil
+	}
+}
+
+// discoverMaxProjects returns the requested project cap, or the configured default
+func (h *Handlers) discoverMaxProjects(requested int) (int, error) {
+	switch {
+	case requested < 0 || requested > maxDiscoverProjects:
+		return 0, ValidationError{Field: "max_projects", Message: fmt.Sprintf("must be between 1 and %d, got: %d", maxDiscoverProjects, requested)}
+	case requested > 0:
+		return requested, nil
+	case h.deps.Config != nil && h.deps.Config.DiscoverMaxProjects > 0:
+		return h.deps.Config.DiscoverMaxProjects, nil
+	default:
+		return config.DefaultDiscoverMaxProjects, nil
+	}
+}
+
+// buildDiscoveryOutput gathers what it can about the server. Failures are reported as warnings
+// next to the data that was fetched; an error is returned only when nothing could be fetched.
+func (h *Handlers) buildDiscoveryOutput(ctx context.Context, client *vikunja.Client, opts discoverOptions) (DiscoverOutput, error) {
+	if opts.minimal {
+		return h.buildMinimalDiscoveryOutput(ctx, client, opts)
+	}
+
+	info, infoErr := client.GetInfo(ctx)
+	output := DiscoverOutput{
+		ServerInfo: &DiscoverServerInfo{Readonly: h.isReadonly()},
+		Projects:   []DiscoveredProject{},
+	}
+	if output.ServerInfo.Readonly {
+		output.ServerInfo.ReadonlyAllow = h.deps.Config.ReadonlyAllow
+	}
+	if h.deps.Config != nil {
+		output.Notes = h.deps.Config.AssistantNotes
+	}
+	if infoErr == nil {
+		output.ServerInfo.Version = info.Version
+	} else {
+		h.deps.Logger.Warn("failed to get Vikunja server info", slog.Any("error", infoErr))
+	}
+
+	projects, err := client.GetProjects(ctx, false)
+	if err != nil {
+		if infoErr != nil {
+			return DiscoverOutput{}, fmt.Errorf("failed to list projects: %w", err)
+		}
+		h.deps.Logger.Warn("failed to list projects for discovery", slog.Any("error", err))
+		output.Warnings = append(output.Warnings, fmt.Sprintf("failed to list projects: %v", err))
+		return output, nil
+	}
+
+	h.describeProjects(ctx, client, projects, opts, &output)
+	h.describeDefaultProject(ctx, client, &output)
+	return output, nil
+}
+
+// buildMinimalDiscoveryOutput describes only the projects and their views, leaving out the server
+// details, notes and default project of a full discovery
+func (h *Handlers) buildMinimalDiscoveryOutput(ctx context.Context, client *vikunja.Client, opts discoverOptions) (DiscoverOutput, error) {
+	projects, err := client.GetProjects(ctx, false)
+	if err != nil {
+		return DiscoverOutput{}, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	// The counts describeProjects records in the server info are dropped; the hint still reports truncation
+	output := DiscoverOutput{ServerInfo: &DiscoverServerInfo{}}
+	h.describeProjects(ctx, client, projects, opts, &output)
+	output.ServerInfo = nil
+	return output, nil
+}
+
+// describeProjects describes the first maxProjects projects and as many of their views as the view
+// caps allow, flagging what was left out and hinting at how to see it
+func (h *Handlers) describeProjects(ctx context.Context, client *vikunja.Client, projects []*vikunja.Project, opts discoverOptions, output *DiscoverOutput) {
+	var hints []string
+	output.ServerInfo.TotalProjects = len(projects)
+	if len(projects) > opts.maxProjects {
+		projects = projects[:opts.maxProjects]
+		output.ServerInfo.Truncated = true
+		hints = append(hints, fmt.Sprintf("Showing %d of %d projects. Call discover_vikunja with a higher max_projects, or use list_projects, to see the rest.",
+			opts.maxProjects, output.ServerInfo.TotalProjects))
+	}
+
+	var warnings []string
+	output.Projects, warnings = h.discoverProjects(ctx, client, projects, opts)
+	output.Warnings = append(output.Warnings, warnings...)
+	output.ServerInfo.ViewsTruncated = capViews(output.Projects, opts.maxViews)
+	if output.ServerInfo.ViewsTruncated {
+		hints = append(hints, "Projects flagged views_truncated list only some of their views; use list_views to see all of them.")
+	}
+	output.Hint = strings.Join(hints, " ")
+}
+
+// capViews keeps at most maxViews views across projects, in project order, flagging each project
+// which lost views. It reports whether any project lists only some of its views.
+func capViews(projects []DiscoveredProject, maxViews int) bool {
+	truncated := false
+	remaining := maxViews
+	for i := range projects {
+		project := &projects[i]
+		if len(project.Views) > remaining {
+			project.Views = project.Views[:remaining]
+			project.ViewsTruncated = true
+		}
+		remaining -= len(project.Views)
+		truncated = truncated || project.ViewsTruncated
+	}
+	return truncated
+}
+
+// describeDefaultProject reports the project tools fall back to, or a warning when it cannot be found
+func (h *Handlers) describeDefaultProject(ctx context.Context, client *vikunja.Client, output *DiscoverOutput) {
+	project, err := h.defaultProject(ctx, client)
+	if err != nil {
+		h.deps.Logger.Warn("failed to find the default project", slog.Any("error", err))
+		output.Warnings = append(output.Warnings, fmt.Sprintf("failed to find the default project: %v", err))
+		return
+	}
+	output.ServerInfo.DefaultProject = project
+}
+
+// discoverProjects loads the views, and when asked the open task counts, of each project, keeping
+// the projects' order. A project which cannot be described in full is kept with what was loaded
+// and described in the returned warnings.
+func (h *Handlers) discoverProjects(ctx context.Context, client *vikunja.Client, projects []*vikunja.Project, opts discoverOptions) ([]DiscoveredProject, []string) {
+	discovered := make([]DiscoveredProject, len(projects))
+	failures := make([]error, len(projects))
+	var g errgroup.Group
+	g.SetLimit(discoverViewFetches)
+
+	for i, p := range projects {
+		discovered[i] = DiscoveredProject{ID: p.ID, Title: p.Title, Views: []DiscoveredView{}}
+		g.Go(func() error {
+			failures[i] = h.discoverProject(ctx, client, &discovered[i], opts)
+			return nil
+		})
+	}
+	_ = g.Wait() //nolint:errcheck // failures are collected per project, never returned
+
+	var warnings []string
+	for _, err := range failures {
+		if err != nil {
+			h.deps.Logger.Warn("failed to discover project", slog.Any("error", err))
+			warnings = append(warnings, err.Error())
+		}
+	}
+	return discovered, warnings
+}
+
+// discoverProject fills in up to maxViewsPerProject views, and when asked the open task count, of a
+// discovered project
+func (h *Handlers) discoverProject(ctx context.Context, client *vikunja.Client, project *DiscoveredProject, opts discoverOptions) error {
+	views, err := client.GetProjectViews(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get views of project %d: %w", project.ID, err)
+	}
+	for _, v := range views {
+		project.Views = append(project.Views, DiscoveredView{ID: v.ID, Title: v.Title, Kind: v.ViewKind})
+	}
+	if def := vikunja.DefaultView(views); def != nil {
+		project.DefaultViewID = def.ID
+	}
+	if len(project.Views) > opts.maxViewsPerProject {
+		project.Views = project.Views[:opts.maxViewsPerProject]
+		project.ViewsTruncated = true
+	}
+
+	if !opts.includeCounts {
+		return nil
+	}
+	count, err := h.openTaskCount(ctx, client, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count tasks of project %d: %w", project.ID, err)
+	}
+	project.OpenTasks = &count
+	return nil
+}
@@ -0,0 +1,358 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// discoverServer serves a Vikunja server holding projectCount projects, each with one kanban view
+func discoverServer(t *testing.T, projectCount int) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var id int64
+		switch {
+		case r.URL.Path == "/api/v1/info":
+			fmt.Fprint(w, `{"version":"v0.24.1"}`) //nolint:errcheck
+		case r.URL.Path == "/api/v1/user":
+			fmt.Fprint(w, `{"id":1,"settings":{"default_project_id":2}}`) //nolint:errcheck
+		case r.URL.Path == "/api/v1/projects/2":
+			fmt.Fprint(w, `{"id":2,"title":"Project 2"}`) //nolint:errcheck
+		case r.URL.Path == "/api/v1/projects":
+			projects := make([]string, projectCount)
+			for i := range projects {
+				projects[i] = fmt.Sprintf(`{"id":%d,"title":"Project %d"}`, i+1, i+1)
+			}
+			fmt.Fprint(w, "["+strings.Join(projects, ",")+"]") //nolint:errcheck
+		case sscanPath(r.URL.Path, "/api/v1/projects/%d/views", &id):
+			fmt.Fprintf(w, `[{"id":%d,"title":"Kanban","view_kind":"kanban"}]`, id*10) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func sscanPath(path, format string, id *int64) bool {
+	n, err := fmt.Sscanf(path, format, id)
+	return err == nil && n == 1 && path == fmt.Sprintf(format, *id)
+}
+
+func TestDiscoverHandler_ProjectCap(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		configured    int
+		maxProjects   int
+		wantProjects  int
+		wantTruncated bool
+	}{
+		{"default cap", 0, 0, config.DefaultDiscoverMaxProjects, true},
+		{"configured cap", 10, 0, 7, false},
+		{"requested cap", 10, 2, 2, true},
+		{"cap equals total", 0, 7, 7, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, &config.Config{DiscoverMaxProjects: tt.configured}, discoverServer(t, 7))
+
+			_, output, err := h.discoverHandler(t.Context(), nil, DiscoverInput{MaxProjects: tt.maxProjects})
+			require.NoError(t, err)
+
+			assert.Equal(t, "v0.24.1", output.ServerInfo.Version)
+			assert.Equal(t, 7, output.ServerInfo.TotalProjects)
+			require.NotNil(t, output.ServerInfo.DefaultProject)
+			assert.Equal(t, int64(2), output.ServerInfo.DefaultProject.ID)
+			assert.Equal(t, tt.wantTruncated, output.ServerInfo.Truncated)
+			require.Len(t, output.Projects, tt.wantProjects)
+			assert.Equal(t, "Project 1", output.Projects[0].Title)
+			assert.Equal(t, []DiscoveredView{{ID: 10, Title: "Kanban", Kind: "kanban"}}, output.Projects[0].Views)
+			if tt.wantTruncated {
+				assert.Contains(t, output.Hint, fmt.Sprintf("Showing %d of 7 projects", tt.wantProjects))
+				assert.Contains(t, output.Hint, "max_projects")
+			} else {
+				assert.Empty(t, output.Hint)
+			}
+		})
+	}
+}
+
+func TestDiscoverHandler_InvalidMaxProjects(t *testing.T) {
+	t.Parallel()
+	for _, maxProjects := range []int{-1, maxDiscoverProjects + 1} {
+		t.Run(fmt.Sprint(maxProjects), func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+			result, _, err := h.discoverHandler(t.Context(), nil, DiscoverInput{MaxProjects: maxProjects})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "max_projects: must be between 1 and 100")
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestDiscoverHandler_PartialResults(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		failPath     string
+		wantProjects int
+		wantWarning  string
+	}{
+		{"one project's views fail", "/api/v1/projects/2/views", 3, "failed to get views of project 2"},
+		{"project list fails", "/api/v1/projects", 0, "failed to list projects"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			serve := discoverServer(t, 3)
+			h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == tt.failPath {
+					http.Error(w, `{"message":"database unavailable"}`, http.StatusInternalServerError)
+					return
+				}
+				serve(w, r)
+			})
+
+			result, output, err := h.discoverHandler(t.Context(), nil, DiscoverInput{})
+			require.NoError(t, err)
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, "v0.24.1", output.ServerInfo.Version)
+			assert.Len(t, output.Projects, tt.wantProjects)
+			require.Len(t, output.Warnings, 1)
+			assert.Contains(t, output.Warnings[0], tt.wantWarning)
+		})
+	}
+}
+
+func TestDiscoverHandler_SharesRetryBudget(t *testing.T) {
+	t.Parallel()
+	var viewRequests atomic.Int64
+	serve := discoverServer(t, 4)
+	h := newTestHandlers(t, &config.Config{RetryBudget: 2}, func(w http.ResponseWriter, r *http.Request) {
+		var id int64
+		if sscanPath(r.URL.Path, "/api/v1/projects/%d/views", &id) {
+			viewRequests.Add(1)
+			http.Error(w, `{"message":"temporarily unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		serve(w, r)
+	})
+
+	_, output, err := withRetryBudget(h, h.discoverHandler)(t.Context(), nil, DiscoverInput{})
+	require.NoError(t, err)
+
+	assert.Len(t, output.Warnings, 4)
+	assert.Equal(t, int64(4+2), viewRequests.Load(), "the four view requests share two retries")
+}
+
+func TestDiscoverHandler_NothingFetched(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"database unavailable"}`, http.StatusInternalServerError)
+	})
+
+	result, _, err := h.discoverHandler(t.Context(), nil, DiscoverInput{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list projects")
+	assert.True(t, result.IsError)
+}
+
+func TestDiscoverHandler_DefaultViewIsLowestPositioned(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/info":
+			fmt.Fprint(w, `{"version":"v0.24.1"}`) //nolint:errcheck
+		case "/api/v1/user":
+			fmt.Fprint(w, `{"id":1,"settings":{"default_project_id":1}}`) //nolint:errcheck
+		case "/api/v1/projects/1":
+			fmt.Fprint(w, `{"id":1,"title":"Work"}`) //nolint:errcheck
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":1,"title":"Work"}]`) //nolint:errcheck
+		case "/api/v1/projects/1/views":
+			fmt.Fprint(w, `[{"id":10,"title":"List","view_kind":"list","position":200},`+ //nolint:errcheck
+				`{"id":11,"title":"Kanban","view_kind":"kanban","position":100},`+
+				`{"id":12,"title":"Table","view_kind":"table","position":300}]`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.discoverHandler(t.Context(), nil, DiscoverInput{})
+	require.NoError(t, err)
+
+	require.Len(t, output.Projects, 1)
+	assert.Len(t, output.Projects[0].Views, 3)
+	assert.Equal(t, int64(11), output.Projects[0].DefaultViewID)
+}
+
+func TestDiscoverHandler_AssistantNotes(t *testing.T) {
+	t.Parallel()
+	notes := []string{"Always confirm before deleting", "Prefer the Work project"}
+	h := newTestHandlers(t, &config.Config{AssistantNotes: notes}, discoverServer(t, 1))
+
+	result, output, err := h.discoverHandler(t.Context(), nil, DiscoverInput{})
+	require.NoError(t, err)
+
+	assert.Equal(t, notes, output.Notes)
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "Always confirm before deleting")
+}
+
+func TestDiscoverHandler_CachesTaskCounts(t *testing.T) {
+	t.Parallel()
+	var countRequests atomic.Int64
+	projects := discoverServer(t, 2)
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tasks" {
+			projects(w, r)
+			return
+		}
+		countRequests.Add(1)
+		var id int64
+		fmt.Sscanf(r.URL.Query().Get("filter"), "project = %d && done = false", &id) //nolint:errcheck
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-pagination-total-pages", fmt.Sprint(id*3))
+		fmt.Fprint(w, `[]`) //nolint:errcheck
+	})
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	h.deps.Now = func() time.Time { return now }
+
+	_, output, err := h.discoverHandler(t.Context(), nil, DiscoverInput{IncludeCounts: true})
+	require.NoError(t, err)
+	require.Len(t, output.Projects, 2)
+	require.NotNil(t, output.Projects[1].OpenTasks)
+	assert.Equal(t, int64(6), *output.Projects[1].OpenTasks)
+	assert.Equal(t, int64(2), countRequests.Load())
+
+	_, output, err = h.discoverHandler(t.Context(), nil, DiscoverInput{IncludeCounts: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), *output.Projects[0].OpenTasks)
+	assert.Equal(t, int64(2), countRequests.Load(), "the second discovery must reuse the cached counts")
+
+	h.taskCounts.invalidate(1)
+	_, _, err = h.discoverHandler(t.Context(), nil, DiscoverInput{IncludeCounts: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), countRequests.Load(), "only the invalidated project is counted again")
+
+	now = now.Add(taskCountTTL)
+	_, _, err = h.discoverHandler(t.Context(), nil, DiscoverInput{IncludeCounts: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), countRequests.Load(), "expired counts are counted again")
+
+	_, output, err = h.discoverHandler(t.Context(), nil, DiscoverInput{})
+	require.NoError(t, err)
+	assert.Nil(t, output.Projects[0].OpenTasks)
+}
+
+func TestDiscoverHandler_ViewCaps(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		input         DiscoverInput
+		wantViews     []int
+		wantTruncated []bool
+	}{
+		{"defaults", DiscoverInput{}, []int{4, 4, 4}, []bool{false, false, false}},
+		{"per project", DiscoverInput{MaxViewsPerProject: 2}, []int{2, 2, 2}, []bool{true, true, true}},
+		{"overall", DiscoverInput{MaxViews: 5}, []int{4, 1, 0}, []bool{false, true, true}},
+		{"both", DiscoverInput{MaxViewsPerProject: 3, MaxViews: 7}, []int{3, 3, 1}, []bool{true, true, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			projects := discoverServer(t, 3)
+			h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				var id int64
+				if !sscanPath(r.URL.Path, "/api/v1/projects/%d/views", &id) {
+					projects(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				views := make([]string, 4)
+				for i := range views {
+					views[i] = fmt.Sprintf(`{"id":%d,"title":"View %d","view_kind":"list","position":%d}`, id*10+int64(i), i, i)
+				}
+				fmt.Fprint(w, "["+strings.Join(views, ",")+"]") //nolint:errcheck
+			})
+
+			_, output, err := h.discoverHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+			require.Len(t, output.Projects, 3)
+
+			anyTruncated := false
+			for i, project := range output.Projects {
+				assert.Len(t, project.Views, tt.wantViews[i], "views of %s", project.Title)
+				assert.Equal(t, tt.wantTruncated[i], project.ViewsTruncated, "views_truncated of %s", project.Title)
+				assert.Equal(t, project.ID*10, project.DefaultViewID, "the default view is chosen before truncating")
+				anyTruncated = anyTruncated || tt.wantTruncated[i]
+			}
+			assert.Equal(t, anyTruncated, output.ServerInfo.ViewsTruncated)
+			if anyTruncated {
+				assert.Contains(t, output.Hint, "list_views")
+			} else {
+				assert.Empty(t, output.Hint)
+			}
+		})
+	}
+}
+
+func TestDiscoverHandler_InvalidViewCaps(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	_, _, err := h.discoverHandler(t.Context(), nil, DiscoverInput{MaxViewsPerProject: -1, MaxViews: maxDiscoverViews + 1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_views_per_project: must be between 1 and 1000")
+	assert.Contains(t, err.Error(), "max_views: must be between 1 and 1000")
+}
+
+func TestDiscoverHandler_MinimalProfile(t *testing.T) {
+	t.Parallel()
+	projects := discoverServer(t, 3)
+	cfg := &config.Config{AssistantNotes: []string{"Prefer the Work project"}, DiscoverMaxProjects: 2}
+	h := newTestHandlers(t, cfg, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/info" || r.URL.Path == "/api/v1/user" {
+			t.Errorf("the minimal profile must not describe the server: %s %s", r.Method, r.URL.Path)
+		}
+		projects(w, r)
+	})
+
+	result, output, err := h.discoverHandler(t.Context(), nil, DiscoverInput{Profile: "minimal"})
+	require.NoError(t, err)
+
+	assert.Nil(t, output.ServerInfo)
+	assert.Empty(t, output.Notes)
+	require.Len(t, output.Projects, 2)
+	assert.Equal(t, []DiscoveredView{{ID: 10, Title: "Kanban", Kind: "kanban"}}, output.Projects[0].Views)
+	assert.Contains(t, output.Hint, "Showing 2 of 3 projects")
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.NotContains(t, text, "server_info")
+	assert.NotContains(t, text, "notes")
+	assert.NotContains(t, text, "default_project")
+}
+
+func TestDiscoverHandler_InvalidProfile(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	_, _, err := h.discoverHandler(t.Context(), nil, DiscoverInput{Profile: "tiny"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "profile: must be one of: full, minimal. Got: tiny")
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// plannedResult formats the writes a dry-run client recorded instead of sending
+func (h *Handlers) plannedResult(client *vikunja.Client) (*mcp.CallToolResult, []vikunja.PlannedRequest, error) {
+	planned := client.PlannedRequests()
+	output := DryRunOutput{
+		DryRun:  true,
+		Message: fmt.Sprintf("Dry run: %d request(s) planned, no changes were made", len(planned)),
+		Planned: planned,
+	}
+
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, planned, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, planned, nil
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveTaskToBucketHandler_DryRun(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("dry-run write reached the server: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/projects/7/views/3/buckets" {
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"}]`) //nolint:errcheck
+			return
+		}
+		fmt.Fprint(w, `{"id":42,"title":"Move me","project_id":7}`) //nolint:errcheck
+	})
+	h.deps.DryRun = true
+
+	result, output, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{
+		TaskID:    "42",
+		ProjectID: "7",
+		ViewID:    "3",
+		BucketID:  "10",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, output.Planned, 1)
+	assert.Equal(t, http.MethodPost, output.Planned[0].Method)
+	assert.Equal(t, "/api/v1/projects/7/views/3/buckets/10/tasks", output.Planned[0].Endpoint)
+	assert.Equal(t, &vikunja.TaskBucket{TaskID: 42}, output.Planned[0].Body)
+	assert.Empty(t, output.Message)
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.Contains(t, text, `"dry_run": true`)
+	assert.Contains(t, text, "/api/v1/projects/7/views/3/buckets/10/tasks")
+}
+
+func TestCreateWebhookHandler_DryRunStillValidates(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+	h.deps.DryRun = true
+
+	result, _, err := h.createWebhookHandler(t.Context(), nil, CreateWebhookInput{ProjectID: "7", TargetURL: "not a url", Events: []string{"task.created"}})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// dueReportHandler handles the due_report tool
+func (h *Handlers) dueReportHandler(ctx context.Context, _ *mcp.CallToolRequest, _ DueReportInput) (*mcp.CallToolResult, DueReportOutput, error) {
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, DueReportOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	tasks, err := client.GetAllTasks(ctx, "done = false")
+	if err != nil {
+		return h.buildErrorResult(err.Error()), DueReportOutput{}, err
+	}
+
+	report := vikunja.NewDueReport(tasks, h.deps.Now())
+	output := DueReportOutput{
+		Overdue:   toTasks(report.Overdue),
+		Today:     toTasks(report.Today),
+		ThisWeek:  toTasks(report.ThisWeek),
+		Later:     toTasks(report.Later),
+		NoDueDate: toTasks(report.NoDueDate),
+	}
+
+	result, err := h.formatResult(report)
+	if err != nil {
+		return nil, DueReportOutput{}, err
+	}
+	return result, output, nil
+}
+
+func toTasks(tasks []*vikunja.Task) []Task {
+	result := make([]Task, len(tasks))
+	for i, t := range tasks {
+		result[i] = toTask(t)
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func taskIDs(tasks []Task) []int64 {
+	ids := make([]int64, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	return ids
+}
+
+func TestDueReportHandler(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, upcomingTasksServer(t))
+	h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+	h.deps.Now = func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+	result, output, err := h.dueReportHandler(t.Context(), nil, DueReportInput{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{4}, taskIDs(output.Overdue))
+	assert.Equal(t, []int64{2}, taskIDs(output.Today))
+	assert.Equal(t, []int64{5, 6}, taskIDs(output.ThisWeek))
+	assert.Equal(t, []int64{1}, taskIDs(output.Later))
+	assert.Equal(t, []int64{3}, taskIDs(output.NoDueDate))
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.Contains(t, text, "## This Week (2)")
+	assert.Contains(t, text, "[Task 2] Tonight")
+}
+
+// pagedTasksServer serves open tasks across all projects: a full first page of tasks without a due
+// date, then lastPage
+func pagedTasksServer(t *testing.T, lastPage string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks", r.URL.Path)
+		assert.Equal(t, "done = false", r.URL.Query().Get("filter"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			tasks := make([]string, 50)
+			for i := range tasks {
+				tasks[i] = fmt.Sprintf(`{"id":%d,"title":"Someday %d"}`, 100+i, i)
+			}
+			fmt.Fprint(w, "["+strings.Join(tasks, ",")+"]") //nolint:errcheck
+		case "2":
+			fmt.Fprint(w, lastPage) //nolint:errcheck
+		default:
+			t.Errorf("unexpected page: %s", r.URL.RawQuery)
+		}
+	}
+}
+
+func TestDueReportHandler_ReadsEveryPage(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, pagedTasksServer(t, `[{"id":4,"title":"Yesterday","due_date":"2026-03-09T08:00:00Z"}]`))
+	h.deps.Now = func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+	_, output, err := h.dueReportHandler(t.Context(), nil, DueReportInput{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{4}, taskIDs(output.Overdue), "tasks past the first page are reported")
+	assert.Len(t, output.NoDueDate, 50)
+}
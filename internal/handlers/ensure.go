@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ensureProjectHandler handles the ensure_project tool. Only creating the project is a write, so
+// readonly mode refuses the call only when the project does not exist yet.
+func (h *Handlers) ensureProjectHandler(ctx context.Context, _ *mcp.CallToolRequest, input EnsureProjectInput) (*mcp.CallToolResult, EnsureProjectOutput, error) {
+	if err := validateRequiredString("title", input.Title); err != nil {
+		return h.buildErrorResult(err.Error()), EnsureProjectOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, EnsureProjectOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	existing, err := h.existingProject(ctx, client, input.Title)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), EnsureProjectOutput{}, err
+	}
+	if existing != nil {
+		return h.formatEnsureProjectOutput(EnsureProjectOutput{Project: *existing})
+	}
+
+	if h.isReadonlyFor("ensure_project") {
+		return h.buildErrorResult("Operation not available in readonly mode"), EnsureProjectOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+	created, err := client.CreateProject(ctx, &vikunja.Project{Title: input.Title})
+	if err != nil {
+		return h.buildErrorResult(err.Error()), EnsureProjectOutput{}, err
+	}
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, EnsureProjectOutput{Created: true, Planned: planned}, err
+	}
+	h.projects.invalidate()
+	return h.formatEnsureProjectOutput(EnsureProjectOutput{Project: toProject(created), Created: true})
+}
+
+// existingProject returns the project titled title, or nil when there is none. The project list is
+// read afresh, as a stale cache could hide a project created elsewhere.
+func (h *Handlers) existingProject(ctx context.Context, client *vikunja.Client, title string) (*Project, error) {
+	projects, err := h.freshProjects(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := findProjectsByTitle(projects, title)
+	switch {
+	case len(matches) == 0:
+		return nil, nil
+	case len(matches) > 1 && !h.firstOfDuplicateTitles():
+		ids := make([]int64, len(matches))
+		for i, p := range matches {
+			ids[i] = p.ID
+		}
+		return nil, resolution.AmbiguousProjectError(title, ids)
+	}
+	return &matches[0], nil
+}
+
+func (h *Handlers) formatEnsureProjectOutput(output EnsureProjectOutput) (*mcp.CallToolResult, EnsureProjectOutput, error) {
+	result, err := h.formatResult(output)
+	if err != nil {
+		return nil, EnsureProjectOutput{}, err
+	}
+	return result, output, nil
+}
+
+// ensureBucketHandler handles the ensure_bucket tool. Only creating the bucket is a write, so
+// readonly mode refuses the call only when the view has no such bucket yet.
+func (h *Handlers) ensureBucketHandler(ctx context.Context, _ *mcp.CallToolRequest, input EnsureBucketInput) (*mcp.CallToolResult, EnsureBucketOutput, error) {
+	var errs ValidationErrors
+	projectID := errs.parseID("project_id", input.ProjectID)
+	viewID := errs.parseID("view_id", input.ViewID)
+	errs.add(validateRequiredString("title", input.Title))
+	if err := errs.err(); err != nil {
+		return h.buildErrorResult(err.Error()), EnsureBucketOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, EnsureBucketOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	buckets, err := client.GetViewBuckets(ctx, projectID, viewID)
+	if err != nil {
+		err = fmt.Errorf("failed to get view buckets: %w", err)
+		return h.buildErrorResult(err.Error()), EnsureBucketOutput{}, err
+	}
+	if bucket, err := h.findBucket(buckets, 0, input.Title, ""); err == nil {
+		return h.formatEnsureBucketOutput(EnsureBucketOutput{Bucket: toBucket(bucket)})
+	}
+
+	if h.isReadonlyFor("ensure_bucket") {
+		return h.buildErrorResult("Operation not available in readonly mode"), EnsureBucketOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+	created, err := client.CreateBucket(ctx, projectID, viewID, &vikunja.Bucket{Title: input.Title})
+	if err != nil {
+		return h.buildErrorResult(err.Error()), EnsureBucketOutput{}, err
+	}
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, EnsureBucketOutput{Created: true, Planned: planned}, err
+	}
+	return h.formatEnsureBucketOutput(EnsureBucketOutput{Bucket: toBucket(created), Created: true})
+}
+
+func (h *Handlers) formatEnsureBucketOutput(output EnsureBucketOutput) (*mcp.CallToolResult, EnsureBucketOutput, error) {
+	result, err := h.formatResult(output)
+	if err != nil {
+		return nil, EnsureBucketOutput{}, err
+	}
+	return result, output, nil
+}
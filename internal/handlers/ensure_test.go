@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ensureServer serves the projects Inbox (1) and Work (7), whose view 3 has a Todo bucket (10),
+// and records the body of every create
+func ensureServer(t *testing.T, created map[string]map[string]any) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":1,"title":"Inbox"},{"id":7,"title":"Work"}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","project_view_id":3}]`) //nolint:errcheck
+		case r.Method == http.MethodPut:
+			body := map[string]any{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			created[r.URL.Path] = body
+			body["id"] = 99
+			if r.URL.Path == "/api/v1/projects" {
+				w.WriteHeader(http.StatusCreated)
+			}
+			json.NewEncoder(w).Encode(body) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestEnsureProjectHandler(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		cfg         *config.Config
+		title       string
+		wantID      int64
+		wantCreated bool
+	}{
+		{"exists", nil, "Work", 7, false},
+		{"exists in readonly mode", &config.Config{Readonly: true}, "Work", 7, false},
+		{"creates", nil, "Home", 99, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			created := map[string]map[string]any{}
+			h := newTestHandlers(t, tt.cfg, ensureServer(t, created))
+
+			_, output, err := h.ensureProjectHandler(t.Context(), nil, EnsureProjectInput{Title: tt.title})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantID, output.Project.ID)
+			assert.Equal(t, tt.title, output.Project.Title)
+			assert.Equal(t, tt.wantCreated, output.Created)
+			if !tt.wantCreated {
+				assert.Empty(t, created, "an existing project is not created again")
+				return
+			}
+			assert.Equal(t, tt.title, created["/api/v1/projects"]["title"])
+		})
+	}
+}
+
+func TestEnsureBucketHandler(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		cfg         *config.Config
+		title       string
+		wantID      int64
+		wantCreated bool
+	}{
+		{"exists", nil, "Todo", 10, false},
+		{"exists in readonly mode", &config.Config{Readonly: true}, "Todo", 10, false},
+		{"creates", nil, "Review", 99, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			created := map[string]map[string]any{}
+			h := newTestHandlers(t, tt.cfg, ensureServer(t, created))
+
+			_, output, err := h.ensureBucketHandler(t.Context(), nil, EnsureBucketInput{ProjectID: "7", ViewID: "3", Title: tt.title})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantID, output.Bucket.ID)
+			assert.Equal(t, tt.title, output.Bucket.Title)
+			assert.Equal(t, tt.wantCreated, output.Created)
+			if !tt.wantCreated {
+				assert.Empty(t, created, "an existing bucket is not created again")
+				return
+			}
+			assert.Equal(t, tt.title, created["/api/v1/projects/7/views/3/buckets"]["title"])
+		})
+	}
+}
+
+func TestEnsureHandlers_ReadonlyRefusesCreate(t *testing.T) {
+	t.Parallel()
+	created := map[string]map[string]any{}
+	h := newTestHandlers(t, &config.Config{Readonly: true}, ensureServer(t, created))
+
+	result, _, err := h.ensureProjectHandler(t.Context(), nil, EnsureProjectInput{Title: "Home"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "readonly mode")
+	assert.True(t, result.IsError)
+
+	result, _, err = h.ensureBucketHandler(t.Context(), nil, EnsureBucketInput{ProjectID: "7", ViewID: "3", Title: "Review"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "readonly mode")
+	assert.True(t, result.IsError)
+
+	assert.Empty(t, created, "nothing is created in readonly mode")
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// exportProjectHandler handles the export_project tool
+func (h *Handlers) exportProjectHandler(ctx context.Context, _ *mcp.CallToolRequest, input ExportProjectInput) (*mcp.CallToolResult, ExportProjectOutput, error) {
+	if err := validateRequiredString("project", input.Project); err != nil {
+		return h.buildErrorResult(err.Error()), ExportProjectOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ExportProjectOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	project, err := resolution.ResolveProject(ctx, client, input.Project)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ExportProjectOutput{}, err
+	}
+
+	export, err := client.ExportProject(ctx, project.ID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ExportProjectOutput{}, err
+	}
+
+	result, err := h.formatResult(export)
+	if err != nil {
+		return nil, ExportProjectOutput{}, err
+	}
+	return result, summarizeExport(project.ID, export), nil
+}
+
+func summarizeExport(projectID int64, export *vikunja.ProjectExport) ExportProjectOutput {
+	output := ExportProjectOutput{ProjectID: projectID, Views: len(export.Views), Tasks: len(export.Tasks)}
+	for _, view := range export.Views {
+		output.Buckets += len(view.Buckets)
+	}
+	return output
+}
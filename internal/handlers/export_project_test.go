@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportTaskPage(from, to int) []map[string]any {
+	tasks := []map[string]any{}
+	for id := from; id <= to; id++ {
+		tasks = append(tasks, map[string]any{"id": id, "title": fmt.Sprintf("Task %d", id), "project_id": 7})
+	}
+	return tasks
+}
+
+func TestExportProjectHandler_Snapshot(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body any
+		switch r.URL.Path {
+		case "/api/v1/projects/7":
+			body = map[string]any{"id": 7, "title": "Launch"}
+		case "/api/v1/projects/7/views":
+			body = []map[string]any{
+				{"id": 1, "title": "List", "project_id": 7, "view_kind": "list"},
+				{"id": 2, "title": "Board", "project_id": 7, "view_kind": "kanban"},
+			}
+		case "/api/v1/tasks":
+			assert.Equal(t, "project = 7", r.URL.Query().Get("filter"))
+			if r.URL.Query().Get("page") == "1" {
+				body = exportTaskPage(1, 50)
+			} else {
+				body = exportTaskPage(51, 55)
+			}
+		case "/api/v1/projects/7/views/2/tasks":
+			body = []map[string]any{
+				{"id": 10, "title": "Todo", "tasks": exportTaskPage(1, 3)},
+				{"id": 11, "title": "Doing", "tasks": exportTaskPage(4, 4)},
+				{"id": 12, "title": "Done", "tasks": []any{}},
+			}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		json.NewEncoder(w).Encode(body) //nolint:errcheck
+	})
+
+	result, output, err := h.exportProjectHandler(t.Context(), nil, ExportProjectInput{Project: "7"})
+	require.NoError(t, err)
+	assert.Equal(t, ExportProjectOutput{ProjectID: 7, Views: 2, Buckets: 3, Tasks: 55}, output)
+
+	var export vikunja.ProjectExport
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &export))
+	assert.Equal(t, 1, export.Version)
+	assert.Equal(t, "Launch", export.Project.Title)
+	assert.Len(t, export.Tasks, 55)
+	require.Len(t, export.Views, 2)
+	assert.Empty(t, export.Views[0].Buckets)
+	require.Len(t, export.Views[1].Buckets, 3)
+	assert.Equal(t, []int64{1, 2, 3}, export.Views[1].Buckets[0].TaskIDs)
+	assert.Equal(t, []int64{4}, export.Views[1].Buckets[1].TaskIDs)
+	assert.Empty(t, export.Views[1].Buckets[2].TaskIDs)
+	assert.Empty(t, export.Views[1].Buckets[0].Bucket.Tasks)
+}
+
+func TestExportProjectHandler_RequiresProject(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.exportProjectHandler(t.Context(), nil, ExportProjectInput{})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestExportProjectHandler_ReportsExportFailure(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/projects/7" {
+			fmt.Fprint(w, `{"id":7,"title":"Launch"}`) //nolint:errcheck
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"message":"boom"}`) //nolint:errcheck
+	})
+
+	result, _, err := h.exportProjectHandler(t.Context(), nil, ExportProjectInput{Project: "7"})
+	require.Error(t, err)
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/sync/errgroup"
+)
+
+// findViewInAllProjects handles find_view with all_projects set and no project: it searches the
+// views of up to maxDiscoverProjects projects and returns every match with its project. The project
+// list is read afresh, as the cache would miss projects created or deleted elsewhere.
+func (h *Handlers) findViewInAllProjects(ctx context.Context, client *vikunja.Client, input FindViewInput) (*mcp.CallToolResult, FindViewOutput, error) {
+	projects, err := h.freshProjects(ctx, client)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), FindViewOutput{}, err
+	}
+
+	output := FindViewOutput{}
+	if len(projects) > maxDiscoverProjects {
+		projects = projects[:maxDiscoverProjects]
+		output.Truncated = true
+	}
+
+	found, err := searchProjectViews(ctx, client, projects, input.ViewName, input.Fuzzy)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), FindViewOutput{}, err
+	}
+	if len(found) == 0 {
+		err := fmt.Errorf("view with title %q not found in any of %d projects. Try: list_views() to see a project's views", input.ViewName, len(projects))
+		return h.buildErrorResult(err.Error()), FindViewOutput{}, err
+	}
+
+	formatted := make([]vikunja.ViewOutput, len(found))
+	for i, match := range found {
+		formatted[i] = vikunja.ViewOutput{Project: *match.project, View: *match.view}
+		output.Matches = append(output.Matches, FoundView{Project: toProject(match.project), View: toView(match.view)})
+	}
+	output.Project, output.View = output.Matches[0].Project, output.Matches[0].View
+
+	data, err := h.deps.OutputFormatter.Format(formatted)
+	if err != nil {
+		return nil, FindViewOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// projectViewMatch is a view matched by name and the project holding it
+type projectViewMatch struct {
+	project *vikunja.Project
+	view    *vikunja.ProjectView
+}
+
+// searchProjectViews loads the views of projects concurrently and returns those matching
+// viewName, in project order. A project deleted since it was listed is skipped.
+func searchProjectViews(ctx context.Context, client *vikunja.Client, projects []*vikunja.Project, viewName string, fuzzy bool) ([]projectViewMatch, error) {
+	perProject := make([][]projectViewMatch, len(projects))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(discoverViewFetches)
+
+	for i, p := range projects {
+		g.Go(func() error {
+			views, err := client.GetProjectViews(ctx, p.ID)
+			if errors.Is(err, vikunja.ErrNotFound) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get views of project %d: %w", p.ID, err)
+			}
+			for _, v := range views {
+				if viewNameMatches(v.Title, viewName, fuzzy) {
+					perProject[i] = append(perProject[i], projectViewMatch{project: p, view: v})
+				}
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var found []projectViewMatch
+	for _, matches := range perProject {
+		found = append(found, matches...)
+	}
+	return found, nil
+}
+
+// viewNameMatches applies find_view's matching: exact titles, or case-insensitive substrings when fuzzy
+func viewNameMatches(title, viewName string, fuzzy bool) bool {
+	if fuzzy {
+		return containsIgnoreCase(title, viewName)
+	}
+	return title == viewName
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sprintBoardServer serves two projects of which only project 8 has a "Sprint Board" view
+func sprintBoardServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":7,"title":"Home"},{"id":8,"title":"Work"}]`) //nolint:errcheck
+		case "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":70,"title":"List","view_kind":"list","project_id":7}]`) //nolint:errcheck
+		case "/api/v1/projects/8/views":
+			fmt.Fprint(w, `[{"id":80,"title":"List","view_kind":"list","project_id":8},`+ //nolint:errcheck
+				`{"id":81,"title":"Sprint Board","view_kind":"kanban","project_id":8}]`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestFindViewHandler_AllProjects(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		input     FindViewInput
+		wantViews []int64
+	}{
+		{"exact name", FindViewInput{ViewName: "Sprint Board", AllProjects: true}, []int64{81}},
+		{"fuzzy name", FindViewInput{ViewName: "sprint", Fuzzy: true, AllProjects: true}, []int64{81}},
+		{"name in every project", FindViewInput{ViewName: "List", AllProjects: true}, []int64{70, 80}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, sprintBoardServer(t))
+
+			_, output, err := h.findViewHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+
+			var views []int64
+			for _, match := range output.Matches {
+				assert.Equal(t, match.View.ProjectID, match.Project.ID)
+				views = append(views, match.View.ID)
+			}
+			assert.Equal(t, tt.wantViews, views)
+			assert.Equal(t, tt.wantViews[0], output.View.ID)
+			assert.False(t, output.Truncated)
+		})
+	}
+}
+
+func TestFindViewHandler_AllProjectsNotFound(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, sprintBoardServer(t))
+
+	result, _, err := h.findViewHandler(t.Context(), nil, FindViewInput{ViewName: "Roadmap", AllProjects: true})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), `view with title "Roadmap" not found in any of 2 projects`)
+}
+
+func TestFindViewHandler_AllProjectsIgnoresStaleCache(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":7,"title":"Home"},{"id":8,"title":"Work"},{"id":9,"title":"Gone"}]`) //nolint:errcheck
+		case "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":70,"title":"List","view_kind":"list","project_id":7}]`) //nolint:errcheck
+		case "/api/v1/projects/8/views":
+			fmt.Fprint(w, `[{"id":81,"title":"Sprint Board","view_kind":"kanban","project_id":8}]`) //nolint:errcheck
+		case "/api/v1/projects/9/views":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":3001,"message":"The project does not exist."}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	// The cache predates project 8, created elsewhere, and project 9 was deleted after it was listed
+	h.projects.store([]*vikunja.Project{{ID: 7, Title: "Home"}})
+
+	_, output, err := h.findViewHandler(t.Context(), nil, FindViewInput{ViewName: "Sprint Board", AllProjects: true})
+	require.NoError(t, err)
+
+	require.Len(t, output.Matches, 1)
+	assert.Equal(t, int64(81), output.Matches[0].View.ID)
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// getBucketHandler handles the get_bucket tool
+func (h *Handlers) getBucketHandler(ctx context.Context, _ *mcp.CallToolRequest, input GetBucketInput) (*mcp.CallToolResult, GetBucketOutput, error) {
+	var errs ValidationErrors
+	projectID := errs.parseID("project_id", input.ProjectID)
+	viewID := errs.parseID("view_id", input.ViewID)
+	bucketID := errs.parseID("bucket_id", input.BucketID)
+	if err := errs.err(); err != nil {
+		return h.buildErrorResult(err.Error()), GetBucketOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, GetBucketOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	view, err := h.resolveView(ctx, client, projectID, strconv.FormatInt(viewID, 10))
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetBucketOutput{}, err
+	}
+
+	response, err := h.getViewTasks(ctx, client, projectID, view, 0, "")
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetBucketOutput{}, err
+	}
+
+	bucket, err := h.findBucket(response.Buckets, bucketID, "", view.Title)
+	if err != nil {
+		err = enhancedBucketIDNotFoundError(bucketID, viewID, bucketLabels(response.Buckets))
+		return h.buildErrorResult(err.Error()), GetBucketOutput{}, err
+	}
+
+	bt := vikunja.BucketTasks{
+		Bucket:       *bucket,
+		Tasks:        bucket.Tasks,
+		IsDoneBucket: view.DoneBucketID != 0 && bucket.ID == view.DoneBucketID,
+	}
+	result, err := h.formatResult(bt)
+	if err != nil {
+		return nil, GetBucketOutput{}, err
+	}
+	return result, GetBucketOutput{Bucket: toBoardBucket(&bt)}, nil
+}
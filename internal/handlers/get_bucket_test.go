@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const getBucketTasksBody = `[
+	{"id":10,"title":"Todo","project_view_id":3,"tasks":[{"id":1,"title":"One"},{"id":2,"title":"Two"}]},
+	{"id":12,"title":"Done","project_view_id":3,"tasks":[{"id":3,"title":"Three","done":true}]}
+]`
+
+func TestGetBucketHandler(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, kanbanServer(t, getBucketTasksBody))
+	h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+	result, output, err := h.getBucketHandler(t.Context(), nil, GetBucketInput{ProjectID: "7", ViewID: "3", BucketID: "10"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(10), output.Bucket.Bucket.ID)
+	assert.Equal(t, "Todo", output.Bucket.Bucket.Title)
+	require.Len(t, output.Bucket.Tasks, 2)
+	assert.Equal(t, int64(1), output.Bucket.Tasks[0].ID)
+	assert.Equal(t, int64(2), output.Bucket.Tasks[1].ID)
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.Contains(t, text, "# 📁 Todo (ID: 10)")
+	assert.Contains(t, text, "- **Tasks**: 2")
+	assert.Contains(t, text, "[Task 2] Two")
+	assert.NotContains(t, text, "Three")
+}
+
+func TestGetBucketHandler_NotFound(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, kanbanServer(t, getBucketTasksBody))
+
+	result, _, err := h.getBucketHandler(t.Context(), nil, GetBucketInput{ProjectID: "7", ViewID: "3", BucketID: "99"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bucket with ID 99 not found in view 3")
+	assert.Contains(t, err.Error(), "Todo (10)")
+	assert.True(t, result.IsError)
+}
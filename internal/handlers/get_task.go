@@ -7,11 +7,12 @@ import (
 
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/sync/errgroup"
 )
 
 // getTaskHandler handles the get_task tool
 func (h *Handlers) getTaskHandler(ctx context.Context, _ *mcp.CallToolRequest, input GetTaskInput) (*mcp.CallToolResult, GetTaskOutput, error) {
-	client, err := createVikunjaClient()
+	client, err := h.vikunjaClient()
 	if err != nil {
 		return nil, GetTaskOutput{}, err
 	}
@@ -21,22 +22,104 @@ func (h *Handlers) getTaskHandler(ctx context.Context, _ *mcp.CallToolRequest, i
 		return h.buildErrorResult(err.Error()), GetTaskOutput{}, err
 	}
 
-	task, err := client.GetTask(ctx, taskID)
+	task, err := client.GetTask(ctx, taskID, taskExpands(input)...)
 	if err != nil {
 		return nil, GetTaskOutput{}, fmt.Errorf("failed to get task: %w", err)
 	}
 
-	var bucketInfo *vikunja.TaskBucketInfo
+	extras := h.fetchTaskExtras(ctx, client, task, input)
+	return h.formatGetTaskOutput(task, extras)
+}
+
+// taskExpands returns the relations get_task asks Vikunja to include: always the task's buckets,
+// which the task output lists, and whatever else the input asks for
+func taskExpands(input GetTaskInput) []vikunja.TaskExpand {
+	expand := []vikunja.TaskExpand{vikunja.TaskExpandBuckets}
+	if input.IncludeSubtasks {
+		expand = append(expand, vikunja.TaskExpandSubtasks)
+	}
+	if input.IncludeCommentSummary {
+		expand = append(expand, vikunja.TaskExpandCommentCount)
+	}
+	return expand
+}
+
+// taskExtras holds the optional sections of a get_task response
+type taskExtras struct {
+	buckets   *vikunja.TaskBucketInfo
+	labels    []*vikunja.Label
+	assignees []*vikunja.User
+	subtasks  []*vikunja.Task
+	comments  *vikunja.CommentSummary
+}
+
+// fetchTaskExtras loads the requested optional sections in parallel.
+// A failing section is logged and left out rather than failing the whole request.
+func (h *Handlers) fetchTaskExtras(ctx context.Context, client *vikunja.Client, task *vikunja.Task, input GetTaskInput) taskExtras {
+	var extras taskExtras
+	if input.IncludeSubtasks {
+		extras.subtasks = vikunja.Subtasks(task)
+	}
+	var g errgroup.Group
+
 	if input.IncludeBuckets {
-		bucketInfo, err = h.buildTaskBucketInfo(ctx, client, task)
-		if err != nil {
-			h.deps.Logger.Warn("failed to get bucket info for task",
-				slog.Int64("task_id", taskID),
-				slog.Any("error", err))
-		}
+		g.Go(func() error {
+			var err error
+			extras.buckets, err = h.buildTaskBucketInfo(ctx, client, task)
+			h.warnTaskSection(err, "bucket info", task.ID)
+			return nil
+		})
+	}
+	if input.IncludeLabels {
+		g.Go(func() error {
+			var err error
+			extras.labels, err = client.GetTaskLabels(ctx, task.ID)
+			h.warnTaskSection(err, "labels", task.ID)
+			return nil
+		})
+	}
+	if input.IncludeAssignees {
+		g.Go(func() error {
+			var err error
+			extras.assignees, err = client.GetTaskAssignees(ctx, task.ID)
+			h.warnTaskSection(err, "assignees", task.ID)
+			return nil
+		})
 	}
 
-	return h.formatGetTaskOutput(task, bucketInfo)
+	if input.IncludeCommentSummary {
+		g.Go(func() error {
+			var err error
+			extras.comments, err = summarizeComments(ctx, client, task)
+			h.warnTaskSection(err, "comment summary", task.ID)
+			return nil
+		})
+	}
+
+	_ = g.Wait() //nolint:errcheck // section errors are logged, never returned
+	return extras
+}
+
+// summarizeComments describes the discussion of a task read with its comment count, fetching only
+// the latest comment and none at all when there are no comments
+func summarizeComments(ctx context.Context, client *vikunja.Client, task *vikunja.Task) (*vikunja.CommentSummary, error) {
+	if task.CommentCount == 0 {
+		return vikunja.SummarizeComments(task, nil), nil
+	}
+	latest, err := client.LatestTaskComment(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	return vikunja.SummarizeComments(task, latest), nil
+}
+
+func (h *Handlers) warnTaskSection(err error, section string, taskID int64) {
+	if err == nil {
+		return
+	}
+	h.deps.Logger.Warn("failed to get "+section+" for task",
+		slog.Int64("task_id", taskID),
+		slog.Any("error", err))
 }
 
 func (h *Handlers) buildTaskBucketInfo(ctx context.Context, client *vikunja.Client, task *vikunja.Task) (*vikunja.TaskBucketInfo, error) {
@@ -45,9 +128,14 @@ func (h *Handlers) buildTaskBucketInfo(ctx context.Context, client *vikunja.Clie
 		return nil, err
 	}
 
+	buckets := task.Buckets
+	if len(buckets) == 0 {
+		buckets = h.findTaskBuckets(ctx, client, task, views)
+	}
+
 	taskViews := make([]vikunja.TaskViewInfo, 0, len(views))
 	for _, view := range views {
-		viewInfo := h.buildViewInfoForTask(view, task)
+		viewInfo := h.buildViewInfoForTask(view, buckets)
 		taskViews = append(taskViews, viewInfo)
 	}
 
@@ -57,14 +145,30 @@ func (h *Handlers) buildTaskBucketInfo(ctx context.Context, client *vikunja.Clie
 	}, nil
 }
 
-func (h *Handlers) buildViewInfoForTask(view *vikunja.ProjectView, task *vikunja.Task) vikunja.TaskViewInfo {
+// findTaskBuckets reconstructs a task's buckets from its kanban views when the server predates
+// expand=buckets. On servers that expand buckets an empty list is accurate and is kept.
+func (h *Handlers) findTaskBuckets(ctx context.Context, client *vikunja.Client, task *vikunja.Task, views []*vikunja.ProjectView) []*vikunja.Bucket {
+	expands, err := client.ExpandsTaskBuckets(ctx)
+	if err != nil {
+		h.deps.Logger.Debug("could not determine Vikunja version, locating task buckets from views", slog.Any("error", err))
+	}
+	if expands {
+		return nil
+	}
+
+	buckets, err := client.FindTaskBuckets(ctx, task.ProjectID, task.ID, views)
+	h.warnTaskSection(err, "fallback bucket info", task.ID)
+	return buckets
+}
+
+func (h *Handlers) buildViewInfoForTask(view *vikunja.ProjectView, buckets []*vikunja.Bucket) vikunja.TaskViewInfo {
 	viewInfo := vikunja.TaskViewInfo{
 		ViewID:    view.ID,
 		ViewTitle: view.Title,
 		ViewKind:  view.ViewKind,
 	}
 
-	for _, bucket := range task.Buckets {
+	for _, bucket := range buckets {
 		if bucket.ProjectViewID != view.ID {
 			continue
 		}
@@ -81,12 +185,17 @@ func (h *Handlers) buildViewInfoForTask(view *vikunja.ProjectView, task *vikunja
 	return viewInfo
 }
 
-func (h *Handlers) formatGetTaskOutput(task *vikunja.Task, bucketInfo *vikunja.TaskBucketInfo) (*mcp.CallToolResult, GetTaskOutput, error) {
+func (h *Handlers) formatGetTaskOutput(task *vikunja.Task, extras taskExtras) (*mcp.CallToolResult, GetTaskOutput, error) {
 	output := GetTaskOutput{
-		Task: toTask(task),
+		Task:       toTask(task),
+		Labels:     toLabels(extras.labels),
+		Assignees:  toAssignees(extras.assignees),
+		Subtasks:   toTasksSummary(extras.subtasks),
+		Comments:   extras.comments,
+		Subscribed: vikunja.IsSubscribed(task),
 	}
-	if bucketInfo != nil {
-		output.Buckets = bucketInfo
+	if extras.buckets != nil {
+		output.Buckets = extras.buckets
 	}
 
 	vikunjaOutput := vikunja.TaskOutput{
@@ -101,8 +210,18 @@ func (h *Handlers) formatGetTaskOutput(task *vikunja.Task, bucketInfo *vikunja.T
 			Updated:     output.Task.Updated,
 			Buckets:     toVikunjaBuckets(output.Task.Buckets),
 			Position:    output.Task.Position,
+			Priority:    output.Task.Priority,
+			HexColor:    output.Task.HexColor,
+			PercentDone: output.Task.PercentDone,
+			StartDate:   output.Task.StartDate,
+			EndDate:     output.Task.EndDate,
 		},
-		Buckets: output.Buckets,
+		Buckets:    output.Buckets,
+		Labels:     extras.labels,
+		Assignees:  extras.assignees,
+		Subtasks:   extras.subtasks,
+		Comments:   extras.comments,
+		Subscribed: &output.Subscribed,
 	}
 
 	data, err := h.deps.OutputFormatter.Format(vikunjaOutput)
@@ -119,7 +238,7 @@ func (h *Handlers) formatGetTaskOutput(task *vikunja.Task, bucketInfo *vikunja.T
 
 // listBucketsHandler handles the list_buckets tool
 func (h *Handlers) listBucketsHandler(ctx context.Context, _ *mcp.CallToolRequest, input ListBucketsInput) (*mcp.CallToolResult, ListBucketsOutput, error) {
-	client, err := createVikunjaClient()
+	client, err := h.vikunjaClient()
 	if err != nil {
 		return nil, ListBucketsOutput{}, err
 	}
@@ -133,10 +252,13 @@ func (h *Handlers) listBucketsHandler(ctx context.Context, _ *mcp.CallToolReques
 }
 
 func (h *Handlers) resolveBucketParams(ctx context.Context, client *vikunja.Client, input ListBucketsInput) (project *Project, v *vikunja.ProjectView, buckets []*vikunja.Bucket, err error) {
-	projectTitle := coalesceString(input.ProjectTitle, "Inbox")
 	viewTitle := coalesceString(input.ViewTitle, "Kanban")
 
-	project, err = findProjectByIDOrTitle(ctx, client, "", projectTitle)
+	if input.ProjectTitle == "" {
+		project, err = h.defaultProject(ctx, client)
+	} else {
+		project, err = h.findProjectByIDOrTitle(ctx, client, "", input.ProjectTitle)
+	}
 	if err != nil {
 		return nil, nil, nil, err
 	}
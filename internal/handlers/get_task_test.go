@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// taskDetailsServer serves a task together with its labels and assignees
+func taskDetailsServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks/5":
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","project_id":1,"priority":3}`) //nolint:errcheck
+		case "/api/v1/tasks/5/labels":
+			fmt.Fprint(w, `[{"id":1,"title":"urgent","hex_color":"ff0000"}]`) //nolint:errcheck
+		case "/api/v1/tasks/5/assignees":
+			fmt.Fprint(w, `[{"id":2,"username":"alex","name":"Alex"}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestGetTaskHandler_OptionalSections(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		input         GetTaskInput
+		wantLabels    bool
+		wantAssignees bool
+	}{
+		{"task only", GetTaskInput{TaskID: "5"}, false, false},
+		{"labels", GetTaskInput{TaskID: "5", IncludeLabels: true}, true, false},
+		{"assignees", GetTaskInput{TaskID: "5", IncludeAssignees: true}, false, true},
+		{"both", GetTaskInput{TaskID: "5", IncludeLabels: true, IncludeAssignees: true}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, taskDetailsServer(t))
+			h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+			result, output, err := h.getTaskHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+			text := result.Content[0].(*mcp.TextContent).Text
+
+			assert.Equal(t, int64(3), output.Task.Priority)
+			assert.Contains(t, text, "**Priority**: High (3)")
+			assert.Equal(t, tt.wantLabels, output.Labels != nil)
+			assert.Equal(t, tt.wantLabels, strings.Contains(text, "**Labels**"))
+			assert.Equal(t, tt.wantAssignees, output.Assignees != nil)
+			assert.Equal(t, tt.wantAssignees, strings.Contains(text, "**Assignees**"))
+			if tt.wantLabels {
+				assert.Equal(t, "urgent", output.Labels[0].Title)
+			}
+			if tt.wantAssignees {
+				assert.Contains(t, text, "Alex (@alex)")
+			}
+		})
+	}
+}
+
+// unexpandedBucketsServer serves task 5 without its buckets from a server reporting version, with
+// the task sitting in the Done bucket (12) of project 1's kanban view (4)
+func unexpandedBucketsServer(t *testing.T, version string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks/5":
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","project_id":1}`) //nolint:errcheck
+		case "/api/v1/info":
+			fmt.Fprintf(w, `{"version":%q}`, version) //nolint:errcheck
+		case "/api/v1/projects/1/views":
+			fmt.Fprint(w, `[{"id":3,"title":"List","view_kind":"list"},{"id":4,"title":"Kanban","view_kind":"kanban","done_bucket_id":12}]`) //nolint:errcheck
+		case "/api/v1/projects/1/views/4/tasks":
+			if version != "v0.22.1" {
+				t.Errorf("buckets were reconstructed although %s expands them", version)
+			}
+			fmt.Fprint(w, `[{"id":11,"title":"Todo","tasks":[{"id":6}]},{"id":12,"title":"Done","tasks":[{"id":5}]}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestGetTaskHandler_BucketsWithoutExpand(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpandedBucketsServer(t, "v0.22.1"))
+
+	_, output, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5", IncludeBuckets: true})
+	require.NoError(t, err)
+
+	require.NotNil(t, output.Buckets)
+	require.Len(t, output.Buckets.Views, 2)
+	assert.Nil(t, output.Buckets.Views[0].BucketID, "list views have no buckets")
+	kanban := output.Buckets.Views[1]
+	require.NotNil(t, kanban.BucketID)
+	assert.Equal(t, int64(12), *kanban.BucketID)
+	assert.Equal(t, "Done", *kanban.BucketTitle)
+	assert.True(t, kanban.IsDoneBucket)
+}
+
+func TestGetTaskHandler_ExpandingServerKeepsEmptyBuckets(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpandedBucketsServer(t, "v0.24.1"))
+
+	_, output, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5", IncludeBuckets: true})
+	require.NoError(t, err)
+
+	require.NotNil(t, output.Buckets)
+	for _, view := range output.Buckets.Views {
+		assert.Nil(t, view.BucketID)
+	}
+}
+
+func TestGetTaskHandler_Subtasks(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks/5", r.URL.Path)
+		assert.Equal(t, "buckets,subtasks", r.URL.Query().Get("expand"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":5,"title":"Ship it","related_tasks":{"subtask":[{"id":6,"title":"Write changelog","done":true}]}}`) //nolint:errcheck
+	})
+	h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+	result, output, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5", IncludeSubtasks: true})
+	require.NoError(t, err)
+
+	require.Len(t, output.Subtasks, 1)
+	assert.Equal(t, int64(6), output.Subtasks[0].ID)
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "✅ [Task 6] Write changelog")
+}
+
+func TestGetTaskHandler_CommentSummary(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks/5":
+			assert.Equal(t, "buckets,comment_count", r.URL.Query().Get("expand"))
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","comment_count":3}`) //nolint:errcheck
+		case "/api/v1/tasks/5/comments":
+			assert.Equal(t, "desc", r.URL.Query().Get("order_by"))
+			assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+			fmt.Fprint(w, `[{"id":9,"comment":"Blocked on the release notes","created":"2026-10-14T09:30:00Z","author":{"username":"alice"}}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+	result, output, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5", IncludeCommentSummary: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, &vikunja.CommentSummary{Count: 3, LatestAt: "2026-10-14T09:30:00Z", LatestAuthor: "alice"}, output.Comments)
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.Contains(t, text, "**Comments**: 3, latest 2026-10-14 09:30 by @alice")
+	assert.NotContains(t, text, "Blocked on the release notes", "the comment thread must not be included")
+}
+
+func TestGetTaskHandler_CommentSummaryWithoutComments(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks/5", r.URL.Path, "comments must not be fetched for a task without any")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":5,"title":"Ship it"}`) //nolint:errcheck
+	})
+
+	_, output, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5", IncludeCommentSummary: true})
+	require.NoError(t, err)
+	assert.Equal(t, &vikunja.CommentSummary{}, output.Comments)
+}
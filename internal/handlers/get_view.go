@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// getViewHandler handles the get_view tool
+func (h *Handlers) getViewHandler(ctx context.Context, _ *mcp.CallToolRequest, input GetViewInput) (*mcp.CallToolResult, GetViewOutput, error) {
+	var errs ValidationErrors
+	projectID := errs.parseID("project_id", input.ProjectID)
+	viewID := errs.parseID("view_id", input.ViewID)
+	if err := errs.err(); err != nil {
+		return h.buildErrorResult(err.Error()), GetViewOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, GetViewOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	views, err := client.GetProjectViews(ctx, projectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetViewOutput{}, fmt.Errorf("failed to get project views: %w", err)
+	}
+	i := slices.IndexFunc(views, func(v *vikunja.ProjectView) bool { return v.ID == viewID })
+	if i < 0 {
+		err := enhancedViewIDNotFoundError(viewID, projectID, viewLabels(views))
+		return h.buildErrorResult(err.Error()), GetViewOutput{}, err
+	}
+
+	result, err := h.formatResult(views[i])
+	if err != nil {
+		return nil, GetViewOutput{}, err
+	}
+	return result, GetViewOutput{View: toView(views[i])}, nil
+}
+
+// viewLabels describes views by title and ID for not-found errors
+func viewLabels(views []*vikunja.ProjectView) []string {
+	labels := make([]string, len(views))
+	for i, v := range views {
+		labels[i] = fmt.Sprintf("%s (%d)", v.Title, v.ID)
+	}
+	return labels
+}
+
+// enhancedViewIDNotFoundError provides contextual error message with available views in a project
+func enhancedViewIDNotFoundError(viewID, projectID int64, availableViews []string) error {
+	var suggestion string
+	switch {
+	case len(availableViews) == 0:
+	case len(availableViews) <= 3:
+		suggestion = fmt.Sprintf(" Available views in project %d: %v", projectID, availableViews)
+	default:
+		suggestion = fmt.Sprintf(" Available views in project %d include: %s, %s, and %d others",
+			projectID, availableViews[0], availableViews[1], len(availableViews)-2)
+	}
+	return fmt.Errorf("view with ID %d not found in project %d.%s Try: list_views() to see project views", viewID, projectID, suggestion)
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// viewsServer serves the list and kanban views of project 7
+func viewsServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/projects/7/views" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		fmt.Fprint(w, `[{"id":2,"project_id":7,"title":"List","view_kind":"list"},`+ //nolint:errcheck
+			`{"id":3,"project_id":7,"title":"Kanban","view_kind":"kanban","bucket_configuration_mode":"manual","default_bucket_id":10,"done_bucket_id":12}]`)
+	}
+}
+
+func TestGetViewHandler(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, viewsServer(t))
+	h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+	result, output, err := h.getViewHandler(t.Context(), nil, GetViewInput{ProjectID: "7", ViewID: "3"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), output.View.ID)
+	assert.Equal(t, "manual", output.View.BucketConfigurationMode)
+	assert.Equal(t, int64(10), output.View.DefaultBucketID)
+	assert.Equal(t, int64(12), output.View.DoneBucketID)
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.Contains(t, text, "# Kanban")
+	assert.Contains(t, text, "- **Bucket Configuration**: manual")
+	assert.Contains(t, text, "- **Done Bucket**: 12")
+}
+
+func TestGetViewHandler_Validation(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.getViewHandler(t.Context(), nil, GetViewInput{ProjectID: "seven"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "project_id: must be a valid integer")
+	assert.Contains(t, err.Error(), "view_id")
+	assert.True(t, result.IsError)
+}
+
+func TestGetViewHandler_NotFound(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, viewsServer(t))
+
+	result, _, err := h.getViewHandler(t.Context(), nil, GetViewInput{ProjectID: "7", ViewID: "9"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "view with ID 9 not found in project 7")
+	assert.Contains(t, err.Error(), "List (2)")
+	assert.Contains(t, err.Error(), "Kanban (3)")
+	assert.Contains(t, err.Error(), "list_views()")
+	assert.True(t, result.IsError)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// groupTasksByLabelHandler handles the group_tasks_by_label tool. Vikunja's task listing carries
+// each task's labels, so the project's tasks are grouped without a request per task.
+func (h *Handlers) groupTasksByLabelHandler(ctx context.Context, _ *mcp.CallToolRequest, input GroupTasksByLabelInput) (*mcp.CallToolResult, GroupTasksByLabelOutput, error) {
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, GroupTasksByLabelOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	project, err := h.findProjectByIDOrTitle(ctx, client, input.ProjectID, input.ProjectTitle)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GroupTasksByLabelOutput{}, err
+	}
+
+	filter := fmt.Sprintf("project = %d", project.ID)
+	if input.Done != nil {
+		filter += fmt.Sprintf(" && done = %t", *input.Done)
+	}
+	tasks, err := client.GetAllTasks(ctx, filter)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GroupTasksByLabelOutput{}, err
+	}
+
+	output := GroupTasksByLabelOutput{Project: *project, Groups: groupTasksByLabel(tasks)}
+	result, err := h.formatResult(toVikunjaLabelGroups(output))
+	if err != nil {
+		return nil, GroupTasksByLabelOutput{}, err
+	}
+	return result, output, nil
+}
+
+// groupTasksByLabel groups tasks under each of their labels, ordered by label title, and ends
+// with the group of unlabeled tasks, which is always present
+func groupTasksByLabel(tasks []*vikunja.Task) []TaskLabelGroup {
+	byLabel := map[int64]*TaskLabelGroup{}
+	unlabeled := TaskLabelGroup{Label: vikunja.UnlabeledGroup, Tasks: []TaskSummary{}}
+	for _, t := range tasks {
+		if len(t.Labels) == 0 {
+			unlabeled.Tasks = append(unlabeled.Tasks, toTaskSummary(t))
+			continue
+		}
+		for _, l := range t.Labels {
+			group, ok := byLabel[l.ID]
+			if !ok {
+				group = &TaskLabelGroup{LabelID: l.ID, Label: l.Title, Tasks: []TaskSummary{}}
+				byLabel[l.ID] = group
+			}
+			group.Tasks = append(group.Tasks, toTaskSummary(t))
+		}
+	}
+
+	groups := make([]TaskLabelGroup, 0, len(byLabel)+1)
+	for _, group := range byLabel {
+		groups = append(groups, *group)
+	}
+	slices.SortFunc(groups, func(a, b TaskLabelGroup) int {
+		return cmp.Or(cmp.Compare(a.Label, b.Label), cmp.Compare(a.LabelID, b.LabelID))
+	})
+	return append(groups, unlabeled)
+}
+
+// toVikunjaLabelGroups converts the output for formatting, leaving out task URIs as list_tasks does
+func toVikunjaLabelGroups(output GroupTasksByLabelOutput) vikunja.TaskLabelGroups {
+	lg := vikunja.TaskLabelGroups{
+		ProjectID:    output.Project.ID,
+		ProjectTitle: output.Project.Title,
+		Groups:       make([]vikunja.TaskLabelGroup, len(output.Groups)),
+	}
+	for i, group := range output.Groups {
+		tasks := make([]vikunja.TaskSummary, len(group.Tasks))
+		for j, task := range group.Tasks {
+			tasks[j] = vikunja.TaskSummary{ID: task.ID, Title: task.Title}
+		}
+		lg.Groups[i] = vikunja.TaskLabelGroup{LabelID: group.LabelID, Label: group.Label, Tasks: tasks}
+	}
+	return lg
+}
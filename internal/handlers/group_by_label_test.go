@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupTasksByLabelHandler(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tasks" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		assert.Equal(t, "project = 7 && done = false", r.URL.Query().Get("filter"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"id":1,"title":"Fix login","labels":[{"id":20,"title":"bug"},{"id":21,"title":"auth"}]},
+			{"id":2,"title":"Write docs","labels":[]},
+			{"id":3,"title":"Crash on save","labels":[{"id":20,"title":"bug"}]},
+			{"id":4,"title":"Plan sprint"}
+		]`) //nolint:errcheck
+	})
+	h.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+	done := false
+	result, output, err := h.groupTasksByLabelHandler(t.Context(), nil, GroupTasksByLabelInput{ProjectID: "7", Done: &done})
+	require.NoError(t, err)
+
+	taskIDs := func(group TaskLabelGroup) []int64 {
+		var ids []int64
+		for _, task := range group.Tasks {
+			ids = append(ids, task.ID)
+		}
+		return ids
+	}
+	require.Len(t, output.Groups, 3)
+	assert.Equal(t, "auth", output.Groups[0].Label)
+	assert.Equal(t, []int64{1}, taskIDs(output.Groups[0]))
+	assert.Equal(t, "bug", output.Groups[1].Label)
+	assert.Equal(t, int64(20), output.Groups[1].LabelID)
+	assert.Equal(t, []int64{1, 3}, taskIDs(output.Groups[1]))
+	assert.Equal(t, vikunja.UnlabeledGroup, output.Groups[2].Label)
+	assert.Zero(t, output.Groups[2].LabelID)
+	assert.Equal(t, []int64{2, 4}, taskIDs(output.Groups[2]))
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.Contains(t, text, "## 🏷️ bug (ID: 20)\n\n- [Task 1] Fix login\n- [Task 3] Crash on save\n")
+	assert.Contains(t, text, "## (unlabeled)\n\n- [Task 2] Write docs\n- [Task 4] Plan sprint\n")
+}
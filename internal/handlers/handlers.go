@@ -2,9 +2,14 @@
 package handlers
 
 import (
+	"fmt"
 	"log/slog"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/internal/metrics"
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -15,11 +20,30 @@ type HandlerDependencies struct {
 	OutputFormatter vikunja.OutputFormatter
 	Config          *config.Config
 	Logger          *slog.Logger
+	// DryRun makes mutating tools report the requests they would send instead of sending them
+	DryRun bool
+	// Now returns the current time; date-relative tools use it so tests can pin the clock
+	Now func() time.Time
+	// Metrics, when set, counts tool calls and the Vikunja requests they make
+	Metrics *metrics.Registry
 }
 
 // Handlers provides all MCP tool handlers
 type Handlers struct {
-	deps *HandlerDependencies
+	deps        *HandlerDependencies
+	idempotency *idempotencyCache
+	projects    *projectCache
+	moves       *moveHistory
+	// taskCounts remembers recent open task counts by project
+	taskCounts *taskCountCache
+	// tools decides which tools Register adds to the MCP server
+	tools *toolSelection
+	// defaultProjects remembers the project used when a call names none
+	defaultProjects *defaultProjectCache
+
+	// clientMu guards client, the environment-configured client created on first use
+	clientMu sync.Mutex
+	client   *vikunja.Client
 }
 
 // NewHandlers creates a new Handlers instance with dependency injection
@@ -27,67 +51,315 @@ func NewHandlers(deps *HandlerDependencies) *Handlers {
 	if deps.Logger == nil {
 		deps.Logger = slog.Default()
 	}
-	return &Handlers{deps: deps}
+	if deps.Now == nil {
+		deps.Now = time.Now
+	}
+	return &Handlers{
+		deps:            deps,
+		idempotency:     newIdempotencyCache(),
+		projects:        newProjectCache(),
+		moves:           newMoveHistory(),
+		taskCounts:      newTaskCountCache(),
+		tools:           newToolSelection(deps.Config),
+		defaultProjects: newDefaultProjectCache(),
+	}
 }
 
 // TODO: These will be replaced with proper handler methods after file splitting
 // For now, we need to import the handlers from split files
 
-// Register adds all Vikunja tool handlers to MCP server.
-func Register(s *mcp.Server, cfg *config.Config) {
+// Register adds the Vikunja tool handlers the configuration enables to MCP server.
+func Register(s *mcp.Server, cfg *config.Config) error {
+	_, err := RegisterWithMetrics(s, cfg, nil)
+	return err
+}
+
+// RegisterWithMetrics adds the Vikunja tool handlers the configuration enables to MCP server,
+// recording tool calls and Vikunja requests in m when it is not nil. It returns the handlers, so
+// the caller can reload their API token, or an error when the configuration enables or disables a
+// tool which does not exist.
+func RegisterWithMetrics(s *mcp.Server, cfg *config.Config, m *metrics.Registry) (*Handlers, error) {
 	// Initialize dependencies
+	formatter := vikunja.GetFormatter(cfg.OutputFormat)
+	if cfg.HumanizeTimes {
+		formatter = vikunja.HumanizeTimes(formatter, time.Now)
+	}
+	if cfg.CompactJSON {
+		formatter = vikunja.CompactJSON(formatter)
+	}
+	if cfg.DescriptionPreviewChars > 0 {
+		formatter = vikunja.PreviewDescriptions(formatter, cfg.DescriptionPreviewChars)
+	}
+	if cfg.FormatFailure != config.FormatFailureError {
+		formatter = vikunja.WithJSONFallback(formatter)
+	}
 	deps := &HandlerDependencies{
 		Config:          cfg,
-		OutputFormatter: vikunja.GetFormatter(cfg.OutputFormat),
+		OutputFormatter: formatter,
 		Logger:          slog.Default(),
+		DryRun:          cfg.DryRun,
+		Metrics:         m,
 	}
 
 	handlers := NewHandlers(deps)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "discover_vikunja",
+		Description: "Start here: describe the Vikunja server, its projects and each project's views. Reports total_projects and truncated when more projects exist than 'max_projects'. Set 'include_counts' for each project's number of open tasks. Views are capped by 'max_views_per_project' and 'max_views'; projects listing only some of their views are flagged views_truncated. Set 'profile' to minimal for only the projects and their views",
+	}, handlers.discoverHandler)
+
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "list_tasks",
-		Description: "List tasks from Vikunja filtering by criteria. Use 'project', 'view', and 'bucket' parameters with either ID (integer) or title (string). Defaults: project=Inbox, view=Kanban",
+		Description: "List tasks from Vikunja filtering by criteria. Use 'project', 'view', and 'bucket' parameters with either ID (integer) or title (string), or 'filter_id' for a saved filter. Defaults: project=the account's default project, view=Kanban",
 	}, handlers.listTasksHandler)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "get_board",
+		Description: "Get a view as a board: every bucket with its full tasks, including descriptions, due dates and priorities, and which bucket is the done bucket. Use 'project', 'view', and 'bucket' with either ID (integer) or title (string). Defaults: project=the account's default project, view=Kanban",
+	}, handlers.getBoardHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "board_summary",
+		Description: "Summarize a project's status: for each kanban view, how many tasks each bucket holds and how many of the view's tasks are done, without listing the tasks",
+	}, handlers.boardSummaryHandler)
+
+	// A watch holds its call open for minutes, which only the HTTP transport serves alongside other calls
+	if cfg.Transport == config.TransportHTTP {
+		addTool(s, handlers, &mcp.Tool{
+			Name:        "watch_project",
+			Description: "Wait for a project's board to change: poll the view every 'interval_seconds' until a task is added, updated, moved or removed, or 'timeout_seconds' pass, and return what changed. Use 'project' and 'view' with either ID (integer) or title (string). Defaults: project=the account's default project, view=Kanban",
+		}, handlers.watchProjectHandler)
+	}
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "list_all_tasks",
+		Description: "List tasks across all projects. Returns incomplete tasks unless 'done' is set; 'filter' accepts a Vikunja filter query to narrow the results and 'filter_id' applies a saved filter. Results come one page at a time: pass 'next_page' back as 'page' for the rest",
+	}, handlers.listAllTasksHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "count_tasks",
+		Description: "Count a project's tasks without listing them. Returns total, open and done counts; set 'done' to count only one state",
+	}, handlers.countTasksHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "group_tasks_by_label",
+		Description: "List a project's tasks grouped under each label, plus an \"(unlabeled)\" group. A task with several labels appears in each of their groups",
+	}, handlers.groupTasksByLabelHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "list_saved_filters",
+		Description: "List saved filters. Their IDs can be passed as 'filter_id' to list_tasks or list_all_tasks",
+	}, handlers.listSavedFiltersHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "list_upcoming_tasks",
+		Description: "List incomplete tasks across all projects that are due within the next 'days' days (default 1, i.e. due today), soonest first. Set 'include_overdue' to also list tasks whose due date has passed",
+	}, handlers.listUpcomingTasksHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "due_report",
+		Description: "Report incomplete tasks across all projects grouped by when they are due: Overdue, Today, This Week (until Sunday night), Later and No Due Date",
+	}, handlers.dueReportHandler)
+
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "get_task",
 		Description: "Get details of a specific task",
 	}, handlers.getTaskHandler)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "get_task_by_project_and_index",
+		Description: "Get the task at a 1-based position of a view, counted in the order list_tasks shows them, e.g. index 3 with bucket 'To Do' for the third task in that column. Use 'project', 'view' and 'bucket' with either ID (integer) or title (string). Defaults: project=the account's default project, view=Kanban",
+	}, handlers.getTaskByIndexHandler)
+
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "list_buckets",
 		Description: "List all buckets in a project view",
 	}, handlers.listBucketsHandler)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "get_bucket",
+		Description: "Get a single bucket of a kanban view with its tasks, by project, view and bucket ID",
+	}, handlers.getBucketHandler)
+
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "list_projects",
 		Description: "List all projects via this Vikunja connection.   Provides a list of projects including ID, name, and URI",
 	}, handlers.listProjectsHandler)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "list_writable_projects",
+		Description: "List only the projects this token can create and change tasks in (read & write or admin permission), to avoid write attempts that would be refused. Each project reports its max_right",
+	}, handlers.listWritableProjectsHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "get_project",
+		Description: "Get a project by ID (integer) or title (string), including its color. Set 'include_views' to also return its views, or 'include_buckets' to also return the buckets of its kanban views, saving follow-up calls",
+	}, handlers.getProjectHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "export_project",
+		Description: "Export a project by ID (integer) or title (string) as one portable JSON snapshot holding the project, its views with their kanban buckets, and all of its tasks",
+	}, handlers.exportProjectHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "import_project",
+		Description: "Recreate a project from an export_project snapshot as a new project with its views, buckets and tasks. Returns the new IDs keyed by their IDs in the snapshot, plus any part that could not be recreated",
+	}, handlers.importProjectHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "resolve_uri",
+		Description: "Fetch the task, project or view a vikunja:// URI from earlier output refers to, e.g. vikunja://task/123, vikunja://project/1 or vikunja://project/1/view/2",
+	}, handlers.resolveURIHandler)
+
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "create_task",
 		Description: "Create a new task in Vikunja",
 	}, handlers.createTaskHandler)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "import_tasks",
+		Description: fmt.Sprintf("Create up to %d tasks in a project at once from a list of {title, description?, due_date?}. Returns the created task IDs and, per failed item, its index and the reason; the other items are still created", maxImportTasks),
+	}, handlers.importTasksHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "create_tasks_in_buckets",
+		Description: fmt.Sprintf("Set up a board by creating up to %d tasks in a project's kanban view, each placed in the bucket named by its bucket_title. Returns the created tasks with their buckets, warning on each task placed past its bucket's limit, and, per failed item, its index and the reason; the other items are still created", maxImportTasks),
+	}, handlers.createTasksInBucketsHandler)
+
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "find_project_by_name",
 		Description: "Find a project by its name/title",
 	}, handlers.findProjectByNameHandler)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "find_view",
-		Description: "Find a specific view by name within a project",
+		Description: "Find a specific view by name within a project, or set 'all_projects' without a project to find every project holding a view of that name",
 	}, handlers.findViewHandler)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "list_views",
 		Description: "List all views for a project, optionally filtered by view kind",
 	}, handlers.listViewsHandler)
 
-	mcp.AddTool(s, &mcp.Tool{
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "get_view",
+		Description: "Get one view of a project by project and view ID, with its bucket configuration mode and default and done buckets",
+	}, handlers.getViewHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "create_view",
+		Description: "Create a new view (list, kanban, gantt or table) in a project",
+	}, handlers.createViewHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "update_view",
+		Description: "Update a project view's title, kind, bucket configuration mode, default bucket or done bucket",
+	}, handlers.updateViewHandler)
+
+	addTool(s, handlers, &mcp.Tool{
 		Name:        "move_task_to_bucket",
-		Description: "Move a task to a different bucket within a project view",
+		Description: "Move a task to a different bucket within a project view, identified by bucket ID or title. A move past the bucket's task limit is made with a warning, or refused when 'strict_wip' is set",
 	}, handlers.moveTaskToBucketHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "set_task_bucket_everywhere",
+		Description: "Move a task to the bucket with a given title, e.g. 'Done', in every kanban view of its project, reporting the outcome per view. Views without such a bucket are skipped; a move past the bucket's task limit is made with a warning",
+	}, handlers.setTaskBucketEverywhereHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "undo_last_move",
+		Description: "Move the task of the most recent move_task_to_bucket call back to the bucket it came from. Remembers the last 20 moves made through this server, and only while it runs",
+	}, handlers.undoLastMoveHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "move_task_to_project",
+		Description: "Move a task to a different project. Its bucket placement does not carry over: the task lands in the new project's default buckets, which the returned task shows",
+	}, handlers.moveTaskToProjectHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "watch_task",
+		Description: "Subscribe to notifications about a task's changes. Use 'get_task' to see whether you already watch it",
+	}, handlers.watchTaskHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "unwatch_task",
+		Description: "Stop notifications about a task's changes",
+	}, handlers.unwatchTaskHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "set_task_color",
+		Description: "Set a task's color. 'hex_color' is a hex triplet such as #1973ff; the leading # is optional",
+	}, handlers.setTaskColorHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "complete_task",
+		Description: "Mark a task done. Set 'move_to_done_bucket' to also move it to the done bucket of 'view' (default: the Kanban view) so it shows as finished on the board",
+	}, handlers.completeTaskHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "set_task_progress",
+		Description: "Set how far along a task is. 'percent_done' is a fraction from 0.0 to 1.0, e.g. 0.5 for 50%",
+	}, handlers.setTaskProgressHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "set_task_dates",
+		Description: "Set a task's start and end dates, which place it on Gantt views. Dates are YYYY-MM-DD or RFC 3339; a date left out keeps its current value. The start must not be after the end",
+	}, handlers.setTaskDatesHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "clear_task_field",
+		Description: "Remove a task's due date, all of its assignees or all of its labels. 'clear_field' is one of due_date, assignees or labels; the rest of the task is left as it is",
+	}, handlers.clearTaskFieldHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "set_project_color",
+		Description: "Set a project's color. 'project' is an ID (integer) or title (string); 'hex_color' is a hex triplet such as #1973ff; the leading # is optional",
+	}, handlers.setProjectColorHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "reorder_buckets",
+		Description: "Reorder the buckets (columns) of a view. Buckets are placed in the given order; any not listed keep their relative order after them",
+	}, handlers.reorderBucketsHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "rename_bucket",
+		Description: "Rename a bucket (column) of a view, identified by bucket ID or its current title",
+	}, handlers.renameBucketHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "ensure_project",
+		Description: "Return the project with the given title, creating it only when no project has that title. 'created' tells which happened, so setup scripts can run repeatedly",
+	}, handlers.ensureProjectHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "ensure_bucket",
+		Description: "Return the bucket with the given title in a kanban view, creating it only when the view has no such bucket. 'created' tells which happened, so setup scripts can run repeatedly",
+	}, handlers.ensureBucketHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "list_webhooks",
+		Description: "List all webhooks configured on a project",
+	}, handlers.listWebhooksHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "create_webhook",
+		Description: "Register a webhook on a project so Vikunja notifies an external URL about the selected events. Returns the webhook ID and signing secret",
+	}, handlers.createWebhookHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "delete_webhook",
+		Description: "Delete a webhook from a project",
+	}, handlers.deleteWebhookHandler)
+
+	addTool(s, handlers, &mcp.Tool{
+		Name:        "create_project_share",
+		Description: "Create a public link share for a project. Returns the share hash and the URL outsiders can open",
+	}, handlers.createProjectShareHandler)
+
+	if err := handlers.tools.validate(); err != nil {
+		return nil, fmt.Errorf("invalid tool selection: %w", err)
+	}
+	return handlers, nil
 }
 
 // isReadonly returns true if server is in readonly mode
@@ -97,3 +369,9 @@ func (h *Handlers) isReadonly() bool {
 	}
 	return false
 }
+
+// isReadonlyFor returns true if readonly mode blocks the named mutating tool, that is unless
+// the tool is on the configured readonly allow-list
+func (h *Handlers) isReadonlyFor(tool string) bool {
+	return h.isReadonly() && !slices.Contains(h.deps.Config.ReadonlyAllow, tool)
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestHandlers builds Handlers backed by a mock Vikunja server using JSON output.
+func newTestHandlers(t *testing.T, cfg *config.Config, handler http.HandlerFunc) *Handlers {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	client, err := vikunja.NewClient(ts.URL, "test-token", true)
+	require.NoError(t, err)
+
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+	return NewHandlers(&HandlerDependencies{
+		Client:          client,
+		OutputFormatter: vikunja.NewJSONFormatter(),
+		Config:          cfg,
+		Logger:          slog.New(slog.DiscardHandler),
+	})
+}
+
+// unexpectedRequest fails the test for any request the mock server was not expecting.
+func unexpectedRequest(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func TestRegister_BuildsToolSchemas(t *testing.T) {
+	t.Parallel()
+	s := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.0"}, nil)
+
+	// AddTool panics when an input or output type cannot be turned into a schema
+	assert.NotPanics(t, func() {
+		require.NoError(t, Register(s, &config.Config{OutputFormat: vikunja.OutputFormatJSON, DryRun: true}))
+	})
+}
+
+func TestRegister_AdvertisesEnums(t *testing.T) {
+	t.Parallel()
+	s := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.0"}, nil)
+	require.NoError(t, Register(s, &config.Config{OutputFormat: vikunja.OutputFormatJSON}))
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	_, err := s.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	client := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "0.0.0"}, nil)
+	session, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+
+	tools, err := session.ListTools(t.Context(), nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		tool     string
+		property string
+		want     []any
+	}{
+		{"list_views", "view_kind", []any{"list", "kanban", "gantt", "table"}},
+		{"create_view", "bucket_configuration_mode", []any{"none", "manual", "filter"}},
+		{"create_project_share", "right", []any{"read", "read_write", "admin"}},
+		{"discover_vikunja", "profile", []any{"full", "minimal"}},
+		{"clear_task_field", "clear_field", []any{"due_date", "assignees", "labels"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tool, func(t *testing.T) {
+			idx := slices.IndexFunc(tools.Tools, func(tool *mcp.Tool) bool { return tool.Name == tt.tool })
+			require.GreaterOrEqual(t, idx, 0, "tool %s is not registered", tt.tool)
+
+			schema, ok := tools.Tools[idx].InputSchema.(map[string]any)
+			require.True(t, ok)
+			property, ok := schema["properties"].(map[string]any)[tt.property].(map[string]any)
+			require.True(t, ok, "%s has no %s property", tt.tool, tt.property)
+			assert.Equal(t, tt.want, property["enum"])
+		})
+	}
+}
+
+func TestReadonlyAllow(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{Readonly: true, ReadonlyAllow: []string{"move_task_to_project", "add_task_comment"}}
+	h := newTestHandlers(t, cfg, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/tasks/5" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":5,"title":"Ship it","project_id":7}`)) //nolint:errcheck
+	})
+	h.deps.DryRun = true
+
+	_, output, err := h.moveTaskToProjectHandler(t.Context(), nil, MoveTaskToProjectInput{TaskID: "5", ProjectID: "9"})
+	require.NoError(t, err, "a listed tool is permitted")
+	assert.Len(t, output.Planned, 1)
+
+	result, _, err := h.setTaskProgressHandler(t.Context(), nil, SetTaskProgressInput{TaskID: "5", PercentDone: 0.5})
+	require.Error(t, err, "an unlisted tool is still blocked")
+	assert.Contains(t, err.Error(), "readonly mode")
+	assert.True(t, result.IsError)
+}
+
+func TestIsReadonlyFor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		want bool
+	}{
+		{"writable", &config.Config{ReadonlyAllow: []string{"create_task"}}, false},
+		{"readonly", &config.Config{Readonly: true}, true},
+		{"readonly, tool allowed", &config.Config{Readonly: true, ReadonlyAllow: []string{"create_task"}}, false},
+		{"readonly, other tool allowed", &config.Config{Readonly: true, ReadonlyAllow: []string{"create_view"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, tt.cfg, unexpectedRequest(t))
+			assert.Equal(t, tt.want, h.isReadonlyFor("create_task"))
+		})
+	}
+}
+
+func TestHandlers_FormattersDoNotInterfere(t *testing.T) {
+	t.Parallel()
+	server := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/tasks/5" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		fmt.Fprint(w, `{"id":5,"title":"Write report"}`) //nolint:errcheck
+	}
+	jsonHandlers := newTestHandlers(t, nil, server)
+	markdownHandlers := newTestHandlers(t, nil, server)
+	markdownHandlers.deps.OutputFormatter = vikunja.NewMarkdownFormatter()
+
+	texts := make([]string, 2)
+	var wg sync.WaitGroup
+	for i, h := range []*Handlers{jsonHandlers, markdownHandlers} {
+		wg.Go(func() {
+			result, _, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5"})
+			if assert.NoError(t, err) {
+				texts[i] = result.Content[0].(*mcp.TextContent).Text
+			}
+		})
+	}
+	wg.Wait()
+
+	assert.True(t, json.Valid([]byte(texts[0])), "the JSON handlers must keep formatting JSON: %s", texts[0])
+	assert.False(t, json.Valid([]byte(texts[1])), "the markdown handlers must keep formatting markdown: %s", texts[1])
+	assert.Contains(t, texts[1], "Write report")
+}
+
+// failingFormatter fails to format anything, standing in for a formatter bug
+type failingFormatter struct{}
+
+func (failingFormatter) Format(interface{}) (string, error) {
+	return "", errors.New("template exploded")
+}
+
+func TestHandlers_FormatFailureFallsBackToJSON(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":5,"title":"Write report"}`) //nolint:errcheck
+	})
+	h.deps.OutputFormatter = vikunja.WithJSONFallback(failingFormatter{})
+
+	result, _, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var fallback struct {
+		FormatError string        `json:"format_error"`
+		Result      GetTaskOutput `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &fallback))
+	assert.Equal(t, "template exploded", fallback.FormatError)
+	assert.Equal(t, int64(5), fallback.Result.Task.ID)
+	assert.Equal(t, "Write report", fallback.Result.Task.Title)
+}
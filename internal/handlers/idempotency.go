@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// idempotencyTTL is how long a create tool's result is replayed for a repeated idempotency key
+	idempotencyTTL = 10 * time.Minute
+	// maxIdempotencyEntries bounds the number of remembered results
+	maxIdempotencyEntries = 1024
+)
+
+// idempotentInput is implemented by tool inputs that accept an idempotency_key
+type idempotentInput interface {
+	idempotencyKey() string
+}
+
+func (i CreateTaskInput) idempotencyKey() string           { return i.IdempotencyKey }
+func (i CreateViewInput) idempotencyKey() string           { return i.IdempotencyKey }
+func (i CreateWebhookInput) idempotencyKey() string        { return i.IdempotencyKey }
+func (i CreateProjectShareInput) idempotencyKey() string   { return i.IdempotencyKey }
+func (i ImportTasksInput) idempotencyKey() string          { return i.IdempotencyKey }
+func (i CreateTasksInBucketsInput) idempotencyKey() string { return i.IdempotencyKey }
+
+// idempotencyEntry tracks one key: in flight until done is closed, then holding the result to replay
+type idempotencyEntry struct {
+	fingerprint string
+	done        chan struct{}
+	succeeded   bool
+	expires     time.Time
+	result      *mcp.CallToolResult
+	output      any
+}
+
+// idempotencyCache remembers the results of create tool calls by idempotency key. It lives in
+// memory only, so keys are honored on a best-effort basis within one server process.
+type idempotencyCache struct {
+	mu      sync.Mutex
+	entries map[string]*idempotencyEntry
+}
+
+func newIdempotencyCache() *idempotencyCache {
+	return &idempotencyCache{entries: make(map[string]*idempotencyEntry)}
+}
+
+// withIdempotency replays the earlier result when a call repeats an idempotency key, so a retried
+// create does not create a second object. A repeat that arrives while the first call is still
+// running waits for it. Failed calls are not remembered and dry runs are never cached.
+func withIdempotency[In, Out any](h *Handlers, name string, next mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		keyed, ok := any(input).(idempotentInput)
+		if !ok || keyed.idempotencyKey() == "" || h.deps.DryRun {
+			return next(ctx, req, input)
+		}
+		fingerprint, err := json.Marshal(input)
+		if err != nil {
+			return next(ctx, req, input)
+		}
+
+		key := name + "\x00" + keyed.idempotencyKey()
+		entry, owner, err := h.idempotency.claim(ctx, key, string(fingerprint), h.deps.Now())
+		if err != nil {
+			var zero Out
+			return h.buildErrorResult(err.Error()), zero, err
+		}
+		if !owner {
+			h.deps.Logger.Debug("replaying result for repeated idempotency key", "tool", name)
+			replay := *entry.result
+			return &replay, entry.output.(Out), nil
+		}
+
+		result, output, err := next(ctx, req, input)
+		succeeded := err == nil && result != nil && !result.IsError
+		h.idempotency.finish(key, entry, succeeded, result, output, h.deps.Now().Add(idempotencyTTL))
+		return result, output, err
+	}
+}
+
+// claim returns the entry for key and whether the caller owns it and must run the tool. A completed
+// entry is returned for replay; an in-flight one is waited for.
+func (c *idempotencyCache) claim(ctx context.Context, key, fingerprint string, now time.Time) (*idempotencyEntry, bool, error) {
+	for {
+		entry, owner := c.lookupOrReserve(key, fingerprint, now)
+		if owner {
+			return entry, true, nil
+		}
+		if entry.fingerprint != fingerprint {
+			return nil, false, ValidationError{Field: "idempotency_key", Message: "was already used with different arguments"}
+		}
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+		if entry.succeeded {
+			return entry, false, nil
+		}
+		// The earlier call failed and released the key, so try to claim it again
+	}
+}
+
+func (c *idempotencyCache) lookupOrReserve(key, fingerprint string, now time.Time) (*idempotencyEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[key]; ok && !(entry.succeeded && now.After(entry.expires)) {
+		return entry, false
+	}
+
+	c.makeRoom(now)
+	entry := &idempotencyEntry{fingerprint: fingerprint, done: make(chan struct{})}
+	c.entries[key] = entry
+	return entry, true
+}
+
+// makeRoom drops expired entries and, when the cache is still full, one completed entry.
+// Callers must hold c.mu.
+func (c *idempotencyCache) makeRoom(now time.Time) {
+	if len(c.entries) < maxIdempotencyEntries {
+		return
+	}
+	for k, entry := range c.entries {
+		if entry.succeeded && now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	for k, entry := range c.entries {
+		if len(c.entries) < maxIdempotencyEntries {
+			return
+		}
+		if entry.succeeded {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// finish records the outcome of an owned entry and releases anyone waiting on it
+func (c *idempotencyCache) finish(key string, entry *idempotencyEntry, succeeded bool, result *mcp.CallToolResult, output any, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	defer close(entry.done)
+
+	if !succeeded {
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		return
+	}
+	entry.succeeded = true
+	entry.result = result
+	entry.output = output
+	entry.expires = expires
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTaskServer creates a new task in project 7 for every PUT and counts the PUTs
+func createTaskServer(t *testing.T, creates *atomic.Int64) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/projects/7/tasks" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		id := 100 + creates.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":%d,"title":"Write report","project_id":7}`, id) //nolint:errcheck
+	}
+}
+
+func TestWithIdempotency_RepeatedKeyReturnsOriginalResult(t *testing.T) {
+	t.Parallel()
+	var creates atomic.Int64
+	h := newTestHandlers(t, nil, createTaskServer(t, &creates))
+	createTask := withIdempotency(h, "create_task", h.createTaskHandler)
+	input := CreateTaskInput{Title: "Write report", ProjectID: "7", IdempotencyKey: "report-1"}
+
+	first, firstOutput, err := createTask(t.Context(), nil, input)
+	require.NoError(t, err)
+	second, secondOutput, err := createTask(t.Context(), nil, input)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), creates.Load(), "the retry must not issue a second create")
+	assert.Equal(t, int64(101), secondOutput.Task.ID)
+	assert.Equal(t, firstOutput, secondOutput)
+	assert.Equal(t, first.Content, second.Content)
+}
+
+func TestWithIdempotency_DistinctKeys(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		keys        [2]string
+		wantCreates int64
+	}{
+		{"without keys", [2]string{"", ""}, 2},
+		{"different keys", [2]string{"report-1", "report-2"}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var creates atomic.Int64
+			h := newTestHandlers(t, nil, createTaskServer(t, &creates))
+			createTask := withIdempotency(h, "create_task", h.createTaskHandler)
+
+			for _, key := range tt.keys {
+				_, _, err := createTask(t.Context(), nil, CreateTaskInput{Title: "Write report", ProjectID: "7", IdempotencyKey: key})
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCreates, creates.Load())
+		})
+	}
+}
+
+func TestWithIdempotency_KeyReusedWithDifferentArguments(t *testing.T) {
+	t.Parallel()
+	var creates atomic.Int64
+	h := newTestHandlers(t, nil, createTaskServer(t, &creates))
+	createTask := withIdempotency(h, "create_task", h.createTaskHandler)
+
+	_, _, err := createTask(t.Context(), nil, CreateTaskInput{Title: "Write report", ProjectID: "7", IdempotencyKey: "report-1"})
+	require.NoError(t, err)
+	result, _, err := createTask(t.Context(), nil, CreateTaskInput{Title: "Other task", ProjectID: "7", IdempotencyKey: "report-1"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "idempotency_key: was already used with different arguments")
+	assert.True(t, result.IsError)
+	assert.Equal(t, int64(1), creates.Load())
+}
+
+func TestWithIdempotency_ExpiredKeyCreatesAgain(t *testing.T) {
+	t.Parallel()
+	var creates atomic.Int64
+	h := newTestHandlers(t, nil, createTaskServer(t, &creates))
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	h.deps.Now = func() time.Time { return now }
+	createTask := withIdempotency(h, "create_task", h.createTaskHandler)
+	input := CreateTaskInput{Title: "Write report", ProjectID: "7", IdempotencyKey: "report-1"}
+
+	_, _, err := createTask(t.Context(), nil, input)
+	require.NoError(t, err)
+	now = now.Add(idempotencyTTL + time.Second)
+	_, output, err := createTask(t.Context(), nil, input)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), creates.Load())
+	assert.Equal(t, int64(102), output.Task.ID)
+}
+
+func TestWithIdempotency_FailedCallIsNotRemembered(t *testing.T) {
+	t.Parallel()
+	var attempts atomic.Int64
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		if attempts.Add(1) == 1 {
+			http.Error(w, `{"message":"database unavailable"}`, http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":101,"title":"Write report","project_id":7}`) //nolint:errcheck
+	})
+	createTask := withIdempotency(h, "create_task", h.createTaskHandler)
+	input := CreateTaskInput{Title: "Write report", ProjectID: "7", IdempotencyKey: "report-1"}
+
+	_, _, err := createTask(t.Context(), nil, input)
+	require.Error(t, err)
+	_, output, err := createTask(t.Context(), nil, input)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), attempts.Load())
+	assert.Equal(t, int64(101), output.Task.ID)
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// importProjectHandler handles the import_project tool. Failures after the project is created
+// are reported in the output rather than failing the call, so the caller sees what was recreated.
+func (h *Handlers) importProjectHandler(ctx context.Context, _ *mcp.CallToolRequest, input ImportProjectInput) (*mcp.CallToolResult, ImportProjectOutput, error) {
+	if h.isReadonlyFor("import_project") {
+		return h.buildErrorResult("Operation not available in readonly mode"), ImportProjectOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	export, err := parseProjectExport(input.Snapshot)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ImportProjectOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ImportProjectOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	imported, err := client.ImportProject(ctx, export)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ImportProjectOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, ImportProjectOutput{Planned: planned}, err
+	}
+	h.projects.invalidate()
+
+	output := ImportProjectOutput{
+		ProjectID: imported.ProjectID,
+		Views:     idMapOutput(imported.Views),
+		Buckets:   idMapOutput(imported.Buckets),
+		Tasks:     idMapOutput(imported.Tasks),
+		Failures:  imported.Failures,
+	}
+	result, err := h.formatResult(output)
+	if err != nil {
+		return nil, ImportProjectOutput{}, err
+	}
+	return result, output, nil
+}
+
+// idMapOutput keys an old-to-new ID mapping by strings, as JSON objects are
+func idMapOutput(ids map[int64]int64) map[string]int64 {
+	out := make(map[string]int64, len(ids))
+	for oldID, newID := range ids {
+		out[strconv.FormatInt(oldID, 10)] = newID
+	}
+	return out
+}
+
+func parseProjectExport(snapshot string) (*vikunja.ProjectExport, error) {
+	if err := validateRequiredString("snapshot", snapshot); err != nil {
+		return nil, err
+	}
+	var export vikunja.ProjectExport
+	if err := json.Unmarshal([]byte(snapshot), &export); err != nil {
+		return nil, ValidationError{Field: "snapshot", Message: fmt.Sprintf("must be a project export: %v", err)}
+	}
+	if export.Project == nil {
+		return nil, ValidationError{Field: "snapshot", Message: "must be a project export: no project found"}
+	}
+	if err := validateExportEntries(&export); err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// validateExportEntries rejects empty view, bucket and task entries, which ImportProject could
+// only fail on after creating the project
+func validateExportEntries(export *vikunja.ProjectExport) error {
+	var errs ValidationErrors
+	for i, ve := range export.Views {
+		if ve == nil || ve.View == nil {
+			errs.add(ValidationError{Field: fmt.Sprintf("snapshot.views[%d]", i), Message: "must hold a view"})
+			continue
+		}
+		for j, be := range ve.Buckets {
+			if be == nil || be.Bucket == nil {
+				errs.add(ValidationError{Field: fmt.Sprintf("snapshot.views[%d].buckets[%d]", i, j), Message: "must hold a bucket"})
+			}
+		}
+	}
+	for i, t := range export.Tasks {
+		if t == nil {
+			errs.add(ValidationError{Field: fmt.Sprintf("snapshot.tasks[%d]", i), Message: "must hold a task"})
+		}
+	}
+	return errs.err()
+}
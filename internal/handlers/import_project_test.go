@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const importSnapshot = `{
+  "version": 1,
+  "project": {"id": 7, "title": "Launch", "hex_color": "ff0000"},
+  "views": [
+    {"view": {"id": 1, "title": "List", "view_kind": "list"}},
+    {"view": {"id": 2, "title": "Board", "view_kind": "kanban", "bucket_configuration_mode": "manual", "done_bucket_id": 11},
+     "buckets": [
+       {"bucket": {"id": 10, "title": "To-Do"}, "task_ids": [100, 102]},
+       {"bucket": {"id": 11, "title": "Done"}, "task_ids": [101]}
+     ]}
+  ],
+  "tasks": [
+    {"id": 100, "title": "Plan"},
+    {"id": 101, "title": "Ship", "done": true},
+    {"id": 102, "title": "Broken"}
+  ]
+}`
+
+func TestImportProjectHandler_CreatesInDependencyOrder(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var writes []string
+	nextTaskID := 200
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		call := r.Method + " " + r.URL.Path
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != http.MethodGet {
+			writes = append(writes, call)
+		}
+		var body map[string]any
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+		}
+		switch call {
+		case "PUT /api/v1/projects":
+			assert.Equal(t, "Launch", body["title"])
+			assert.Nil(t, body["id"])
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":70,"title":"Launch"}`) //nolint:errcheck
+		case "GET /api/v1/projects/70/views":
+			fmt.Fprint(w, `[{"id":71,"title":"List","view_kind":"list","project_id":70}]`) //nolint:errcheck
+		case "PUT /api/v1/projects/70/views":
+			assert.Equal(t, "Board", body["title"])
+			fmt.Fprint(w, `{"id":72,"title":"Board","view_kind":"kanban","project_id":70}`) //nolint:errcheck
+		case "GET /api/v1/projects/70/views/72/buckets":
+			fmt.Fprint(w, `[{"id":80,"title":"To-Do"}]`) //nolint:errcheck
+		case "PUT /api/v1/projects/70/views/72/buckets":
+			assert.Equal(t, "Done", body["title"])
+			fmt.Fprint(w, `{"id":81,"title":"Done"}`) //nolint:errcheck
+		case "PUT /api/v1/projects/70/tasks":
+			if body["title"] == "Broken" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id":%d,"title":%q,"project_id":70}`, nextTaskID, body["title"]) //nolint:errcheck
+			nextTaskID++
+		case "POST /api/v1/projects/70/views/72/buckets/80/tasks", "POST /api/v1/projects/70/views/72/buckets/81/tasks":
+			fmt.Fprintf(w, `{"task_id":%v}`, body["task_id"]) //nolint:errcheck
+		case "POST /api/v1/projects/70/views/72":
+			assert.InDelta(t, 81, body["done_bucket_id"], 0)
+			fmt.Fprint(w, `{"id":72,"title":"Board","view_kind":"kanban"}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s", call)
+		}
+	})
+
+	h.projects.store([]*vikunja.Project{{ID: 7, Title: "Launch"}})
+
+	_, output, err := h.importProjectHandler(t.Context(), nil, ImportProjectInput{Snapshot: importSnapshot})
+	require.NoError(t, err)
+	_, cached := h.projects.list()
+	assert.False(t, cached, "the project cache is invalidated so the imported project can be found")
+
+	assert.Equal(t, []string{
+		"PUT /api/v1/projects",
+		"PUT /api/v1/projects/70/views",
+		"PUT /api/v1/projects/70/views/72/buckets",
+		"PUT /api/v1/projects/70/tasks",
+		"PUT /api/v1/projects/70/tasks",
+		"PUT /api/v1/projects/70/tasks",
+		"POST /api/v1/projects/70/views/72/buckets/80/tasks",
+		"POST /api/v1/projects/70/views/72/buckets/81/tasks",
+		"POST /api/v1/projects/70/views/72",
+	}, writes)
+
+	assert.Equal(t, int64(70), output.ProjectID)
+	assert.Equal(t, map[string]int64{"1": 71, "2": 72}, output.Views)
+	assert.Equal(t, map[string]int64{"10": 80, "11": 81}, output.Buckets)
+	assert.Equal(t, map[string]int64{"100": 200, "101": 201}, output.Tasks)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, vikunja.ImportStepTask, output.Failures[0].Step)
+	assert.Equal(t, int64(102), output.Failures[0].OldID)
+}
+
+func TestImportProjectHandler_RejectsCall(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		cfg   *config.Config
+		input ImportProjectInput
+		want  string
+	}{
+		{name: "readonly", cfg: &config.Config{Readonly: true}, input: ImportProjectInput{Snapshot: importSnapshot}, want: "readonly"},
+		{name: "not json", input: ImportProjectInput{Snapshot: "nope"}, want: "snapshot"},
+		{name: "no project", input: ImportProjectInput{Snapshot: `{"version":1}`}, want: "no project"},
+		{name: "other version", input: ImportProjectInput{Snapshot: `{"version":2,"project":{"title":"x"}}`}, want: "unsupported project export version"},
+		{name: "null view", input: ImportProjectInput{Snapshot: `{"version":1,"project":{"title":"x"},"views":[null]}`}, want: "snapshot.views[0]: must hold a view"},
+		{name: "empty view", input: ImportProjectInput{Snapshot: `{"version":1,"project":{"title":"x"},"views":[{}]}`}, want: "snapshot.views[0]: must hold a view"},
+		{name: "null bucket", input: ImportProjectInput{Snapshot: `{"version":1,"project":{"title":"x"},"views":[{"view":{"id":1},"buckets":[{"bucket":{"id":2}},null]}]}`}, want: "snapshot.views[0].buckets[1]: must hold a bucket"},
+		{name: "null task", input: ImportProjectInput{Snapshot: `{"version":1,"project":{"title":"x"},"tasks":[{"id":1},null]}`}, want: "snapshot.tasks[1]: must hold a task"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, tt.cfg, unexpectedRequest(t))
+
+			result, _, err := h.importProjectHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+			assert.True(t, result.IsError)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxImportTasks bounds how many tasks one import_tasks call may create
+const maxImportTasks = 100
+
+// importTasksHandler handles the import_tasks tool. Items are created one after another; an item
+// that is invalid or fails to create is reported and the rest are still created.
+func (h *Handlers) importTasksHandler(ctx context.Context, _ *mcp.CallToolRequest, input ImportTasksInput) (*mcp.CallToolResult, ImportTasksOutput, error) {
+	if h.isReadonlyFor("import_tasks") {
+		return h.buildErrorResult("Operation not available in readonly mode"), ImportTasksOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	if err := validateImportTasksInput(input); err != nil {
+		return h.buildErrorResult(err.Error()), ImportTasksOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ImportTasksOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	project, err := resolution.ResolveProject(ctx, client, input.ProjectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ImportTasksOutput{}, err
+	}
+
+	output := ImportTasksOutput{ProjectID: project.ID, Created: []ImportedTask{}}
+	for i, item := range input.Tasks {
+		task, err := importTask(ctx, client, project.ID, item)
+		if err != nil {
+			output.Errors = append(output.Errors, ImportTaskError{Index: i, Title: item.Title, Error: err.Error()})
+			continue
+		}
+		output.Created = append(output.Created, ImportedTask{Index: i, ID: task.ID, Title: task.Title, URI: vikunja.TaskURI(task.ID)})
+	}
+	h.taskCounts.invalidate(project.ID)
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, ImportTasksOutput{ProjectID: project.ID, Errors: output.Errors, Planned: planned}, err
+	}
+
+	result, err := h.formatResult(output)
+	if err != nil {
+		return nil, ImportTasksOutput{}, err
+	}
+	return result, output, nil
+}
+
+// validateImportTasksInput checks the call as a whole; items are validated one by one as they are imported
+func validateImportTasksInput(input ImportTasksInput) error {
+	if err := validateRequiredString("project_id", input.ProjectID); err != nil {
+		return err
+	}
+	switch {
+	case len(input.Tasks) == 0:
+		return ValidationError{Field: "tasks", Message: "at least one task is required"}
+	case len(input.Tasks) > maxImportTasks:
+		return ValidationError{Field: "tasks", Message: fmt.Sprintf("at most %d tasks can be imported at once, got: %d", maxImportTasks, len(input.Tasks))}
+	}
+	return nil
+}
+
+// importTask validates and creates one import_tasks item
+func importTask(ctx context.Context, client *vikunja.Client, projectID int64, item ImportTaskItem) (*vikunja.Task, error) {
+	if err := validateRequiredString("title", item.Title); err != nil {
+		return nil, err
+	}
+	dueDate, err := parseTaskDate("due_date", item.DueDate)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateTask(ctx, item.Title, projectID, item.Description, nil, dueDate)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportTasksHandler_ReportsInvalidItems(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var created []map[string]any
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7":
+			fmt.Fprint(w, `{"id":7,"title":"Inbox"}`) //nolint:errcheck
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/projects/7/tasks":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			mu.Lock()
+			created = append(created, body)
+			id := 100 + len(created)
+			mu.Unlock()
+			body["id"] = id
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(body) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.importTasksHandler(t.Context(), nil, ImportTasksInput{
+		ProjectID: "7",
+		Tasks: []ImportTaskItem{
+			{Title: "Write report", Description: "Quarterly numbers", DueDate: "2026-11-01"},
+			{Title: "Book venue", DueDate: "next blursday"},
+			{Title: "Send invites"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(7), output.ProjectID)
+	require.Len(t, output.Created, 2)
+	assert.Equal(t, ImportedTask{Index: 0, ID: 101, Title: "Write report", URI: "vikunja://task/101"}, output.Created[0])
+	assert.Equal(t, ImportedTask{Index: 2, ID: 102, Title: "Send invites", URI: "vikunja://task/102"}, output.Created[1])
+
+	require.Len(t, output.Errors, 1)
+	assert.Equal(t, 1, output.Errors[0].Index)
+	assert.Equal(t, "Book venue", output.Errors[0].Title)
+	assert.Contains(t, output.Errors[0].Error, "due_date")
+
+	require.Len(t, created, 2)
+	assert.Equal(t, "Quarterly numbers", created[0]["description"])
+	assert.True(t, strings.HasPrefix(created[0]["due_date"].(string), "2026-11-01"))
+}
+
+func TestImportTasksHandler_RejectsBatch(t *testing.T) {
+	t.Parallel()
+	tooMany := make([]ImportTaskItem, maxImportTasks+1)
+	for i := range tooMany {
+		tooMany[i] = ImportTaskItem{Title: fmt.Sprintf("Task %d", i)}
+	}
+
+	tests := []struct {
+		name  string
+		cfg   *config.Config
+		input ImportTasksInput
+		want  string
+	}{
+		{name: "readonly", cfg: &config.Config{Readonly: true}, input: ImportTasksInput{ProjectID: "7", Tasks: tooMany[:1]}, want: "readonly"},
+		{name: "no tasks", input: ImportTasksInput{ProjectID: "7"}, want: "tasks"},
+		{name: "too many tasks", input: ImportTasksInput{ProjectID: "7", Tasks: tooMany}, want: fmt.Sprintf("at most %d", maxImportTasks)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, tt.cfg, unexpectedRequest(t))
+
+			result, _, err := h.importTasksHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+			assert.True(t, result.IsError)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// listAllTasksHandler handles the list_all_tasks tool
+func (h *Handlers) listAllTasksHandler(ctx context.Context, _ *mcp.CallToolRequest, input ListAllTasksInput) (*mcp.CallToolResult, ListAllTasksOutput, error) {
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ListAllTasksOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	page, err := fetchAllTasks(ctx, client, input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ListAllTasksOutput{}, err
+	}
+
+	output := ListAllTasksOutput{Tasks: make([]Task, len(page.Tasks)), Page: page.Page, NextPage: page.NextPage()}
+	for i, t := range page.Tasks {
+		output.Tasks[i] = toTask(t)
+	}
+
+	data, err := h.deps.OutputFormatter.Format(page.Tasks)
+	if err != nil {
+		return nil, ListAllTasksOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// fetchAllTasks runs one page of the list_all_tasks query, starting from the saved filter's query
+// when one is given
+func fetchAllTasks(ctx context.Context, client *vikunja.Client, input ListAllTasksInput) (*vikunja.TaskPage, error) {
+	if input.Page < 0 {
+		return nil, ValidationError{Field: "page", Message: fmt.Sprintf("must be at least 1, got: %d", input.Page)}
+	}
+	page := int64(max(input.Page, 1))
+
+	if input.FilterID == "" {
+		return client.GetTasksPage(ctx, buildAllTasksFilter(input, true), page)
+	}
+
+	filterID, err := parseSavedFilterID("filter_id", input.FilterID)
+	if err != nil {
+		return nil, err
+	}
+	// Saved filters carry their own done criteria, so only an explicit done state is added
+	_, query, err := savedFilterQuery(ctx, client, filterID, buildAllTasksFilter(input, false))
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTasksPage(ctx, query, page)
+}
+
+// buildAllTasksFilter combines the done state with the caller's filter. The done state defaults
+// to incomplete tasks when defaultDone is set and is otherwise only included when given.
+func buildAllTasksFilter(input ListAllTasksInput, defaultDone bool) string {
+	var parts []string
+	switch {
+	case input.Done != nil:
+		parts = append(parts, fmt.Sprintf("done = %t", *input.Done))
+	case defaultDone:
+		parts = append(parts, "done = false")
+	}
+
+	if custom := strings.TrimSpace(input.Filter); custom != "" {
+		parts = append(parts, "("+custom+")")
+	}
+	return strings.Join(parts, " && ")
+}
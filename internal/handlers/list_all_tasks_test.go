@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAllTasksHandler_Filter(t *testing.T) {
+	t.Parallel()
+	done := true
+	tests := []struct {
+		name       string
+		input      ListAllTasksInput
+		wantFilter string
+	}{
+		{"defaults to incomplete", ListAllTasksInput{}, "done = false"},
+		{"done tasks", ListAllTasksInput{Done: &done}, "done = true"},
+		{"custom filter", ListAllTasksInput{Filter: "priority >= 3"}, "done = false && (priority >= 3)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/tasks", r.URL.Path)
+				assert.Equal(t, tt.wantFilter, r.URL.Query().Get("filter"))
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `[{"id":1,"title":"One","project_id":2},{"id":3,"title":"Three","project_id":4}]`) //nolint:errcheck
+			})
+
+			_, output, err := h.listAllTasksHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+			require.Len(t, output.Tasks, 2)
+			assert.Equal(t, int64(4), output.Tasks[1].ProjectID)
+		})
+	}
+}
+
+func TestListAllTasksHandler_Pages(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-pagination-total-pages", "2")
+		fmt.Fprintf(w, `[{"id":%s,"title":"Task","project_id":2}]`, r.URL.Query().Get("page")) //nolint:errcheck
+	})
+
+	_, first, err := h.listAllTasksHandler(t.Context(), nil, ListAllTasksInput{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first.Page)
+	assert.Equal(t, int64(2), first.NextPage)
+
+	_, second, err := h.listAllTasksHandler(t.Context(), nil, ListAllTasksInput{Page: int(first.NextPage)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), second.Page)
+	assert.Zero(t, second.NextPage)
+	require.Len(t, second.Tasks, 1)
+	assert.Equal(t, int64(2), second.Tasks[0].ID)
+}
+
+func TestListAllTasksHandler_InvalidPage(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.listAllTasksHandler(t.Context(), nil, ListAllTasksInput{Page: -1})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "page")
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// taskSummaryFields lists the task fields list_tasks can be asked to include
+func taskSummaryFields() []string {
+	return []string{"id", "title", "uri", "description", "done", "due_date", "start_date", "end_date", "priority", "percent_done"}
+}
+
+// parseTaskFields parses a comma separated list_tasks field selection. An empty selection
+// returns nil, which keeps the default fields.
+func parseTaskFields(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	known := taskSummaryFields()
+	var fields []string
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !slices.Contains(known, field) {
+			return nil, ValidationError{Field: "fields", Message: fmt.Sprintf("unknown field %q, expected any of: %s", field, strings.Join(known, ", "))}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// toSelectedTasksSummary summarizes tasks with only the given fields set; nil fields keeps the default summary
+func toSelectedTasksSummary(tasks []*vikunja.Task, fields []string) []TaskSummary {
+	if fields == nil {
+		return toTasksSummary(tasks)
+	}
+	if tasks == nil {
+		return nil
+	}
+	res := make([]TaskSummary, len(tasks))
+	for i, t := range tasks {
+		for _, field := range fields {
+			setTaskSummaryField(&res[i], t, field)
+		}
+	}
+	return res
+}
+
+func setTaskSummaryField(summary *TaskSummary, t *vikunja.Task, field string) {
+	switch field {
+	case "id":
+		summary.ID = t.ID
+	case "title":
+		summary.Title = t.Title
+	case "uri":
+		summary.URI = vikunja.TaskURI(t.ID)
+	case "description":
+		summary.Description = t.Description
+	case "done":
+		summary.Done = &t.Done
+	case "priority":
+		summary.Priority = &t.Priority
+	case "percent_done":
+		summary.PercentDone = &t.PercentDone
+	default:
+		setTaskSummaryDate(summary, t, field)
+	}
+}
+
+// setTaskSummaryDate sets one of the date fields, leaving dates Vikunja reports as unset empty
+func setTaskSummaryDate(summary *TaskSummary, t *vikunja.Task, field string) {
+	dates := map[string]struct {
+		from string
+		to   *string
+	}{
+		"due_date":   {t.DueDate, &summary.DueDate},
+		"start_date": {t.StartDate, &summary.StartDate},
+		"end_date":   {t.EndDate, &summary.EndDate},
+	}
+	date, ok := dates[field]
+	if !ok || storedTaskDate(date.from).IsZero() {
+		return
+	}
+	*date.to = date.from
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/internal/metrics"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// addTool registers a tool handler wrapped with the behavior shared by every tool, unless the
+// configuration leaves the tool out
+func addTool[In, Out any](s *mcp.Server, h *Handlers, tool *mcp.Tool, handler mcp.ToolHandlerFor[In, Out]) {
+	if !h.tools.allows(tool.Name) {
+		return
+	}
+	if tool.InputSchema == nil {
+		if schema := inputSchema[In](); schema != nil {
+			tool.InputSchema = schema
+		}
+	}
+	mcp.AddTool(s, tool, withMetrics(h, tool.Name, withTrace(h, tool.Name, withOutputLimit(h, withToolTimeout(h, tool.Name, withRetryBudget(h, withIdempotency(h, tool.Name, withAPIErrorHints(h, handler))))))))
+}
+
+// withMetrics counts the tool's calls by outcome when metrics are enabled
+func withMetrics[In, Out any](h *Handlers, name string, next mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	if h.deps.Metrics == nil {
+		return next
+	}
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		result, output, err := next(ctx, req, input)
+		outcome := metrics.OutcomeSuccess
+		if err != nil || (result != nil && result.IsError) {
+			outcome = metrics.OutcomeError
+		}
+		h.deps.Metrics.ToolCall(name, outcome)
+		return result, output, err
+	}
+}
+
+// withTrace gives the tool call a trace ID, carried by the context into every Vikunja request
+// the call makes, and logs the call with it so the two can be correlated
+func withTrace[In, Out any](h *Handlers, name string, next mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		traceID := requestTraceID(req)
+		ctx = vikunja.WithTraceID(ctx, traceID)
+		logger := h.deps.Logger.With(slog.String("tool", name), slog.String("trace_id", traceID))
+		if req != nil && req.Session != nil {
+			logger = logger.With(slog.String("session_id", req.Session.ID()))
+		}
+
+		start := time.Now()
+		logger.DebugContext(ctx, "tool call started")
+		result, output, err := next(ctx, req, input)
+		if err != nil {
+			logger.DebugContext(ctx, "tool call failed", slog.Duration("duration", time.Since(start)), slog.Any("error", err))
+		} else {
+			logger.DebugContext(ctx, "tool call finished", slog.Duration("duration", time.Since(start)))
+		}
+		return result, output, err
+	}
+}
+
+// requestTraceID returns the trace ID the client sent with req, either as "trace_id" or a W3C
+// "traceparent" in the request's _meta or as a traceparent HTTP header, or a new random one
+func requestTraceID(req *mcp.CallToolRequest) string {
+	if req != nil && req.Params != nil {
+		if id := metaTraceID(req.Params.Meta); id != "" {
+			return id
+		}
+	}
+	if req != nil && req.Extra != nil {
+		if id := traceparentID(req.Extra.Header.Get("Traceparent")); id != "" {
+			return id
+		}
+	}
+	return newTraceID()
+}
+
+// metaTraceID returns the trace ID in a request's _meta, or "" when it carries none
+func metaTraceID(meta mcp.Meta) string {
+	if id, ok := meta["trace_id"].(string); ok && id != "" {
+		return id
+	}
+	parent, _ := meta["traceparent"].(string)
+	return traceparentID(parent)
+}
+
+// traceparentID extracts the trace ID from a W3C traceparent value such as
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+func traceparentID(traceparent string) string {
+	parts := strings.Split(traceparent, "-")
+	if len(parts) != 4 || len(parts[1]) != 32 {
+		return ""
+	}
+	return parts[1]
+}
+
+// newTraceID returns a random 16 byte trace ID in hex, the size W3C trace context uses
+func newTraceID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// withToolTimeout bounds the whole tool call, including every Vikunja request it makes,
+// by the configured tool timeout
+func withToolTimeout[In, Out any](h *Handlers, name string, next mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		timeout := h.toolTimeout()
+		if timeout <= 0 {
+			return next(ctx, req, input)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		result, output, err := next(ctx, req, input)
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("%s timed out after %s: %w", name, timeout, err)
+			var zero Out
+			return h.buildErrorResult(err.Error()), zero, err
+		}
+		return result, output, err
+	}
+}
+
+// toolTimeout returns the configured bound on a single tool call
+func (h *Handlers) toolTimeout() time.Duration {
+	if h.deps.Config != nil {
+		return h.deps.Config.ToolTimeout
+	}
+	return 0
+}
+
+// withRetryBudget gives the tool call the configured number of retries, shared by every Vikunja
+// request the call makes rather than granted to each request
+func withRetryBudget[In, Out any](h *Handlers, next mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		budget := 0
+		if h.deps.Config != nil {
+			budget = h.deps.Config.RetryBudget
+		}
+		if budget <= 0 {
+			return next(ctx, req, input)
+		}
+		return next(vikunja.WithRetryBudget(ctx, vikunja.NewRetryBudget(budget)), req, input)
+	}
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/internal/metrics"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithToolTimeout_SlowServer(t *testing.T) {
+	t.Parallel()
+	slow := func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+	}
+	h := newTestHandlers(t, &config.Config{ToolTimeout: 100 * time.Millisecond}, slow)
+	handler := withToolTimeout(h, "get_task", h.getTaskHandler)
+
+	start := time.Now()
+	result, _, err := handler(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	elapsed := time.Since(start)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "get_task timed out after 100ms")
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+	assert.Less(t, elapsed, 2*time.Second)
+}
+
+func TestWithToolTimeout_Disabled(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{}, taskDetailsServer(t))
+	handler := withToolTimeout(h, "get_task", h.getTaskHandler)
+
+	_, output, err := handler(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), output.Task.ID)
+}
+
+func TestWithTrace_CorrelatesHandlerAndClientLogs(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(taskDetailsServer(t))
+	t.Cleanup(ts.Close)
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	opts := vikunja.DefaultClientOptions()
+	opts.Logger = logger
+	client, err := vikunja.NewClientWithOptions(ts.URL, "test-token", true, opts)
+	require.NoError(t, err)
+	h := NewHandlers(&HandlerDependencies{Client: client, OutputFormatter: vikunja.NewJSONFormatter(), Config: &config.Config{}, Logger: logger})
+
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Meta: mcp.Meta{"trace_id": "trace-123"}}}
+	_, _, err = withTrace(h, "get_task", h.getTaskHandler)(t.Context(), req, GetTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+
+	var handlerLine, clientLine string
+	for line := range strings.Lines(logs.String()) {
+		switch {
+		case strings.Contains(line, `msg="tool call started"`):
+			handlerLine = line
+		case strings.Contains(line, `msg="vikunja request"`) && clientLine == "":
+			clientLine = line
+		}
+	}
+	assert.Contains(t, handlerLine, "trace_id=trace-123")
+	assert.Contains(t, handlerLine, "tool=get_task")
+	assert.Contains(t, clientLine, "trace_id=trace-123")
+	assert.Contains(t, clientLine, "path=/api/v1/tasks/5")
+}
+
+func TestRequestTraceID(t *testing.T) {
+	t.Parallel()
+	const parent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	tests := []struct {
+		name string
+		req  *mcp.CallToolRequest
+		want string
+	}{
+		{"meta trace id", &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Meta: mcp.Meta{"trace_id": "abc"}}}, "abc"},
+		{"meta traceparent", &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Meta: mcp.Meta{"traceparent": parent}}}, "4bf92f3577b34da6a3ce929d0e0e4736"},
+		{"header traceparent", &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{}, Extra: &mcp.RequestExtra{Header: http.Header{"Traceparent": {parent}}}}, "4bf92f3577b34da6a3ce929d0e0e4736"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, requestTraceID(tt.req))
+		})
+	}
+
+	generated := requestTraceID(nil)
+	assert.Len(t, generated, 32)
+	assert.NotEqual(t, generated, requestTraceID(&mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Meta: mcp.Meta{"traceparent": "garbage"}}}))
+}
+
+func TestWithMetrics_CountsToolCallsAndRequests(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(taskDetailsServer(t))
+	t.Cleanup(ts.Close)
+
+	registry := metrics.New()
+	opts := vikunja.DefaultClientOptions()
+	opts.Logger = slog.New(slog.DiscardHandler)
+	opts.Observer = registry
+	client, err := vikunja.NewClientWithOptions(ts.URL, "test-token", true, opts)
+	require.NoError(t, err)
+	h := NewHandlers(&HandlerDependencies{Client: client, OutputFormatter: vikunja.NewJSONFormatter(), Config: &config.Config{}, Metrics: registry})
+	handler := withMetrics(h, "get_task", h.getTaskHandler)
+
+	_, _, err = handler(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+	_, _, err = handler(t.Context(), nil, GetTaskInput{TaskID: "nope"})
+	require.Error(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, registry.Write(&out))
+	assert.Contains(t, out.String(), `mcp_vikunja_tool_calls_total{outcome="success",tool="get_task"} 1`)
+	assert.Contains(t, out.String(), `mcp_vikunja_tool_calls_total{outcome="error",tool="get_task"} 1`)
+	assert.Contains(t, out.String(), `mcp_vikunja_request_duration_seconds_count{method="GET",status="200"}`)
+}
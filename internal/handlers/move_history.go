@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxRecordedMoves bounds how many bucket moves undo_last_move can reverse
+const maxRecordedMoves = 20
+
+// recordedMove is a bucket move undo_last_move can reverse: the task and the bucket it left
+type recordedMove struct {
+	taskID       int64
+	projectID    int64
+	viewID       int64
+	fromBucketID int64
+	toBucketID   int64
+}
+
+// moveHistory remembers the most recent move_task_to_bucket calls of this process, so a move
+// made by mistake can be undone. It is best-effort: moves made elsewhere are not seen, and moves
+// whose original bucket the server did not report are not recorded.
+type moveHistory struct {
+	mu    sync.Mutex
+	moves []recordedMove
+}
+
+func newMoveHistory() *moveHistory {
+	return &moveHistory{}
+}
+
+// record remembers that task left the bucket it held in viewID for toBucketID. Nothing is
+// recorded when the task's bucket in that view is unknown or the move kept it in place.
+func (m *moveHistory) record(task *vikunja.Task, viewID, toBucketID int64) {
+	from := taskBucketInView(task.Buckets, viewID)
+	if from == 0 || from == toBucketID {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.moves = append(m.moves, recordedMove{
+		taskID:       task.ID,
+		projectID:    task.ProjectID,
+		viewID:       viewID,
+		fromBucketID: from,
+		toBucketID:   toBucketID,
+	})
+	if len(m.moves) > maxRecordedMoves {
+		m.moves = m.moves[len(m.moves)-maxRecordedMoves:]
+	}
+}
+
+// last returns the most recent recorded move, and false when there is none
+func (m *moveHistory) last() (recordedMove, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.moves) == 0 {
+		return recordedMove{}, false
+	}
+	return m.moves[len(m.moves)-1], true
+}
+
+// forget drops move once it has been undone, unless newer moves were recorded meanwhile
+func (m *moveHistory) forget(move recordedMove) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n := len(m.moves); n > 0 && m.moves[n-1] == move {
+		m.moves = m.moves[:n-1]
+	}
+}
+
+// taskBucketInView returns the ID of the bucket a task holds in viewID, or 0 when unknown
+func taskBucketInView(buckets []*vikunja.Bucket, viewID int64) int64 {
+	for _, b := range buckets {
+		if b != nil && b.ProjectViewID == viewID {
+			return b.ID
+		}
+	}
+	return 0
+}
+
+// undoLastMoveHandler handles the undo_last_move tool
+func (h *Handlers) undoLastMoveHandler(ctx context.Context, _ *mcp.CallToolRequest, _ UndoLastMoveInput) (*mcp.CallToolResult, UndoLastMoveOutput, error) {
+	if h.isReadonlyFor("undo_last_move") {
+		return h.buildErrorResult("Operation not available in readonly mode"), UndoLastMoveOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	move, ok := h.moves.last()
+	if !ok {
+		err := fmt.Errorf("no bucket move to undo: move_task_to_bucket has not moved a task since the server started")
+		return h.buildErrorResult(err.Error()), UndoLastMoveOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, UndoLastMoveOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	taskBucket, err := client.MoveTaskToBucket(ctx, move.projectID, move.viewID, move.fromBucketID, move.taskID)
+	if err != nil {
+		return h.buildErrorResult(fmt.Sprintf("Failed to undo move: %v", err)), UndoLastMoveOutput{}, fmt.Errorf("failed to undo move: %w", err)
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, UndoLastMoveOutput{Planned: planned}, err
+	}
+	h.moves.forget(move)
+
+	return h.formatUndoLastMoveOutput(taskBucket, move)
+}
+
+func (h *Handlers) formatUndoLastMoveOutput(taskBucket *vikunja.TaskBucket, move recordedMove) (*mcp.CallToolResult, UndoLastMoveOutput, error) {
+	output := UndoLastMoveOutput{
+		TaskBucket: TaskBucket{
+			TaskID:        taskBucket.TaskID,
+			BucketID:      taskBucket.BucketID,
+			ProjectViewID: taskBucket.ProjectViewID,
+		},
+		Message: fmt.Sprintf("Task %d moved back from bucket %d to bucket %d", move.taskID, move.toBucketID, move.fromBucketID),
+	}
+
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, UndoLastMoveOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bucketBoardServer serves task 42 of project 7, whose view 3 has Todo (10) and Done (12)
+// buckets, and moves the task between them as the tool posts moves
+func bucketBoardServer(t *testing.T) (http.HandlerFunc, func() int64) {
+	t.Helper()
+	var mu sync.Mutex
+	bucket := int64(10)
+	current := func() int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return bucket
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var target int64
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/42":
+			fmt.Fprintf(w, `{"id":42,"title":"Move me","project_id":7,"buckets":[{"id":%d,"project_view_id":3}]}`, current()) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":12,"title":"Done"}]`) //nolint:errcheck
+		case r.Method == http.MethodPost && sscanPath(r.URL.Path, "/api/v1/projects/7/views/3/buckets/%d/tasks", &target):
+			mu.Lock()
+			bucket = target
+			mu.Unlock()
+			fmt.Fprintf(w, `{"task_id":42,"bucket_id":%d,"project_view_id":3}`, target) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}, current
+}
+
+func TestUndoLastMoveHandler_RestoresBucket(t *testing.T) {
+	t.Parallel()
+	serve, currentBucket := bucketBoardServer(t)
+	h := newTestHandlers(t, nil, serve)
+
+	_, _, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{TaskID: "42", ProjectID: "7", ViewID: "3", BucketTitle: "Done"})
+	require.NoError(t, err)
+	require.Equal(t, int64(12), currentBucket())
+
+	_, output, err := h.undoLastMoveHandler(t.Context(), nil, UndoLastMoveInput{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), currentBucket(), "the task is back in its original bucket")
+	assert.Equal(t, int64(10), output.TaskBucket.BucketID)
+	assert.Contains(t, output.Message, "moved back from bucket 12 to bucket 10")
+
+	result, _, err := h.undoLastMoveHandler(t.Context(), nil, UndoLastMoveInput{})
+	require.Error(t, err, "an undone move is forgotten")
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "no bucket move to undo")
+}
+
+func TestUndoLastMoveHandler_Readonly(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{Readonly: true}, unexpectedRequest(t))
+	h.moves.moves = []recordedMove{{taskID: 42, projectID: 7, viewID: 3, fromBucketID: 10, toBucketID: 12}}
+
+	result, _, err := h.undoLastMoveHandler(t.Context(), nil, UndoLastMoveInput{})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "readonly")
+}
+
+func TestMoveHistory_KeepsRecentMoves(t *testing.T) {
+	t.Parallel()
+	history := newMoveHistory()
+	for i := range maxRecordedMoves + 5 {
+		history.record(moveTestTask(int64(i)), 3, 12)
+	}
+	history.record(moveTestTask(99), 4, 12)
+
+	assert.Len(t, history.moves, maxRecordedMoves)
+	last, ok := history.last()
+	require.True(t, ok)
+	assert.Equal(t, int64(maxRecordedMoves+4), last.taskID, "a move whose original bucket is unknown is not recorded")
+}
+
+// moveTestTask returns task id of project 7 sitting in bucket 10 of view 3
+func moveTestTask(id int64) *vikunja.Task {
+	return &vikunja.Task{ID: id, ProjectID: 7, Buckets: []*vikunja.Bucket{{ID: 10, ProjectViewID: 3}}}
+}
@@ -3,6 +3,8 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -10,11 +12,11 @@ import (
 
 // moveTaskToBucketHandler handles the move_task_to_bucket tool
 func (h *Handlers) moveTaskToBucketHandler(ctx context.Context, _ *mcp.CallToolRequest, input MoveTaskToBucketInput) (*mcp.CallToolResult, MoveTaskToBucketOutput, error) {
-	if h.isReadonly() {
+	if h.isReadonlyFor("move_task_to_bucket") {
 		return h.buildErrorResult("Operation not available in readonly mode"), MoveTaskToBucketOutput{}, fmt.Errorf("operation not available in readonly mode")
 	}
 
-	client, err := createVikunjaClient()
+	client, err := h.vikunjaClient()
 	if err != nil {
 		return nil, MoveTaskToBucketOutput{}, fmt.Errorf("failed to create client: %w", err)
 	}
@@ -24,7 +26,19 @@ func (h *Handlers) moveTaskToBucketHandler(ctx context.Context, _ *mcp.CallToolR
 		return h.buildErrorResult(err.Error()), MoveTaskToBucketOutput{}, err
 	}
 
-	if err := h.verifyTaskExists(ctx, client, taskID, projectID); err != nil {
+	bucket, err := h.resolveViewBucket(ctx, client, projectID, viewID, bucketID, input.BucketTitle)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), MoveTaskToBucketOutput{}, err
+	}
+	bucketID = bucket.ID
+
+	task, err := h.verifyTaskExists(ctx, client, taskID, projectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), MoveTaskToBucketOutput{}, err
+	}
+
+	warning, err := checkBucketLimit(ctx, client, projectID, viewID, bucket, taskID, input.StrictWIP)
+	if err != nil {
 		return h.buildErrorResult(err.Error()), MoveTaskToBucketOutput{}, err
 	}
 
@@ -33,54 +47,91 @@ func (h *Handlers) moveTaskToBucketHandler(ctx context.Context, _ *mcp.CallToolR
 		return h.buildErrorResult(fmt.Sprintf("Failed to move task: %v", err)), MoveTaskToBucketOutput{}, fmt.Errorf("failed to move task: %w", err)
 	}
 
-	return h.formatMoveTaskOutput(taskBucket, taskID, bucketID)
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, MoveTaskToBucketOutput{Planned: planned}, err
+	}
+	h.moves.record(task, viewID, bucketID)
+
+	return h.formatMoveTaskOutput(taskBucket, taskID, bucketID, warning)
 }
 
 func (h *Handlers) parseMoveTaskIDs(input MoveTaskToBucketInput) (taskID, projectID, viewID, bucketID int64, err error) {
-	taskID, err = parseID("task_id", input.TaskID)
-	if err != nil {
+	var errs ValidationErrors
+	taskID = errs.parseID("task_id", input.TaskID)
+	projectID = errs.parseID("project_id", input.ProjectID)
+	viewID = errs.parseID("view_id", input.ViewID)
+	bucketID, err = parseBucketRef(input.BucketID, input.BucketTitle)
+	errs.add(err)
+	if err := errs.err(); err != nil {
 		return 0, 0, 0, 0, err
 	}
+	return taskID, projectID, viewID, bucketID, nil
+}
 
-	projectID, err = parseID("project_id", input.ProjectID)
-	if err != nil {
-		return 0, 0, 0, 0, err
+// parseBucketRef parses bucket_id, returning 0 when the bucket is given by bucket_title instead
+func parseBucketRef(bucketID, bucketTitle string) (int64, error) {
+	switch {
+	case bucketID != "" && bucketTitle != "":
+		return 0, ValidationError{Field: "bucket_id", Message: "specify either bucket_id or bucket_title, not both"}
+	case bucketTitle != "":
+		return 0, nil
+	case bucketID == "":
+		return 0, ValidationError{Field: "bucket_id", Message: "bucket_id or bucket_title is required"}
 	}
+	return parseID("bucket_id", bucketID)
+}
 
-	viewID, err = parseID("view_id", input.ViewID)
+// resolveViewBucket returns the bucket, which must be one of the view's buckets so a bucket of
+// another view is reported clearly instead of by Vikunja. A zero bucketID is resolved from title.
+func (h *Handlers) resolveViewBucket(ctx context.Context, client *vikunja.Client, projectID, viewID, bucketID int64, title string) (*vikunja.Bucket, error) {
+	buckets, err := client.GetViewBuckets(ctx, projectID, viewID)
 	if err != nil {
-		return 0, 0, 0, 0, err
+		return nil, fmt.Errorf("failed to get view buckets: %w", err)
 	}
 
-	bucketID, err = parseID("bucket_id", input.BucketID)
-	if err != nil {
-		return 0, 0, 0, 0, err
+	if bucketID != 0 {
+		i := slices.IndexFunc(buckets, func(b *vikunja.Bucket) bool { return b.ID == bucketID })
+		if i < 0 {
+			return nil, enhancedBucketIDNotFoundError(bucketID, viewID, bucketLabels(buckets))
+		}
+		return buckets[i], nil
 	}
 
-	return taskID, projectID, viewID, bucketID, nil
+	bucket, err := h.findBucket(buckets, 0, title, strconv.FormatInt(viewID, 10))
+	if err != nil {
+		return nil, enhancedBucketTitleNotFoundError(title, viewID, bucketLabels(buckets))
+	}
+	return bucket, nil
 }
 
-func (h *Handlers) verifyTaskExists(ctx context.Context, client *vikunja.Client, taskID, projectID int64) error {
+// verifyTaskExists returns the task, which must belong to projectID
+func (h *Handlers) verifyTaskExists(ctx context.Context, client *vikunja.Client, taskID, projectID int64) (*vikunja.Task, error) {
 	task, err := client.GetTask(ctx, taskID)
 	if err != nil {
-		return fmt.Errorf("task with ID %d not found: %w", taskID, err)
+		return nil, fmt.Errorf("task with ID %d not found: %w", taskID, err)
 	}
 
 	if task.ProjectID != projectID {
-		return fmt.Errorf("task %d does not belong to project %d", taskID, projectID)
+		return nil, fmt.Errorf("task %d does not belong to project %d", taskID, projectID)
 	}
 
-	return nil
+	return task, nil
 }
 
 func (h *Handlers) moveTask(ctx context.Context, client *vikunja.Client, projectID, viewID, bucketID, taskID int64) (*vikunja.TaskBucket, error) {
 	return client.MoveTaskToBucket(ctx, projectID, viewID, bucketID, taskID)
 }
 
-func (h *Handlers) formatMoveTaskOutput(taskBucket *vikunja.TaskBucket, taskID, bucketID int64) (*mcp.CallToolResult, MoveTaskToBucketOutput, error) {
+func (h *Handlers) formatMoveTaskOutput(taskBucket *vikunja.TaskBucket, taskID, bucketID int64, warning string) (*mcp.CallToolResult, MoveTaskToBucketOutput, error) {
 	output := MoveTaskToBucketOutput{
-		TaskBucket: *taskBucket,
-		Message:    fmt.Sprintf("Task %d successfully moved to bucket %d", taskID, bucketID),
+		TaskBucket: TaskBucket{
+			TaskID:        taskBucket.TaskID,
+			BucketID:      taskBucket.BucketID,
+			ProjectViewID: taskBucket.ProjectViewID,
+		},
+		Message: fmt.Sprintf("Task %d successfully moved to bucket %d", taskID, bucketID),
+		Warning: warning,
 	}
 
 	data, err := h.deps.OutputFormatter.Format(output)
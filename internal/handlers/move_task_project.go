@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// moveTaskToProjectHandler handles the move_task_to_project tool
+func (h *Handlers) moveTaskToProjectHandler(ctx context.Context, _ *mcp.CallToolRequest, input MoveTaskToProjectInput) (*mcp.CallToolResult, MoveTaskToProjectOutput, error) {
+	if h.isReadonlyFor("move_task_to_project") {
+		return h.buildErrorResult("Operation not available in readonly mode"), MoveTaskToProjectOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	var errs ValidationErrors
+	taskID := errs.parseID("task_id", input.TaskID)
+	projectID := errs.parseID("project_id", input.ProjectID)
+	if err := errs.err(); err != nil {
+		return h.buildErrorResult(err.Error()), MoveTaskToProjectOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, MoveTaskToProjectOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	moved, err := client.MoveTaskToProject(ctx, taskID, projectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), MoveTaskToProjectOutput{}, err
+	}
+	// The project the task left is not known, so every count may be off
+	h.taskCounts.invalidateAll()
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, MoveTaskToProjectOutput{Planned: planned}, err
+	}
+
+	result, err := h.formatResult(moved)
+	if err != nil {
+		return nil, MoveTaskToProjectOutput{}, err
+	}
+	return result, MoveTaskToProjectOutput{
+		Task:    toTask(moved),
+		Message: fmt.Sprintf("Task %d moved to project %d; it now sits in that project's default buckets", taskID, projectID),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveTaskToProjectHandler_Rejected(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		input   MoveTaskToProjectInput
+		wantErr string
+	}{
+		{"readonly", &config.Config{Readonly: true}, MoveTaskToProjectInput{TaskID: "5", ProjectID: "9"}, "readonly mode"},
+		{"missing task", nil, MoveTaskToProjectInput{ProjectID: "9"}, "task_id: is required"},
+		{"bad task", nil, MoveTaskToProjectInput{TaskID: "five", ProjectID: "9"}, "task_id: must be a valid integer"},
+		{"missing project", nil, MoveTaskToProjectInput{TaskID: "5"}, "project_id: is required"},
+		{"pseudo project", nil, MoveTaskToProjectInput{TaskID: "5", ProjectID: "-1"}, "project_id: must be a positive integer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, tt.cfg, unexpectedRequest(t))
+
+			result, _, err := h.moveTaskToProjectHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestMoveTaskToProjectHandler_DryRun(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/tasks/5" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":5,"title":"Ship it","project_id":7,"bucket_id":12}`)) //nolint:errcheck
+	})
+	h.deps.DryRun = true
+
+	_, output, err := h.moveTaskToProjectHandler(t.Context(), nil, MoveTaskToProjectInput{TaskID: "5", ProjectID: "9"})
+	require.NoError(t, err)
+	require.Len(t, output.Planned, 1)
+	assert.Equal(t, http.MethodPost, output.Planned[0].Method)
+	assert.Contains(t, output.Planned[0].Endpoint, "/tasks/5")
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// moveTaskServer serves task 42 in project 7 whose view 3 has Todo (10) and Done (12) buckets,
+// and records the bucket a move was posted to
+func moveTaskServer(t *testing.T, movedTo *string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/42":
+			fmt.Fprint(w, `{"id":42,"title":"Move me","project_id":7}`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":12,"title":"Done"}]`) //nolint:errcheck
+		case r.Method == http.MethodPost:
+			*movedTo = r.URL.Path
+			fmt.Fprint(w, `{"task_id":42,"bucket_id":12,"project_view_id":3}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestMoveTaskToBucketHandler_BucketValidation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   MoveTaskToBucketInput
+		wantErr string
+	}{
+		{"neither", MoveTaskToBucketInput{}, "bucket_id or bucket_title is required"},
+		{"both", MoveTaskToBucketInput{BucketID: "12", BucketTitle: "Done"}, "specify either bucket_id or bucket_title, not both"},
+		{"invalid id", MoveTaskToBucketInput{BucketID: "done"}, "bucket_id: must be a valid integer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, unexpectedRequest(t))
+			tt.input.TaskID, tt.input.ProjectID, tt.input.ViewID = "42", "7", "3"
+
+			result, _, err := h.moveTaskToBucketHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestMoveTaskToBucketHandler_BucketTitle(t *testing.T) {
+	t.Parallel()
+	var movedTo string
+	h := newTestHandlers(t, nil, moveTaskServer(t, &movedTo))
+
+	_, output, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{
+		TaskID: "42", ProjectID: "7", ViewID: "3", BucketTitle: "Done",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/v1/projects/7/views/3/buckets/12/tasks", movedTo)
+	assert.Equal(t, "Task 42 successfully moved to bucket 12", output.Message)
+}
+
+func TestMoveTaskToBucketHandler_UnknownBucketTitle(t *testing.T) {
+	t.Parallel()
+	var movedTo string
+	h := newTestHandlers(t, nil, moveTaskServer(t, &movedTo))
+
+	_, _, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{
+		TaskID: "42", ProjectID: "7", ViewID: "3", BucketTitle: "Doing",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `bucket with title "Doing" not found in view 3`)
+	assert.Contains(t, err.Error(), "Available buckets in view 3: [Todo (10) Done (12)]")
+	assert.Empty(t, movedTo)
+}
+
+func TestMoveTaskToBucketHandler_BucketOfAnotherView(t *testing.T) {
+	t.Parallel()
+	var movedTo string
+	h := newTestHandlers(t, nil, moveTaskServer(t, &movedTo))
+
+	result, _, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{
+		TaskID: "42", ProjectID: "7", ViewID: "3", BucketID: "55",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bucket with ID 55 not found in view 3")
+	assert.Contains(t, err.Error(), "Available buckets in view 3: [Todo (10) Done (12)]")
+	assert.True(t, result.IsError)
+	assert.Empty(t, movedTo, "nothing is sent to Vikunja")
+}
+
+func TestMoveTaskToBucketHandler_BucketID(t *testing.T) {
+	t.Parallel()
+	var movedTo string
+	h := newTestHandlers(t, nil, moveTaskServer(t, &movedTo))
+
+	_, output, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{
+		TaskID: "42", ProjectID: "7", ViewID: "3", BucketID: "12",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/v1/projects/7/views/3/buckets/12/tasks", movedTo)
+	assert.Equal(t, "Task 42 successfully moved to bucket 12", output.Message)
+}
+
+// fullBucketServer serves task 42 in project 7 whose view 3 has a Doing bucket (11) limited to two
+// tasks and already holding two, and records the bucket a move was posted to
+func fullBucketServer(t *testing.T, movedTo *string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/42":
+			fmt.Fprint(w, `{"id":42,"title":"Move me","project_id":7}`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":11,"title":"Doing","limit":2}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/tasks":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","tasks":[{"id":42}]},{"id":11,"title":"Doing","limit":2,"tasks":[{"id":1},{"id":2}]}]`) //nolint:errcheck
+		case r.Method == http.MethodPost:
+			*movedTo = r.URL.Path
+			fmt.Fprint(w, `{"task_id":42,"bucket_id":11,"project_view_id":3}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestMoveTaskToBucketHandler_ExceedsBucketLimit(t *testing.T) {
+	t.Parallel()
+	var movedTo string
+	h := newTestHandlers(t, nil, fullBucketServer(t, &movedTo))
+
+	_, output, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{
+		TaskID: "42", ProjectID: "7", ViewID: "3", BucketTitle: "Doing",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/v1/projects/7/views/3/buckets/11/tasks", movedTo, "the limit only warns")
+	assert.Equal(t, "Task 42 successfully moved to bucket 11", output.Message)
+	assert.Equal(t, `bucket "Doing" already holds 2 tasks and has a limit of 2; moving task 42 exceeds it`, output.Warning)
+}
+
+func TestMoveTaskToBucketHandler_StrictWIP(t *testing.T) {
+	t.Parallel()
+	var movedTo string
+	h := newTestHandlers(t, nil, fullBucketServer(t, &movedTo))
+
+	result, _, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{
+		TaskID: "42", ProjectID: "7", ViewID: "3", BucketID: "11", StrictWIP: true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `WIP limit exceeded: bucket "Doing" already holds 2 tasks`)
+	assert.True(t, result.IsError)
+	assert.Empty(t, movedTo, "nothing is sent to Vikunja")
+}
+
+func TestMoveTaskToBucketHandler_WithinBucketLimit(t *testing.T) {
+	t.Parallel()
+	var movedTo string
+	h := newTestHandlers(t, nil, fullBucketServer(t, &movedTo))
+
+	_, output, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{
+		TaskID: "42", ProjectID: "7", ViewID: "3", BucketTitle: "Todo", StrictWIP: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/v1/projects/7/views/3/buckets/10/tasks", movedTo)
+	assert.Empty(t, output.Warning)
+}
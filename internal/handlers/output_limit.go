@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// withOutputLimit bounds the size of the result sent to the client, so a large board or listing
+// cannot flood the client's context. An oversized result loses its structured output, which cannot
+// be cut, and its text is cut until the whole result fits. Error results are passed through unchanged.
+func withOutputLimit[In, Out any](h *Handlers, next mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		result, output, err := next(ctx, req, input)
+		limit := h.maxOutputBytes()
+		if limit <= 0 || result == nil || result.IsError || resultSize(result, output) <= limit {
+			return result, output, err
+		}
+		var dropped Out
+		return limitOutput(result, dropped, limit), dropped, err
+	}
+}
+
+// maxOutputBytes returns the configured bound on the size of a tool's result
+func (h *Handlers) maxOutputBytes() int {
+	if h.deps.Config != nil {
+		return h.deps.Config.MaxOutputBytes
+	}
+	return 0
+}
+
+// resultSize returns the size of result as sent to the client with structured as its structured
+// output, the way the MCP server will attach it
+func resultSize(result *mcp.CallToolResult, structured any) int {
+	sized := *result
+	sized.StructuredContent = structured
+	data, err := json.Marshal(&sized)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
+
+// limitOutput returns result with its text cut so that, sent with structured, it fits in limit
+// bytes. The result itself is copied rather than modified, as it may be shared with the
+// idempotency cache.
+func limitOutput(result *mcp.CallToolResult, structured any, limit int) *mcp.CallToolResult {
+	budget := limit - resultSize(result, structured) + textSize(result)
+	for {
+		limited := limitText(result, budget, limit)
+		excess := resultSize(limited, structured) - limit
+		if excess <= 0 || budget <= 0 {
+			return limited
+		}
+		budget -= excess
+	}
+}
+
+// textSize returns the combined length of result's text contents
+func textSize(result *mcp.CallToolResult) int {
+	size := 0
+	for _, content := range result.Content {
+		if text, ok := content.(*mcp.TextContent); ok {
+			size += len(text.Text)
+		}
+	}
+	return size
+}
+
+// limitText returns a copy of result with every text content longer than budget bytes truncated
+func limitText(result *mcp.CallToolResult, budget, limit int) *mcp.CallToolResult {
+	limited := *result
+	limited.Content = slices.Clone(result.Content)
+	for i, content := range limited.Content {
+		text, ok := content.(*mcp.TextContent)
+		if !ok || len(text.Text) <= budget {
+			continue
+		}
+		limited.Content[i] = &mcp.TextContent{Text: truncateOutput(text.Text, budget, limit), Meta: text.Meta, Annotations: text.Annotations}
+	}
+	return &limited
+}
+
+// truncatedJSON is what truncateOutput returns for JSON text, so the result stays valid JSON
+type truncatedJSON struct {
+	Truncated     string `json:"truncated"`
+	PartialOutput string `json:"partial_output"`
+}
+
+// truncateOutput keeps the whole lines of text that fit in budget bytes together with a trailer
+// saying how many lines were left out. JSON text is returned as a JSON object holding the kept
+// lines and the trailer.
+func truncateOutput(text string, budget, limit int) string {
+	lines := strings.Count(strings.TrimRight(text, "\n"), "\n") + 1
+	// Room is left for the trailer and the newline ending the kept text
+	cut := min(max(budget-len(truncationTrailer(lines, limit))-1, 0), len(text))
+	for cut > 0 && cut < len(text) && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	if nl := strings.LastIndexByte(text[:cut], '\n'); nl >= 0 {
+		cut = nl + 1
+	}
+
+	kept := text[:cut]
+	omitted := strings.Count(strings.TrimRight(text[cut:], "\n"), "\n") + 1
+	trailer := truncationTrailer(omitted, limit)
+	if json.Valid([]byte(text)) {
+		data, err := json.Marshal(truncatedJSON{Truncated: strings.TrimSpace(trailer), PartialOutput: kept})
+		if err == nil {
+			return string(data)
+		}
+	}
+	if kept != "" && !strings.HasSuffix(kept, "\n") {
+		kept += "\n"
+	}
+	return kept + trailer
+}
+
+// truncationTrailer is the note ending truncated output
+func truncationTrailer(omitted, limit int) string {
+	return fmt.Sprintf("... %d more lines omitted (output is limited to %d bytes), use pagination or filters to narrow the result\n", omitted, limit)
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectListing returns a markdown listing of n projects and the structured output describing them
+func projectListing(n int) (string, ListWritableProjectsOutput) {
+	var text strings.Builder
+	text.WriteString("# Projects\n")
+	output := ListWritableProjectsOutput{}
+	for i := range n {
+		fmt.Fprintf(&text, "- [Project %d] Project number %d\n", i, i)
+		output.Projects = append(output.Projects, Project{ID: int64(i), Title: fmt.Sprintf("Project number %d", i)})
+	}
+	return text.String(), output
+}
+
+func TestWithOutputLimit(t *testing.T) {
+	t.Parallel()
+	listing, structured := projectListing(20)
+	fullSize := resultSize(&mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: listing}}}, structured)
+	tests := []struct {
+		name      string
+		limit     int
+		isError   bool
+		truncated bool
+	}{
+		{"no limit", 0, false, false},
+		{"under limit", fullSize, false, false},
+		{"over limit", 400, false, true},
+		{"error result", 400, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, &config.Config{MaxOutputBytes: tt.limit}, unexpectedRequest(t))
+			original := &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: listing}}, IsError: tt.isError}
+			handler := withOutputLimit(h, func(context.Context, *mcp.CallToolRequest, ListWritableProjectsInput) (*mcp.CallToolResult, ListWritableProjectsOutput, error) {
+				return original, structured, nil
+			})
+
+			result, output, err := handler(t.Context(), nil, ListWritableProjectsInput{})
+			require.NoError(t, err)
+			require.Len(t, result.Content, 1)
+			text := result.Content[0].(*mcp.TextContent).Text
+			assert.Equal(t, listing, original.Content[0].(*mcp.TextContent).Text, "the original result must not be modified")
+
+			if !tt.truncated {
+				assert.Equal(t, listing, text)
+				assert.Len(t, output.Projects, 20)
+				return
+			}
+			assert.LessOrEqual(t, resultSize(result, output), tt.limit)
+			assert.Empty(t, output.Projects, "structured output is dropped rather than sent past the limit")
+			assert.True(t, strings.HasPrefix(text, "# Projects\n- [Project 0] Project number 0\n"))
+			assert.Contains(t, text, "more lines omitted (output is limited to 400 bytes)")
+		})
+	}
+}
+
+func TestWithOutputLimit_JSONStaysValid(t *testing.T) {
+	t.Parallel()
+	_, structured := projectListing(20)
+	text, err := json.MarshalIndent(structured, "", "  ")
+	require.NoError(t, err)
+	h := newTestHandlers(t, &config.Config{MaxOutputBytes: 500}, unexpectedRequest(t))
+	handler := withOutputLimit(h, func(context.Context, *mcp.CallToolRequest, ListWritableProjectsInput) (*mcp.CallToolResult, ListWritableProjectsOutput, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: string(text)}}}, structured, nil
+	})
+
+	result, output, err := handler(t.Context(), nil, ListWritableProjectsInput{})
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, resultSize(result, output), 500)
+	var truncated truncatedJSON
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &truncated))
+	assert.Contains(t, truncated.Truncated, "more lines omitted")
+	assert.True(t, strings.HasPrefix(string(text), truncated.PartialOutput))
+	assert.NotEmpty(t, truncated.PartialOutput)
+}
+
+func TestWithOutputLimit_BoundsCallToolResult(t *testing.T) {
+	t.Parallel()
+	const limit = 600
+	listing, structured := projectListing(50)
+	h := newTestHandlers(t, &config.Config{MaxOutputBytes: limit}, unexpectedRequest(t))
+	s := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.0"}, nil)
+	addTool(s, h, &mcp.Tool{Name: "big_listing"}, func(context.Context, *mcp.CallToolRequest, ListWritableProjectsInput) (*mcp.CallToolResult, ListWritableProjectsOutput, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: listing}}}, structured, nil
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	_, err := s.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	client := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "0.0.0"}, nil)
+	session, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+
+	result, err := session.CallTool(t.Context(), &mcp.CallToolParams{Name: "big_listing"})
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(data), limit, "text and structured output together stay within the limit")
+	assert.Contains(t, result.Content[0].(*mcp.TextContent).Text, "more lines omitted")
+}
+
+func TestTruncateOutput_SingleLine(t *testing.T) {
+	t.Parallel()
+	const text = "héllo wörld, this line is long\nsecond"
+	got := truncateOutput(text, 120, 120)
+
+	assert.LessOrEqual(t, len(got), 120, "the trailer fits within the budget")
+	assert.True(t, utf8.ValidString(got), "text is cut at a rune boundary")
+	kept, trailer, found := strings.Cut(got, "\n")
+	require.True(t, found)
+	assert.True(t, strings.HasPrefix(text, kept))
+	assert.Equal(t, "... 2 more lines omitted (output is limited to 120 bytes), use pagination or filters to narrow the result\n", trailer)
+}
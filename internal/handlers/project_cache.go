@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// projectCache remembers which projects carry which title, so resolving a project by title does
+// not fetch the whole project list on every tool call. It holds the most recent project list for
+// the life of the process; tools that create, delete or edit projects must invalidate it.
+type projectCache struct {
+	mu       sync.Mutex
+	loaded   bool
+	projects []*vikunja.Project
+	byTitle  map[string][]*vikunja.Project
+}
+
+func newProjectCache() *projectCache {
+	return &projectCache{}
+}
+
+// lookup returns the projects titled title, and false when the project list must be fetched
+// because it was never loaded or does not know the title
+func (c *projectCache) lookup(title string) ([]*vikunja.Project, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	matches, ok := c.byTitle[title]
+	return matches, c.loaded && ok
+}
+
+// list returns the cached projects in server order, and false when they were never loaded
+func (c *projectCache) list() ([]*vikunja.Project, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.projects, c.loaded
+}
+
+// store replaces the cached project list
+func (c *projectCache) store(projects []*vikunja.Project) {
+	byTitle := make(map[string][]*vikunja.Project, len(projects))
+	for _, p := range projects {
+		byTitle[p.Title] = append(byTitle[p.Title], p)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loaded = true
+	c.projects = projects
+	c.byTitle = byTitle
+}
+
+// invalidate drops the cached project list so the next lookup fetches it again
+func (c *projectCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loaded = false
+	c.projects = nil
+	c.byTitle = nil
+}
+
+// projectsByTitle returns every project titled title, fetching the project list only when the
+// cache cannot answer. A title the cache does not know is looked up afresh, so new projects are found.
+func (h *Handlers) projectsByTitle(ctx context.Context, client *vikunja.Client, title string) ([]*vikunja.Project, error) {
+	if matches, ok := h.projects.lookup(title); ok {
+		return matches, nil
+	}
+
+	projects, err := client.GetProjects(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	h.projects.store(projects)
+
+	var matches []*vikunja.Project
+	for _, p := range projects {
+		if p.Title == title {
+			matches = append(matches, p)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, enhancedProjectNotFoundError(title, extractProjectTitles(projects))
+	}
+	return matches, nil
+}
+
+// cachedProjects returns the project list, fetching it only when the cache holds none
+func (h *Handlers) cachedProjects(ctx context.Context, client *vikunja.Client) ([]*vikunja.Project, error) {
+	if projects, ok := h.projects.list(); ok {
+		return projects, nil
+	}
+
+	projects, err := client.GetProjects(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	h.projects.store(projects)
+	return projects, nil
+}
+
+// freshProjects fetches the project list, bypassing and refreshing the cache, for callers which
+// must see projects created or deleted outside this server
+func (h *Handlers) freshProjects(ctx context.Context, client *vikunja.Client) ([]*vikunja.Project, error) {
+	projects, err := client.GetProjects(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	h.projects.store(projects)
+	return projects, nil
+}
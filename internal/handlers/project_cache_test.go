@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectListServer serves two projects and counts how often the project list is fetched
+func projectListServer(t *testing.T, listings *atomic.Int64) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			listings.Add(1)
+			fmt.Fprint(w, `[{"id":1,"title":"Inbox"},{"id":7,"title":"Board"}]`) //nolint:errcheck
+		case "/api/v1/user":
+			fmt.Fprint(w, `{"id":1,"settings":{}}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestProjectsByTitle_ResolvesFromCache(t *testing.T) {
+	t.Parallel()
+	var listings atomic.Int64
+	h := newTestHandlers(t, nil, projectListServer(t, &listings))
+	client, err := h.vikunjaClient()
+	require.NoError(t, err)
+
+	_, boardID, err := h.resolveProjectByValue(t.Context(), client, "Board")
+	require.NoError(t, err)
+	project, err := h.findProjectByIDOrTitle(t.Context(), client, "", "Board")
+	require.NoError(t, err)
+	_, inboxID, err := h.resolveProjectByValue(t.Context(), client, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(7), boardID)
+	assert.Equal(t, int64(7), project.ID)
+	assert.Equal(t, int64(1), inboxID)
+	assert.Equal(t, int64(1), listings.Load(), "the project list must be fetched once")
+}
+
+func TestProjectsByTitle_Refetches(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		prepare func(h *Handlers)
+		title   string
+		wantErr bool
+	}{
+		{"unknown title", func(*Handlers) {}, "Missing", true},
+		{"after invalidation", func(h *Handlers) { h.projects.invalidate() }, "Board", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var listings atomic.Int64
+			h := newTestHandlers(t, nil, projectListServer(t, &listings))
+			client, err := h.vikunjaClient()
+			require.NoError(t, err)
+
+			_, err = h.projectsByTitle(t.Context(), client, "Board")
+			require.NoError(t, err)
+			tt.prepare(h)
+			_, err = h.projectsByTitle(t.Context(), client, tt.title)
+
+			if tt.wantErr {
+				assert.ErrorContains(t, err, `project with title "Missing" not found`)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, int64(2), listings.Load())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// resolver resolves projects, views and buckets through client as configured, looking projects
+// up through the project cache
+func (h *Handlers) resolver(client *vikunja.Client) *resolution.Resolver {
+	r := &resolution.Resolver{
+		Client: client,
+		ListProjects: func(ctx context.Context) ([]*vikunja.Project, error) {
+			return h.cachedProjects(ctx, client)
+		},
+		ProjectsByTitle: func(ctx context.Context, title string) ([]*vikunja.Project, error) {
+			return h.projectsByTitle(ctx, client, title)
+		},
+		Logger: h.deps.Logger,
+	}
+	if cfg := h.deps.Config; cfg != nil {
+		r.DefaultProject = cfg.Vikunja.DefaultProject
+		r.FirstOfDuplicateTitles = h.firstOfDuplicateTitles()
+		r.ViewFallback = cfg.ViewFallback
+	}
+	return r
+}
+
+// firstOfDuplicateTitles reports whether a title shared by several projects resolves to the first
+func (h *Handlers) firstOfDuplicateTitles() bool {
+	return h.deps.Config != nil && h.deps.Config.DuplicateProjectTitles == config.DuplicateTitlesFirst
+}
+
+// projectByTitle resolves title to a single project. A title shared by several projects is an
+// ambiguity error listing their IDs, unless the configuration resolves it to the first match.
+func (h *Handlers) projectByTitle(ctx context.Context, client *vikunja.Client, title string) (*Project, error) {
+	project, err := h.resolver(client).ProjectByTitle(ctx, title)
+	if err != nil {
+		return nil, err
+	}
+	p := toProject(project)
+	return &p, nil
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// duplicateWorkServer serves two projects titled "Work" next to a uniquely titled one
+func duplicateWorkServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":3,"title":"Work"},{"id":5,"title":"Home"},{"id":9,"title":"Work"}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestFindProjectByNameHandler_DuplicateTitles(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, duplicateWorkServer(t))
+
+	result, _, err := h.findProjectByNameHandler(t.Context(), nil, FindProjectByNameInput{Name: "Work"})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), `multiple projects found with title "Work" (IDs: 3, 9)`)
+	assert.Contains(t, err.Error(), "project_id")
+
+	_, output, err := h.findProjectByNameHandler(t.Context(), nil, FindProjectByNameInput{Name: "Home"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), output.Project.ID)
+}
+
+func TestProjectByTitle_DuplicateTitlePolicy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		policy  config.DuplicateTitlePolicy
+		wantID  int64
+		wantErr bool
+	}{
+		{"rejected by default", "", 0, true},
+		{"rejected", config.DuplicateTitlesError, 0, true},
+		{"first match", config.DuplicateTitlesFirst, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, &config.Config{DuplicateProjectTitles: tt.policy}, duplicateWorkServer(t))
+			client, err := h.vikunjaClient()
+			require.NoError(t, err)
+
+			_, id, err := h.resolveProjectByValue(t.Context(), client, "Work")
+			project, titleErr := h.findProjectByIDOrTitle(t.Context(), client, "", "Work")
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, titleErr)
+				assert.Contains(t, err.Error(), "IDs: 3, 9")
+				assert.Contains(t, titleErr.Error(), "IDs: 3, 9")
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, titleErr)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantID, project.ID)
+		})
+	}
+}
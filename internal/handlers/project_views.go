@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/sync/errgroup"
+)
+
+// getProjectWithViews answers get_project with the project's views embedded and, with
+// withBuckets, the buckets of its kanban views
+func (h *Handlers) getProjectWithViews(ctx context.Context, client *vikunja.Client, project *vikunja.Project, withBuckets bool) (*mcp.CallToolResult, GetProjectOutput, error) {
+	views, err := client.GetProjectViews(ctx, project.ID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetProjectOutput{}, fmt.Errorf("failed to get project views: %w", err)
+	}
+
+	var buckets []vikunja.ViewBuckets
+	if withBuckets {
+		if buckets, err = kanbanViewBuckets(ctx, client, project.ID, views); err != nil {
+			return h.buildErrorResult(err.Error()), GetProjectOutput{}, err
+		}
+	}
+
+	result, err := h.formatResult(vikunja.ViewsOutput{Project: *project, Views: views, Buckets: buckets})
+	if err != nil {
+		return nil, GetProjectOutput{}, err
+	}
+	return result, GetProjectOutput{Project: toProject(project), Views: toViews(views), Buckets: toViewBuckets(buckets)}, nil
+}
+
+// kanbanViewBuckets reads the buckets of each kanban view among views concurrently
+func kanbanViewBuckets(ctx context.Context, client *vikunja.Client, projectID int64, views []*vikunja.ProjectView) ([]vikunja.ViewBuckets, error) {
+	var kanban []*vikunja.ProjectView
+	for _, v := range views {
+		if v.ViewKind == string(vikunja.ViewKindKanban) {
+			kanban = append(kanban, v)
+		}
+	}
+
+	buckets := make([]vikunja.ViewBuckets, len(kanban))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(discoverViewFetches)
+	for i, view := range kanban {
+		g.Go(func() error {
+			viewBuckets, err := client.GetViewBuckets(ctx, projectID, view.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get buckets of view %q: %w", view.Title, err)
+			}
+			buckets[i] = vikunja.ViewBuckets{ViewID: view.ID, ViewTitle: view.Title, Buckets: viewBuckets}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return buckets, nil
+}
+
+func toViewBuckets(buckets []vikunja.ViewBuckets) []ViewBuckets {
+	if buckets == nil {
+		return nil
+	}
+	result := make([]ViewBuckets, len(buckets))
+	for i, vb := range buckets {
+		result[i] = ViewBuckets{ViewID: vb.ViewID, ViewTitle: vb.ViewTitle, Buckets: toBuckets(vb.Buckets)}
+	}
+	return result
+}
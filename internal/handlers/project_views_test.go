@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectViewsServer serves project 7 with a list view (2) and a kanban view (3) holding Todo and
+// Done buckets
+func projectViewsServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/7":
+			fmt.Fprint(w, `{"id":7,"title":"Work"}`) //nolint:errcheck
+		case "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":2,"project_id":7,"title":"List","view_kind":"list"},{"id":3,"project_id":7,"title":"Board","view_kind":"kanban"}]`) //nolint:errcheck
+		case "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":12,"title":"Done"}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestGetProjectHandler_IncludeViews(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		input       GetProjectInput
+		wantViews   int
+		wantBuckets []int
+	}{
+		{"project only", GetProjectInput{Project: "7"}, 0, nil},
+		{"views", GetProjectInput{Project: "7", IncludeViews: true}, 2, nil},
+		{"buckets", GetProjectInput{Project: "7", IncludeBuckets: true}, 2, []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, projectViewsServer(t))
+
+			result, output, err := h.getProjectHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+
+			assert.Equal(t, "Work", output.Project.Title)
+			assert.Len(t, output.Views, tt.wantViews)
+			require.Len(t, output.Buckets, len(tt.wantBuckets))
+			for i, want := range tt.wantBuckets {
+				assert.Equal(t, int64(3), output.Buckets[i].ViewID, "only kanban views have buckets")
+				assert.Len(t, output.Buckets[i].Buckets, want)
+			}
+
+			text := result.Content[0].(*mcp.TextContent).Text
+			if tt.wantViews == 0 {
+				assert.NotContains(t, text, `"Board"`)
+			} else {
+				assert.Contains(t, text, `"Board"`)
+			}
+		})
+	}
+}
@@ -4,23 +4,31 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // listProjectsHandler handles the list_projects tool
-func (h *Handlers) listProjectsHandler(ctx context.Context, _ *mcp.CallToolRequest, _ ListProjectsInput) (*mcp.CallToolResult, ListProjectsOutput, error) {
-	client, err := createVikunjaClient()
+func (h *Handlers) listProjectsHandler(ctx context.Context, _ *mcp.CallToolRequest, input ListProjectsInput) (*mcp.CallToolResult, ListProjectsOutput, error) {
+	if err := validateListProjectsInput(input); err != nil {
+		return h.buildErrorResult(err.Error()), ListProjectsOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
 	if err != nil {
 		return nil, ListProjectsOutput{}, err
 	}
 
-	projects, err := client.GetProjects(ctx)
+	projects, err := client.GetProjects(ctx, input.IncludeArchived)
 	if err != nil {
 		return nil, ListProjectsOutput{}, fmt.Errorf("failed to list projects: %w", err)
 	}
+	if !input.IncludeArchived {
+		h.projects.store(projects)
+	}
 
 	output := ListProjectsOutput{
-		Projects: projects,
+		Projects: sortAndLimitProjects(projects, input.Sort, input.Limit),
 	}
 
 	data, err := h.deps.OutputFormatter.Format(output.Projects)
@@ -42,31 +50,14 @@ func (h *Handlers) findProjectByNameHandler(ctx context.Context, _ *mcp.CallTool
 		return h.buildErrorResult(err.Error()), FindProjectByNameOutput{}, err
 	}
 
-	client, err := createVikunjaClient()
+	client, err := h.vikunjaClient()
 	if err != nil {
 		return nil, FindProjectByNameOutput{}, err
 	}
 
-	projects, err := client.GetProjects(ctx)
+	project, err := h.projectByTitle(ctx, client, input.Name)
 	if err != nil {
-		return nil, FindProjectByNameOutput{}, fmt.Errorf("failed to list projects: %w", err)
-	}
-
-	found := false
-	var project Project
-	for _, p := range projects {
-		if p.Title == input.Name {
-			project = Project{
-				ID:    p.ID,
-				Title: p.Title,
-				URI:   fmt.Sprintf("vikunja://project/%d", p.ID),
-			}
-			found = true
-			break
-		}
-	}
-	if !found {
-		return h.buildErrorResult(fmt.Sprintf("project with title %q not found", input.Name)), FindProjectByNameOutput{}, fmt.Errorf("project with title %q not found", input.Name)
+		return h.buildErrorResult(err.Error()), FindProjectByNameOutput{}, err
 	}
 
 	data, err := h.deps.OutputFormatter.Format(project)
@@ -78,5 +69,49 @@ func (h *Handlers) findProjectByNameHandler(ctx context.Context, _ *mcp.CallTool
 		Content: []mcp.Content{
 			&mcp.TextContent{Text: string(data)},
 		},
-	}, FindProjectByNameOutput{Project: project}, nil
+	}, FindProjectByNameOutput{Project: *project}, nil
+}
+
+// getProjectHandler handles the get_project tool
+func (h *Handlers) getProjectHandler(ctx context.Context, _ *mcp.CallToolRequest, input GetProjectInput) (*mcp.CallToolResult, GetProjectOutput, error) {
+	if err := validateRequiredString("project", input.Project); err != nil {
+		return h.buildErrorResult(err.Error()), GetProjectOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, GetProjectOutput{}, err
+	}
+
+	project, err := h.getProjectByValue(ctx, client, input.Project)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetProjectOutput{}, err
+	}
+	if input.IncludeViews || input.IncludeBuckets {
+		return h.getProjectWithViews(ctx, client, project, input.IncludeBuckets)
+	}
+
+	result, err := h.formatResult(project)
+	if err != nil {
+		return nil, GetProjectOutput{}, err
+	}
+	return result, GetProjectOutput{Project: toProject(project)}, nil
+}
+
+// getProjectByValue fetches the full project named by an ID (integer string) or title
+func (h *Handlers) getProjectByValue(ctx context.Context, client *vikunja.Client, value string) (*vikunja.Project, error) {
+	id, err := parseIDAllowingSpecial("project", value)
+	if err != nil {
+		match, err := h.projectByTitle(ctx, client, value)
+		if err != nil {
+			return nil, err
+		}
+		id = match.ID
+	}
+
+	project, err := client.GetProject(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("project with ID %d not found: %w", id, err)
+	}
+	return project, nil
 }
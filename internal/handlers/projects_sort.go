@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// Orders list_projects can sort by
+const (
+	projectSortTitle   = "title"
+	projectSortID      = "id"
+	projectSortCreated = "created"
+)
+
+func projectSortOrders() []string {
+	return []string{projectSortTitle, projectSortID, projectSortCreated}
+}
+
+func validateListProjectsInput(input ListProjectsInput) error {
+	var errs ValidationErrors
+	if input.Sort != "" && !slices.Contains(projectSortOrders(), input.Sort) {
+		errs.add(ValidationError{Field: "sort", Message: fmt.Sprintf("must be one of: %s. Got: %s", strings.Join(projectSortOrders(), ", "), input.Sort)})
+	}
+	if input.Limit < 0 {
+		errs.add(ValidationError{Field: "limit", Message: fmt.Sprintf("must not be negative, got: %d", input.Limit)})
+	}
+	return errs.err()
+}
+
+// sortAndLimitProjects returns projects in the given order, cut to limit when it is positive.
+// An empty order keeps the server's order. projects itself is left untouched.
+func sortAndLimitProjects(projects []*vikunja.Project, order string, limit int) []*vikunja.Project {
+	if order == "" && (limit <= 0 || limit >= len(projects)) {
+		return projects
+	}
+
+	sorted := slices.Clone(projects)
+	switch order {
+	case projectSortTitle:
+		slices.SortStableFunc(sorted, func(a, b *vikunja.Project) int {
+			return cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		})
+	case projectSortID:
+		slices.SortStableFunc(sorted, func(a, b *vikunja.Project) int { return cmp.Compare(a.ID, b.ID) })
+	case projectSortCreated:
+		slices.SortStableFunc(sorted, func(a, b *vikunja.Project) int {
+			return projectCreated(a).Compare(projectCreated(b))
+		})
+	}
+
+	if limit > 0 && limit < len(sorted) {
+		sorted = sorted[:limit]
+	}
+	return sorted
+}
+
+// projectCreated parses a project's creation time; unparsable times sort first
+func projectCreated(p *vikunja.Project) time.Time {
+	created, err := time.Parse(time.RFC3339, p.Created)
+	if err != nil {
+		return time.Time{}
+	}
+	return created
+}
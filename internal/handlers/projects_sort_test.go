@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func projectsServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/projects" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"id":3,"title":"work","created":"2025-02-01T00:00:00Z"},
+			{"id":1,"title":"Inbox","created":"2025-03-01T00:00:00Z"},
+			{"id":2,"title":"Garden","created":"2025-01-01T00:00:00Z"}
+		]`) //nolint:errcheck
+	}
+}
+
+func projectIDs(projects []*vikunja.Project) []int64 {
+	ids := make([]int64, len(projects))
+	for i, p := range projects {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func TestListProjectsHandler_SortAndLimit(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		input ListProjectsInput
+		want  []int64
+	}{
+		{name: "server order by default", input: ListProjectsInput{}, want: []int64{3, 1, 2}},
+		{name: "by title", input: ListProjectsInput{Sort: "title"}, want: []int64{2, 1, 3}},
+		{name: "by id", input: ListProjectsInput{Sort: "id"}, want: []int64{1, 2, 3}},
+		{name: "by created", input: ListProjectsInput{Sort: "created"}, want: []int64{2, 3, 1}},
+		{name: "limit", input: ListProjectsInput{Limit: 2}, want: []int64{3, 1}},
+		{name: "limit after sorting", input: ListProjectsInput{Sort: "title", Limit: 1}, want: []int64{2}},
+		{name: "limit above count", input: ListProjectsInput{Limit: 10}, want: []int64{3, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, projectsServer(t))
+
+			_, output, err := h.listProjectsHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, projectIDs(output.Projects))
+		})
+	}
+}
+
+func TestListProjectsHandler_InvalidSortAndLimit(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.listProjectsHandler(t.Context(), nil, ListProjectsInput{Sort: "color", Limit: -1})
+	require.Error(t, err)
+	assert.Equal(t, "sort: must be one of: title, id, created. Got: color; limit: must not be negative, got: -1", err.Error())
+	assert.True(t, result.IsError)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// renameBucketHandler handles the rename_bucket tool
+func (h *Handlers) renameBucketHandler(ctx context.Context, _ *mcp.CallToolRequest, input RenameBucketInput) (*mcp.CallToolResult, RenameBucketOutput, error) {
+	if h.isReadonlyFor("rename_bucket") {
+		return h.buildErrorResult("Operation not available in readonly mode"), RenameBucketOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	projectID, viewID, bucketID, err := parseRenameBucketInput(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), RenameBucketOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, RenameBucketOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	bucket, err := h.resolveViewBucket(ctx, client, projectID, viewID, bucketID, input.BucketTitle)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), RenameBucketOutput{}, err
+	}
+
+	// Vikunja replaces the whole bucket on update, so the stored limit and position are sent back
+	oldTitle := bucket.Title
+	bucket.Title = input.NewTitle
+	updated, err := client.UpdateBucket(ctx, projectID, viewID, bucket)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), RenameBucketOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, RenameBucketOutput{Planned: planned}, err
+	}
+
+	output := RenameBucketOutput{
+		Bucket:  toBucket(updated),
+		Message: fmt.Sprintf("Bucket %d renamed from %q to %q", updated.ID, oldTitle, updated.Title),
+	}
+	result, err := h.formatResult(output)
+	if err != nil {
+		return nil, RenameBucketOutput{}, err
+	}
+	return result, output, nil
+}
+
+func parseRenameBucketInput(input RenameBucketInput) (projectID, viewID, bucketID int64, err error) {
+	var errs ValidationErrors
+	projectID = errs.parseID("project_id", input.ProjectID)
+	viewID = errs.parseID("view_id", input.ViewID)
+	bucketID, err = parseBucketRef(input.BucketID, input.BucketTitle)
+	errs.add(err)
+	errs.add(validateRequiredString("new_title", input.NewTitle))
+	return projectID, viewID, bucketID, errs.err()
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// renameBucketServer serves project 7 whose view 3 has Todo (10) and Doing (11, limit 3) buckets,
+// and records the bucket sent back on update
+func renameBucketServer(t *testing.T, sent *map[string]any) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","position":1},{"id":11,"title":"Doing","limit":3,"position":2}]`) //nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/projects/7/views/3/buckets/11":
+			if err := json.NewDecoder(r.Body).Decode(sent); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			json.NewEncoder(w).Encode(*sent) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestRenameBucketHandler_ByTitle(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	h := newTestHandlers(t, nil, renameBucketServer(t, &sent))
+
+	_, output, err := h.renameBucketHandler(t.Context(), nil, RenameBucketInput{
+		ProjectID: "7", ViewID: "3", BucketTitle: "Doing", NewTitle: "In progress",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "In progress", sent["title"])
+	assert.InDelta(t, 3, sent["limit"], 0.0001, "the stored limit must be sent back")
+	assert.InDelta(t, 2, sent["position"], 0.0001, "the stored position must be sent back")
+	assert.Equal(t, "In progress", output.Bucket.Title)
+	assert.Equal(t, `Bucket 11 renamed from "Doing" to "In progress"`, output.Message)
+}
+
+func TestRenameBucketHandler_Rejected(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		input   RenameBucketInput
+		wantErr string
+	}{
+		{"readonly", &config.Config{Readonly: true}, RenameBucketInput{ProjectID: "7", ViewID: "3", BucketID: "11", NewTitle: "Done"}, "readonly mode"},
+		{"no new title", nil, RenameBucketInput{ProjectID: "7", ViewID: "3", BucketID: "11"}, "new_title"},
+		{"no bucket", nil, RenameBucketInput{ProjectID: "7", ViewID: "3", NewTitle: "Done"}, "bucket_id or bucket_title is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, tt.cfg, unexpectedRequest(t))
+
+			result, _, err := h.renameBucketHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestRenameBucketHandler_UnknownTitle(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	h := newTestHandlers(t, nil, renameBucketServer(t, &sent))
+
+	_, _, err := h.renameBucketHandler(t.Context(), nil, RenameBucketInput{
+		ProjectID: "7", ViewID: "3", BucketTitle: "Review", NewTitle: "QA",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `bucket with title "Review" not found in view 3`)
+	assert.Nil(t, sent, "nothing is sent to Vikunja")
+}
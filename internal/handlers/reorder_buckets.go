@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// bucketPositionSpacing leaves room between buckets so later single moves don't force a renumbering
+	bucketPositionSpacing = 65536
+	// maxConcurrentBucketUpdates bounds the number of in-flight bucket updates
+	maxConcurrentBucketUpdates = 4
+)
+
+// reorderBucketsHandler handles the reorder_buckets tool
+func (h *Handlers) reorderBucketsHandler(ctx context.Context, _ *mcp.CallToolRequest, input ReorderBucketsInput) (*mcp.CallToolResult, ReorderBucketsOutput, error) {
+	if h.isReadonlyFor("reorder_buckets") {
+		return h.buildErrorResult("Operation not available in readonly mode"), ReorderBucketsOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	projectID, viewID, bucketIDs, err := parseReorderBucketsInput(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ReorderBucketsOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ReorderBucketsOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	buckets, err := client.GetViewBuckets(ctx, projectID, viewID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ReorderBucketsOutput{}, err
+	}
+
+	ordered, err := orderBuckets(buckets, bucketIDs, viewID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ReorderBucketsOutput{}, err
+	}
+
+	moved, err := moveBuckets(ctx, client, projectID, viewID, ordered)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ReorderBucketsOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, ReorderBucketsOutput{Planned: planned}, err
+	}
+
+	output := ReorderBucketsOutput{
+		Buckets: toBuckets(moved),
+		Message: fmt.Sprintf("Reordered %d buckets in view %d", len(moved), viewID),
+	}
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, ReorderBucketsOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+func parseReorderBucketsInput(input ReorderBucketsInput) (projectID, viewID int64, bucketIDs []int64, err error) {
+	if projectID, err = parseID("project_id", input.ProjectID); err != nil {
+		return 0, 0, nil, err
+	}
+	if viewID, err = parseID("view_id", input.ViewID); err != nil {
+		return 0, 0, nil, err
+	}
+	if len(input.BucketIDs) == 0 {
+		return 0, 0, nil, ValidationError{Field: "bucket_ids", Message: "at least one bucket ID is required"}
+	}
+
+	seen := make(map[int64]bool, len(input.BucketIDs))
+	for i, raw := range input.BucketIDs {
+		id, err := parseID(fmt.Sprintf("bucket_ids[%d]", i), raw)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		if seen[id] {
+			return 0, 0, nil, ValidationError{Field: "bucket_ids", Message: fmt.Sprintf("bucket %d is listed more than once", id)}
+		}
+		seen[id] = true
+		bucketIDs = append(bucketIDs, id)
+	}
+	return projectID, viewID, bucketIDs, nil
+}
+
+// orderBuckets returns the view's buckets in the requested order. Buckets not mentioned keep their
+// relative order after the requested ones.
+func orderBuckets(buckets []*vikunja.Bucket, bucketIDs []int64, viewID int64) ([]*vikunja.Bucket, error) {
+	byID := make(map[int64]*vikunja.Bucket, len(buckets))
+	for _, b := range buckets {
+		byID[b.ID] = b
+	}
+
+	ordered := make([]*vikunja.Bucket, 0, len(buckets))
+	requested := make(map[int64]bool, len(bucketIDs))
+	for _, id := range bucketIDs {
+		b, ok := byID[id]
+		if !ok {
+			return nil, enhancedBucketIDNotFoundError(id, viewID, bucketLabels(buckets))
+		}
+		requested[id] = true
+		ordered = append(ordered, b)
+	}
+
+	rest := make([]*vikunja.Bucket, 0, len(buckets)-len(ordered))
+	for _, b := range buckets {
+		if !requested[b.ID] {
+			rest = append(rest, b)
+		}
+	}
+	sort.SliceStable(rest, func(i, j int) bool { return rest[i].Position < rest[j].Position })
+	return append(ordered, rest...), nil
+}
+
+// moveBuckets gives the buckets evenly-spaced positions in their order, running a bounded number of
+// updates at once, and returns copies of the buckets at their new positions
+func moveBuckets(ctx context.Context, client *vikunja.Client, projectID, viewID int64, buckets []*vikunja.Bucket) ([]*vikunja.Bucket, error) {
+	moved := make([]*vikunja.Bucket, len(buckets))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentBucketUpdates)
+	for i, b := range buckets {
+		position := float64(i+1) * bucketPositionSpacing
+		g.Go(func() error {
+			return client.SetBucketPosition(gctx, projectID, viewID, b.ID, position)
+		})
+		bucket := *b
+		bucket.Position = position
+		moved[i] = &bucket
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return moved, nil
+}
+
+func bucketLabels(buckets []*vikunja.Bucket) []string {
+	labels := make([]string, len(buckets))
+	for i, b := range buckets {
+		labels[i] = fmt.Sprintf("%s (%d)", b.Title, b.ID)
+	}
+	return labels
+}
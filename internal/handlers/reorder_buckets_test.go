@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBuckets() []*vikunja.Bucket {
+	return []*vikunja.Bucket{
+		{ID: 1, Title: "Todo", Position: 10},
+		{ID: 2, Title: "Doing", Position: 20},
+		{ID: 3, Title: "Review", Position: 30},
+		{ID: 4, Title: "Done", Position: 40},
+	}
+}
+
+func TestOrderBuckets(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		bucketIDs []int64
+		wantOrder []int64
+	}{
+		{"full reorder", []int64{4, 3, 2, 1}, []int64{4, 3, 2, 1}},
+		{"partial reorder keeps rest in place", []int64{3}, []int64{3, 1, 2, 4}},
+		{"unchanged", []int64{1, 2, 3, 4}, []int64{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			buckets := testBuckets()
+			ordered, err := orderBuckets(buckets, tt.bucketIDs, 5)
+			require.NoError(t, err)
+			require.Len(t, ordered, len(tt.wantOrder))
+			for i, b := range ordered {
+				assert.Equal(t, tt.wantOrder[i], b.ID)
+			}
+		})
+	}
+}
+
+func TestOrderBuckets_UnknownBucket(t *testing.T) {
+	t.Parallel()
+	_, err := orderBuckets(testBuckets(), []int64{2, 99}, 5)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bucket with ID 99 not found in view 5")
+	assert.Contains(t, err.Error(), "Todo (1), Doing (2)")
+}
+
+func TestParseReorderBucketsInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   ReorderBucketsInput
+		wantErr string
+	}{
+		{"valid", ReorderBucketsInput{ProjectID: "1", ViewID: "2", BucketIDs: []string{"3", "4"}}, ""},
+		{"no buckets", ReorderBucketsInput{ProjectID: "1", ViewID: "2"}, "bucket_ids: at least one bucket ID is required"},
+		{"bad bucket", ReorderBucketsInput{ProjectID: "1", ViewID: "2", BucketIDs: []string{"3", "x"}}, "bucket_ids[1]: must be a valid integer"},
+		{"duplicate bucket", ReorderBucketsInput{ProjectID: "1", ViewID: "2", BucketIDs: []string{"3", "3"}}, "bucket 3 is listed more than once"},
+		{"missing view", ReorderBucketsInput{ProjectID: "1", BucketIDs: []string{"3"}}, "view_id: is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, _, _, err := parseReorderBucketsInput(tt.input)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestReorderBucketsHandler_Readonly(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{Readonly: true}, unexpectedRequest(t))
+
+	result, _, err := h.reorderBucketsHandler(t.Context(), nil, ReorderBucketsInput{ProjectID: "1", ViewID: "2", BucketIDs: []string{"3"}})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestReorderBucketsHandler_UpdatesEveryBucket(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	positions := map[string]float64{}
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `[{"id":1,"title":"Todo","position":1},{"id":2,"title":"Done","position":2}]`) //nolint:errcheck
+			return
+		}
+		var b vikunja.Bucket
+		if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		mu.Lock()
+		positions[r.URL.Path] = b.Position
+		mu.Unlock()
+		json.NewEncoder(w).Encode(b) //nolint:errcheck
+	})
+
+	_, output, err := h.reorderBucketsHandler(t.Context(), nil, ReorderBucketsInput{ProjectID: "7", ViewID: "3", BucketIDs: []string{"2", "1"}})
+	require.NoError(t, err)
+
+	assert.InDelta(t, bucketPositionSpacing, positions["/api/v1/projects/7/views/3/buckets/2"], 0)
+	assert.InDelta(t, 2*bucketPositionSpacing, positions["/api/v1/projects/7/views/3/buckets/1"], 0)
+	require.Len(t, output.Buckets, 2)
+	assert.Equal(t, int64(2), output.Buckets[0].ID)
+	assert.InDelta(t, bucketPositionSpacing, output.Buckets[0].Position, 0)
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// resolveURIHandler handles the resolve_uri tool
+func (h *Handlers) resolveURIHandler(ctx context.Context, _ *mcp.CallToolRequest, input ResolveURIInput) (*mcp.CallToolResult, ResolveURIOutput, error) {
+	if err := validateRequiredString("uri", input.URI); err != nil {
+		return h.buildErrorResult(err.Error()), ResolveURIOutput{}, err
+	}
+	ref, err := vikunja.ParseURI(input.URI)
+	if err != nil {
+		err = ValidationError{Field: "uri", Message: err.Error()}
+		return h.buildErrorResult(err.Error()), ResolveURIOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ResolveURIOutput{}, err
+	}
+
+	data, output, err := h.resolveResource(ctx, client, ref)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ResolveURIOutput{}, err
+	}
+
+	result, err := h.formatResult(data)
+	if err != nil {
+		return nil, ResolveURIOutput{}, err
+	}
+	return result, output, nil
+}
+
+// resolveResource fetches the resource ref names, returning it for formatting alongside the tool output
+func (h *Handlers) resolveResource(ctx context.Context, client *vikunja.Client, ref vikunja.ResourceRef) (any, ResolveURIOutput, error) {
+	output := ResolveURIOutput{Kind: ref.Kind}
+	switch ref.Kind {
+	case vikunja.ResourceTask:
+		task, err := client.GetTask(ctx, ref.ID)
+		if err != nil {
+			return nil, output, fmt.Errorf("failed to get task: %w", err)
+		}
+		t := toTask(task)
+		output.Task = &t
+		return task, output, nil
+	case vikunja.ResourceProject:
+		project, err := client.GetProject(ctx, ref.ID)
+		if err != nil {
+			return nil, output, fmt.Errorf("failed to get project: %w", err)
+		}
+		p := toProject(project)
+		output.Project = &p
+		return project, output, nil
+	case vikunja.ResourceView:
+		_, view, err := h.findProjectViewByID(ctx, client, strconv.FormatInt(ref.ProjectID, 10), "", ref.ID)
+		if err != nil {
+			return nil, output, err
+		}
+		v := toView(view)
+		output.View = &v
+		return view, output, nil
+	default:
+		return nil, output, fmt.Errorf("unsupported resource kind: %s", ref.Kind)
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveURIHandler(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks/123":
+			fmt.Fprint(w, `{"id":123,"title":"Ship it","project_id":1}`) //nolint:errcheck
+		case "/api/v1/projects/1":
+			fmt.Fprint(w, `{"id":1,"title":"Work"}`) //nolint:errcheck
+		case "/api/v1/projects/1/views":
+			fmt.Fprint(w, `[{"id":2,"project_id":1,"title":"Kanban","view_kind":"kanban"}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.resolveURIHandler(t.Context(), nil, ResolveURIInput{URI: "vikunja://task/123"})
+	require.NoError(t, err)
+	assert.Equal(t, vikunja.ResourceTask, output.Kind)
+	require.NotNil(t, output.Task)
+	assert.Equal(t, "Ship it", output.Task.Title)
+	assert.Nil(t, output.Project)
+
+	_, output, err = h.resolveURIHandler(t.Context(), nil, ResolveURIInput{URI: "vikunja://project/1"})
+	require.NoError(t, err)
+	require.NotNil(t, output.Project)
+	assert.Equal(t, "Work", output.Project.Title)
+
+	_, output, err = h.resolveURIHandler(t.Context(), nil, ResolveURIInput{URI: "vikunja://project/1/view/2"})
+	require.NoError(t, err)
+	assert.Equal(t, vikunja.ResourceView, output.Kind)
+	require.NotNil(t, output.View)
+	assert.Equal(t, "Kanban", output.View.Title)
+	assert.Equal(t, "vikunja://project/1/view/2", output.View.URI)
+}
+
+func TestResolveURIHandler_Malformed(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.resolveURIHandler(t.Context(), nil, ResolveURIInput{URI: "vikunja://tasks/123"})
+	var validationErr ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "uri", validationErr.Field)
+	assert.True(t, result.IsError)
+}
+
+func TestResolveURIHandler_ViewNotFound(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":2,"project_id":1,"title":"Kanban","view_kind":"kanban"}]`) //nolint:errcheck
+	})
+
+	result, _, err := h.resolveURIHandler(t.Context(), nil, ResolveURIInput{URI: "vikunja://project/1/view/9"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "view 9 not found")
+	assert.True(t, result.IsError)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// listSavedFiltersHandler handles the list_saved_filters tool
+func (h *Handlers) listSavedFiltersHandler(ctx context.Context, _ *mcp.CallToolRequest, _ ListSavedFiltersInput) (*mcp.CallToolResult, ListSavedFiltersOutput, error) {
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ListSavedFiltersOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	filters, err := client.GetSavedFilters(ctx)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ListSavedFiltersOutput{}, err
+	}
+
+	output := ListSavedFiltersOutput{Filters: filters}
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, ListSavedFiltersOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// listSavedFilterTasks answers list_tasks for a saved filter, as a single bucket of matching tasks
+func (h *Handlers) listSavedFilterTasks(ctx context.Context, client *vikunja.Client, filterID int64, fields []string) (*mcp.CallToolResult, ListTasksOutput, error) {
+	saved, tasks, err := savedFilterTasks(ctx, client, filterID, "")
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ListTasksOutput{}, err
+	}
+
+	project := &Project{
+		ID:    saved.ProjectID,
+		Title: saved.Title,
+		URI:   vikunja.ProjectURI(saved.ProjectID),
+	}
+	vt := h.buildViewTasksSummary(0, saved.Title, &vikunja.ViewTasksResponse{Tasks: tasks}, fields)
+	return h.listTasksResult(project, vt, fields)
+}
+
+// savedFilterTasks loads a saved filter and the tasks matched by its query, narrowed by extra when given
+func savedFilterTasks(ctx context.Context, client *vikunja.Client, filterID int64, extra string) (*vikunja.SavedFilter, []*vikunja.Task, error) {
+	saved, query, err := savedFilterQuery(ctx, client, filterID, extra)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tasks, err := client.GetAllTasks(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	return saved, tasks, nil
+}
+
+// savedFilterQuery loads a saved filter and returns its query narrowed by extra when given
+func savedFilterQuery(ctx context.Context, client *vikunja.Client, filterID int64, extra string) (*vikunja.SavedFilter, string, error) {
+	saved, err := client.GetSavedFilter(ctx, filterID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := saved.Filter
+	switch {
+	case query == "":
+		query = extra
+	case extra != "":
+		query = "(" + query + ") && " + extra
+	}
+	return saved, query, nil
+}
+
+// parseSavedFilterID accepts either a saved filter's own ID or the negative pseudo-project ID
+// Vikunja lists it under, and returns the saved filter ID
+func parseSavedFilterID(fieldName, value string) (int64, error) {
+	id, err := parseIDAllowingSpecial(fieldName, value)
+	if err != nil {
+		return 0, err
+	}
+	if id > 0 {
+		return id, nil
+	}
+	if filterID, ok := vikunja.SavedFilterID(id); ok {
+		return filterID, nil
+	}
+	return 0, ValidationError{Field: fieldName, Message: fmt.Sprintf("must be a saved filter ID or a saved filter project ID below -1, got: %d", id)}
+}
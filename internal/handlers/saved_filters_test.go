@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// savedFilterServer serves saved filter 4 ("Urgent") and records the task filter it was queried with
+func savedFilterServer(t *testing.T, gotFilter *string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			fmt.Fprint(w, `[{"id":-1,"title":"Favorites"},{"id":3,"title":"Inbox"},{"id":-5,"title":"Urgent"}]`) //nolint:errcheck
+		case "/api/v1/filters/4":
+			fmt.Fprint(w, `{"id":4,"title":"Urgent","filters":{"filter":"priority >= 4"}}`) //nolint:errcheck
+		case "/api/v1/tasks":
+			*gotFilter = r.URL.Query().Get("filter")
+			fmt.Fprint(w, `[{"id":8,"title":"Fire","priority":5},{"id":9,"title":"Smoke","priority":4}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestListSavedFiltersHandler(t *testing.T) {
+	t.Parallel()
+	var gotFilter string
+	h := newTestHandlers(t, nil, savedFilterServer(t, &gotFilter))
+
+	_, output, err := h.listSavedFiltersHandler(t.Context(), nil, ListSavedFiltersInput{})
+	require.NoError(t, err)
+	require.Len(t, output.Filters, 1)
+	assert.Equal(t, int64(4), output.Filters[0].ID)
+	assert.Equal(t, int64(-5), output.Filters[0].ProjectID)
+}
+
+func TestListTasksHandler_SavedFilter(t *testing.T) {
+	t.Parallel()
+	for _, filterID := range []string{"4", "-5"} {
+		t.Run(filterID, func(t *testing.T) {
+			t.Parallel()
+			var gotFilter string
+			h := newTestHandlers(t, nil, savedFilterServer(t, &gotFilter))
+
+			_, output, err := h.listTasksHandler(t.Context(), nil, ListTasksInput{FilterID: filterID})
+			require.NoError(t, err)
+
+			assert.Equal(t, "priority >= 4", gotFilter)
+			assert.Equal(t, int64(-5), output.Project.ID)
+			assert.Equal(t, "Urgent", output.View.ViewTitle)
+			require.Len(t, output.View.Buckets, 1)
+			assert.Len(t, output.View.Buckets[0].Tasks, 2)
+		})
+	}
+}
+
+func TestListTasksHandler_SavedFilterReadsEveryPage(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/filters/4":
+			fmt.Fprint(w, `{"id":4,"title":"Urgent","filters":{"filter":"priority >= 4"}}`) //nolint:errcheck
+		case r.URL.Path == "/api/v1/tasks" && r.URL.Query().Get("page") == "1":
+			tasks := make([]string, 50)
+			for i := range tasks {
+				tasks[i] = fmt.Sprintf(`{"id":%d,"title":"Fire %d","priority":5}`, 100+i, i)
+			}
+			fmt.Fprint(w, "["+strings.Join(tasks, ",")+"]") //nolint:errcheck
+		case r.URL.Path == "/api/v1/tasks" && r.URL.Query().Get("page") == "2":
+			fmt.Fprint(w, `[{"id":9,"title":"Smoke","priority":4}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+
+	_, output, err := h.listTasksHandler(t.Context(), nil, ListTasksInput{FilterID: "4"})
+	require.NoError(t, err)
+
+	require.Len(t, output.View.Buckets, 1)
+	assert.Len(t, output.View.Buckets[0].Tasks, 51, "matches past the first page are listed")
+}
+
+func TestListAllTasksHandler_SavedFilter(t *testing.T) {
+	t.Parallel()
+	done := false
+	tests := []struct {
+		name       string
+		input      ListAllTasksInput
+		wantFilter string
+	}{
+		{"saved query only", ListAllTasksInput{FilterID: "4"}, "priority >= 4"},
+		{"explicit done state", ListAllTasksInput{FilterID: "4", Done: &done}, "(priority >= 4) && done = false"},
+		{"extra filter", ListAllTasksInput{FilterID: "-5", Filter: "due_date < now"}, "(priority >= 4) && (due_date < now)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var gotFilter string
+			h := newTestHandlers(t, nil, savedFilterServer(t, &gotFilter))
+
+			_, output, err := h.listAllTasksHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFilter, gotFilter)
+			assert.Len(t, output.Tasks, 2)
+		})
+	}
+}
+
+func TestParseSavedFilterID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{value: "4", want: 4},
+		{value: "-5", want: 4},
+		{value: "-1", wantErr: true},
+		{value: "0", wantErr: true},
+		{value: "abc", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseSavedFilterID("filter_id", tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
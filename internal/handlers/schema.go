@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// inputEnums maps tool input properties that only take a fixed set of values to those values.
+// Struct tags can only describe a property, so the enums are added to the inferred schema here.
+func inputEnums() map[string][]any {
+	return map[string][]any{
+		"view_kind":                 enumValues(vikunja.ViewKinds()),
+		"bucket_configuration_mode": enumValues(vikunja.BucketConfigurationModes()),
+		"right":                     enumValues(vikunja.ShareRights()),
+		"sort":                      enumValues(projectSortOrders()),
+		"profile":                   enumValues(discoverProfiles()),
+		"clear_field":               enumValues(clearableTaskFields()),
+	}
+}
+
+// inputSchema infers the input schema of a tool from In, advertising the valid values of its
+// enumerated properties so clients can offer them as choices
+func inputSchema[In any]() *jsonschema.Schema {
+	schema, err := jsonschema.For[In](nil)
+	if err != nil {
+		// Leave the schema to mcp.AddTool, which infers it again and reports the error
+		return nil
+	}
+	for name, values := range inputEnums() {
+		if property, ok := schema.Properties[name]; ok && property.Type == "string" {
+			property.Enum = values
+		}
+	}
+	return schema
+}
+
+func enumValues(values []string) []any {
+	enum := make([]any, len(values))
+	for i, v := range values {
+		enum[i] = v
+	}
+	return enum
+}
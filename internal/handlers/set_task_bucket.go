@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Outcomes of moving a task within one kanban view
+const (
+	viewBucketMoved   = "moved"
+	viewBucketSkipped = "skipped"
+	viewBucketFailed  = "failed"
+)
+
+// setTaskBucketEverywhereHandler handles the set_task_bucket_everywhere tool
+func (h *Handlers) setTaskBucketEverywhereHandler(ctx context.Context, _ *mcp.CallToolRequest, input SetTaskBucketEverywhereInput) (*mcp.CallToolResult, SetTaskBucketEverywhereOutput, error) {
+	if h.isReadonlyFor("set_task_bucket_everywhere") {
+		return h.buildErrorResult("Operation not available in readonly mode"), SetTaskBucketEverywhereOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	var errs ValidationErrors
+	taskID := errs.parseID("task_id", input.TaskID)
+	title := strings.TrimSpace(input.BucketTitle)
+	if title == "" {
+		errs.add(ValidationError{Field: "bucket_title", Message: "is required"})
+	}
+	if err := errs.err(); err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskBucketEverywhereOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, SetTaskBucketEverywhereOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	output, err := h.setTaskBucketEverywhere(ctx, client, taskID, title)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskBucketEverywhereOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, SetTaskBucketEverywhereOutput{Planned: planned}, err
+	}
+
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, SetTaskBucketEverywhereOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// setTaskBucketEverywhere moves a task to the bucket titled title in each kanban view of its project.
+// It fails only when no kanban view has such a bucket; failures of single moves are reported per view.
+func (h *Handlers) setTaskBucketEverywhere(ctx context.Context, client *vikunja.Client, taskID int64, title string) (SetTaskBucketEverywhereOutput, error) {
+	task, err := client.GetTask(ctx, taskID)
+	if err != nil {
+		return SetTaskBucketEverywhereOutput{}, fmt.Errorf("task with ID %d not found: %w", taskID, err)
+	}
+	views, err := client.GetProjectViews(ctx, task.ProjectID)
+	if err != nil {
+		return SetTaskBucketEverywhereOutput{}, fmt.Errorf("failed to get views of project %d: %w", task.ProjectID, err)
+	}
+
+	output := SetTaskBucketEverywhereOutput{TaskID: taskID, Views: []ViewBucketMove{}}
+	for _, view := range views {
+		if view.ViewKind == vikunja.ViewKindKanban {
+			output.Views = append(output.Views, h.setTaskBucketInView(ctx, client, task, view, title))
+		}
+	}
+
+	if !slices.ContainsFunc(output.Views, func(m ViewBucketMove) bool { return m.Status != viewBucketSkipped }) {
+		return SetTaskBucketEverywhereOutput{}, fmt.Errorf("no kanban view of project %d has a bucket titled %q", task.ProjectID, title)
+	}
+	return output, nil
+}
+
+// setTaskBucketInView moves task to the bucket titled title in one kanban view, skipping views
+// without such a bucket and warning when the move exceeds the bucket's limit
+func (h *Handlers) setTaskBucketInView(ctx context.Context, client *vikunja.Client, task *vikunja.Task, view *vikunja.ProjectView, title string) ViewBucketMove {
+	move := ViewBucketMove{ViewID: view.ID, ViewTitle: view.Title}
+	buckets, err := client.GetViewBuckets(ctx, task.ProjectID, view.ID)
+	if err != nil {
+		move.Status, move.Error = viewBucketFailed, fmt.Sprintf("failed to get view buckets: %v", err)
+		return move
+	}
+	bucket, err := h.findBucket(buckets, 0, title, view.Title)
+	if err != nil {
+		move.Status, move.Error = viewBucketSkipped, err.Error()
+		return move
+	}
+
+	move.BucketID = bucket.ID
+	if move.Warning, err = checkBucketLimit(ctx, client, task.ProjectID, view.ID, bucket, task.ID, false); err != nil {
+		move.Status, move.Error = viewBucketFailed, err.Error()
+		return move
+	}
+	if _, err := client.MoveTaskToBucket(ctx, task.ProjectID, view.ID, bucket.ID, task.ID); err != nil {
+		move.Status, move.Error = viewBucketFailed, fmt.Sprintf("failed to move task: %v", err)
+		return move
+	}
+	move.Status = viewBucketMoved
+	if !client.IsDryRun() {
+		h.moves.record(task, view.ID, bucket.ID)
+	}
+	return move
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// everywhereServer serves task 42 in project 7, whose kanban view 3 has a Done bucket (12) while
+// kanban view 4 has none, next to list view 5; the paths of posted moves are recorded
+func everywhereServer(t *testing.T, moves *[]string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/42":
+			fmt.Fprint(w, `{"id":42,"title":"Ship it","project_id":7}`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":3,"title":"Kanban","view_kind":"kanban"},{"id":4,"title":"Triage","view_kind":"kanban"},{"id":5,"title":"List","view_kind":"list"}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":12,"title":"Done"}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/4/buckets":
+			fmt.Fprint(w, `[{"id":20,"title":"Backlog"},{"id":21,"title":"Doing"}]`) //nolint:errcheck
+		case r.Method == http.MethodPost:
+			*moves = append(*moves, r.URL.Path)
+			fmt.Fprint(w, `{"task_id":42,"bucket_id":12,"project_view_id":3}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestSetTaskBucketEverywhereHandler_SkipsViewsWithoutBucket(t *testing.T) {
+	t.Parallel()
+	var moves []string
+	h := newTestHandlers(t, nil, everywhereServer(t, &moves))
+
+	_, output, err := h.setTaskBucketEverywhereHandler(t.Context(), nil, SetTaskBucketEverywhereInput{TaskID: "42", BucketTitle: "Done"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/api/v1/projects/7/views/3/buckets/12/tasks"}, moves)
+	require.Len(t, output.Views, 2, "only kanban views are reported")
+	assert.Equal(t, ViewBucketMove{ViewID: 3, ViewTitle: "Kanban", BucketID: 12, Status: "moved"}, output.Views[0])
+	assert.Equal(t, int64(4), output.Views[1].ViewID)
+	assert.Equal(t, "skipped", output.Views[1].Status)
+	assert.Contains(t, output.Views[1].Error, `bucket with title "Done" not found`)
+}
+
+func TestSetTaskBucketEverywhereHandler_NoViewHasBucket(t *testing.T) {
+	t.Parallel()
+	var moves []string
+	h := newTestHandlers(t, nil, everywhereServer(t, &moves))
+
+	result, _, err := h.setTaskBucketEverywhereHandler(t.Context(), nil, SetTaskBucketEverywhereInput{TaskID: "42", BucketTitle: "Archive"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `no kanban view of project 7 has a bucket titled "Archive"`)
+	assert.True(t, result.IsError)
+	assert.Empty(t, moves)
+}
+
+func TestSetTaskBucketEverywhereHandler_Validation(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	_, _, err := h.setTaskBucketEverywhereHandler(t.Context(), nil, SetTaskBucketEverywhereInput{TaskID: "abc", BucketTitle: " "})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "task_id: must be a valid integer")
+	assert.Contains(t, err.Error(), "bucket_title: is required")
+
+	readonly := newTestHandlers(t, &config.Config{Readonly: true}, unexpectedRequest(t))
+	_, _, err = readonly.setTaskBucketEverywhereHandler(t.Context(), nil, SetTaskBucketEverywhereInput{TaskID: "42", BucketTitle: "Done"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "readonly mode")
+}
+
+func TestSetTaskBucketEverywhereHandler_WarnsPastBucketLimit(t *testing.T) {
+	t.Parallel()
+	var moves []string
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/42":
+			fmt.Fprint(w, `{"id":42,"title":"Ship it","project_id":7}`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":3,"title":"Kanban","view_kind":"kanban"}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":12,"title":"Done","limit":2}]`) //nolint:errcheck
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/tasks":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","tasks":[{"id":42,"title":"Ship it"}]},{"id":12,"title":"Done","tasks":[{"id":1,"title":"A"},{"id":2,"title":"B"}]}]`) //nolint:errcheck
+		case r.Method == http.MethodPost:
+			moves = append(moves, r.URL.Path)
+			fmt.Fprint(w, `{"task_id":42,"bucket_id":12,"project_view_id":3}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.setTaskBucketEverywhereHandler(t.Context(), nil, SetTaskBucketEverywhereInput{TaskID: "42", BucketTitle: "Done"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/api/v1/projects/7/views/3/buckets/12/tasks"}, moves, "the move is still made")
+	require.Len(t, output.Views, 1)
+	assert.Equal(t, "moved", output.Views[0].Status)
+	assert.Contains(t, output.Views[0].Warning, `bucket "Done" already holds 2 tasks and has a limit of 2`)
+}
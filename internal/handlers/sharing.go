@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// createProjectShareHandler handles the create_project_share tool
+func (h *Handlers) createProjectShareHandler(ctx context.Context, _ *mcp.CallToolRequest, input CreateProjectShareInput) (*mcp.CallToolResult, CreateProjectShareOutput, error) {
+	if h.isReadonlyFor("create_project_share") {
+		return h.buildErrorResult("Operation not available in readonly mode"), CreateProjectShareOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	projectID, err := parseID("project_id", input.ProjectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateProjectShareOutput{}, err
+	}
+	right := input.Right
+	if right == "" {
+		right = vikunja.ShareRightRead
+	}
+	if err := validateShareRight(right); err != nil {
+		return h.buildErrorResult(err.Error()), CreateProjectShareOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, CreateProjectShareOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	share, err := client.CreateLinkShare(ctx, projectID, right)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateProjectShareOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, CreateProjectShareOutput{Planned: planned}, err
+	}
+
+	output := CreateProjectShareOutput{Share: ProjectShare{
+		ID:        share.ID,
+		ProjectID: projectID,
+		Hash:      share.Hash,
+		Right:     right,
+		URL:       client.LinkShareURL(share.Hash),
+	}}
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, CreateProjectShareOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// validateShareRight checks the right is one a link share can be created with
+func validateShareRight(right string) error {
+	rights := vikunja.ShareRights()
+	for _, r := range rights {
+		if r == right {
+			return nil
+		}
+	}
+	return ValidationError{Field: "right", Message: fmt.Sprintf("must be one of: %s. Got: %s", strings.Join(rights, ", "), right)}
+}
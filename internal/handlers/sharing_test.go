@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateShareRight(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		right   string
+		wantErr bool
+	}{
+		{"read", false},
+		{"read_write", false},
+		{"admin", false},
+		{"write", true},
+		{"READ", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.right, func(t *testing.T) {
+			t.Parallel()
+			err := validateShareRight(tt.right)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "right: must be one of: read, read_write, admin")
+		})
+	}
+}
+
+func TestCreateProjectShareHandler_Readonly(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{Readonly: true}, unexpectedRequest(t))
+
+	result, _, err := h.createProjectShareHandler(t.Context(), nil, CreateProjectShareInput{ProjectID: "7"})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestCreateProjectShareHandler_DefaultsToRead(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/projects/7/shares", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":4,"hash":"abc123","permission":0}`) //nolint:errcheck
+	})
+
+	_, output, err := h.createProjectShareHandler(t.Context(), nil, CreateProjectShareInput{ProjectID: "7"})
+	require.NoError(t, err)
+	assert.Equal(t, "read", output.Share.Right)
+	assert.Equal(t, "abc123", output.Share.Hash)
+	assert.Contains(t, output.Share.URL, "/share/abc123/auth")
+}
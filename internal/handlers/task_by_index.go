@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// getTaskByIndexHandler handles the get_task_by_project_and_index tool
+func (h *Handlers) getTaskByIndexHandler(ctx context.Context, _ *mcp.CallToolRequest, input GetTaskByIndexInput) (*mcp.CallToolResult, GetTaskByIndexOutput, error) {
+	if input.Index < 1 {
+		err := ValidationError{Field: "index", Message: fmt.Sprintf("must be at least 1, got: %d", input.Index)}
+		return h.buildErrorResult(err.Error()), GetTaskByIndexOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, GetTaskByIndexOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	tasks, err := h.fetchIndexedViewTasks(ctx, client, input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetTaskByIndexOutput{}, err
+	}
+
+	task, bucket, err := taskAtIndex(tasks, input.Index)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), GetTaskByIndexOutput{}, err
+	}
+
+	output := GetTaskByIndexOutput{Task: toTask(task), Index: input.Index}
+	if bucket != nil {
+		summary := toBucketSummary(bucket)
+		output.Bucket = &summary
+	}
+
+	result, err := h.formatResult(task)
+	if err != nil {
+		return nil, GetTaskByIndexOutput{}, err
+	}
+	return result, output, nil
+}
+
+// fetchIndexedViewTasks fetches the tasks of the view, or of its bucket, the input names
+func (h *Handlers) fetchIndexedViewTasks(ctx context.Context, client *vikunja.Client, input GetTaskByIndexInput) (*vikunja.ViewTasksResponse, error) {
+	_, projectID, err := h.resolveProjectByValue(ctx, client, input.Project)
+	if err != nil {
+		return nil, err
+	}
+	view, err := h.resolveView(ctx, client, projectID, input.View)
+	if err != nil {
+		return nil, err
+	}
+	bucketID, bucketTitle, err := h.resolveBucketByValue(ctx, client, projectID, view.ID, input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	return h.getViewTasks(ctx, client, projectID, view, bucketID, bucketTitle)
+}
+
+// taskAtIndex returns the task at the 1-based index in the order list_tasks shows a view's tasks:
+// bucket after bucket when the view has buckets. The bucket holding the task is returned with it.
+func taskAtIndex(tasks *vikunja.ViewTasksResponse, index int) (*vikunja.Task, *vikunja.Bucket, error) {
+	if len(tasks.Buckets) == 0 {
+		if index <= len(tasks.Tasks) {
+			return tasks.Tasks[index-1], nil, nil
+		}
+		return nil, nil, indexOutOfRange(index, len(tasks.Tasks))
+	}
+
+	preceding := 0
+	for _, b := range tasks.Buckets {
+		if index-preceding <= len(b.Tasks) {
+			return b.Tasks[index-preceding-1], b, nil
+		}
+		preceding += len(b.Tasks)
+	}
+	return nil, nil, indexOutOfRange(index, preceding)
+}
+
+func indexOutOfRange(index, count int) error {
+	return ValidationError{Field: "index", Message: fmt.Sprintf("%d is out of range, there are %d tasks", index, count)}
+}
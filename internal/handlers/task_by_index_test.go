@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const indexedKanbanTasks = `[{"id":10,"title":"Todo","tasks":[{"id":1,"title":"One"},{"id":2,"title":"Two"}]},` +
+	`{"id":11,"title":"Doing","tasks":[]},{"id":12,"title":"Done","tasks":[{"id":3,"title":"Three"}]}]`
+
+func TestGetTaskByIndexHandler(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		bucket     string
+		index      int
+		wantTask   int64
+		wantBucket string
+	}{
+		{"first task", "", 1, 1, "Todo"},
+		{"counts across buckets", "", 3, 3, "Done"},
+		{"bucket scoped", "Done", 1, 3, "Done"},
+		{"bucket scoped by ID", "10", 2, 2, "Todo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, kanbanServer(t, indexedKanbanTasks))
+
+			_, output, err := h.getTaskByIndexHandler(t.Context(), nil, GetTaskByIndexInput{Project: "7", Bucket: tt.bucket, Index: tt.index})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTask, output.Task.ID)
+			assert.Equal(t, tt.index, output.Index)
+			require.NotNil(t, output.Bucket)
+			assert.Equal(t, tt.wantBucket, output.Bucket.Title)
+		})
+	}
+}
+
+func TestGetTaskByIndexHandler_OutOfRange(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		bucket  string
+		index   int
+		wantErr string
+	}{
+		{"past the view", "", 4, "index: 4 is out of range, there are 3 tasks"},
+		{"past the bucket", "Todo", 3, "index: 3 is out of range, there are 2 tasks"},
+		{"empty bucket", "Doing", 1, "index: 1 is out of range, there are 0 tasks"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, kanbanServer(t, indexedKanbanTasks))
+
+			result, _, err := h.getTaskByIndexHandler(t.Context(), nil, GetTaskByIndexInput{Project: "7", Bucket: tt.bucket, Index: tt.index})
+			require.Error(t, err)
+			assert.EqualError(t, err, tt.wantErr)
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestGetTaskByIndexHandler_InvalidIndex(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	_, _, err := h.getTaskByIndexHandler(t.Context(), nil, GetTaskByIndexInput{Project: "7"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "index: must be at least 1")
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// taskCountTTL bounds how long a project's task count is reused, as changes made outside this
+// server cannot invalidate it
+const taskCountTTL = 30 * time.Second
+
+// taskCountCache remembers recent open task counts by project, so repeated discovery calls do not
+// count every project again. Tools that add tasks to a project, or close them, invalidate it.
+type taskCountCache struct {
+	mu     sync.Mutex
+	counts map[int64]cachedTaskCount
+}
+
+type cachedTaskCount struct {
+	count   int64
+	counted time.Time
+}
+
+func newTaskCountCache() *taskCountCache {
+	return &taskCountCache{counts: make(map[int64]cachedTaskCount)}
+}
+
+// lookup returns the project's count, and false when there is none younger than taskCountTTL
+func (c *taskCountCache) lookup(projectID int64, now time.Time) (int64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.counts[projectID]
+	if !ok || now.Sub(cached.counted) >= taskCountTTL {
+		return 0, false
+	}
+	return cached.count, true
+}
+
+func (c *taskCountCache) store(projectID, count int64, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[projectID] = cachedTaskCount{count: count, counted: now}
+}
+
+// invalidate drops the project's count so the next lookup counts again
+func (c *taskCountCache) invalidate(projectID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.counts, projectID)
+}
+
+// invalidateAll drops every count, for changes whose projects are not all known
+func (c *taskCountCache) invalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.counts)
+}
+
+// openTaskCount returns the number of open tasks in the project, reusing a recent count
+func (h *Handlers) openTaskCount(ctx context.Context, client *vikunja.Client, projectID int64) (int64, error) {
+	if count, ok := h.taskCounts.lookup(projectID, h.deps.Now()); ok {
+		return count, nil
+	}
+	count, err := client.CountTasks(ctx, fmt.Sprintf("project = %d && done = false", projectID))
+	if err != nil {
+		return 0, err
+	}
+	h.taskCounts.store(projectID, count, h.deps.Now())
+	return count, nil
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// updateStoredTask applies change to the stored task and saves it. Vikunja replaces the whole task
+// on update, so the change is made to a freshly fetched copy to keep the other fields.
+func updateStoredTask(ctx context.Context, client *vikunja.Client, taskID int64, change func(*vikunja.Task) error) (*vikunja.Task, error) {
+	task, err := client.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(task); err != nil {
+		return nil, err
+	}
+	return client.UpdateTask(ctx, task)
+}
+
+// validatePercentDone checks that a task's progress is a fraction from 0 to 1
+func validatePercentDone(value float64) error {
+	if math.IsNaN(value) || value < 0 || value > 1 {
+		return ValidationError{Field: "percent_done", Message: fmt.Sprintf("must be between 0.0 and 1.0, got: %g", value)}
+	}
+	return nil
+}
+
+// setTaskProgressHandler handles the set_task_progress tool
+func (h *Handlers) setTaskProgressHandler(ctx context.Context, _ *mcp.CallToolRequest, input SetTaskProgressInput) (*mcp.CallToolResult, SetTaskProgressOutput, error) {
+	if h.isReadonlyFor("set_task_progress") {
+		return h.buildErrorResult("Operation not available in readonly mode"), SetTaskProgressOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	taskID, err := parseID("task_id", input.TaskID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskProgressOutput{}, err
+	}
+	if err := validatePercentDone(input.PercentDone); err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskProgressOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, SetTaskProgressOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	updated, err := updateStoredTask(ctx, client, taskID, func(task *vikunja.Task) error {
+		task.PercentDone = input.PercentDone
+		return nil
+	})
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskProgressOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, SetTaskProgressOutput{Planned: planned}, err
+	}
+
+	result, err := h.formatResult(updated)
+	if err != nil {
+		return nil, SetTaskProgressOutput{}, err
+	}
+	return result, SetTaskProgressOutput{Task: toTask(updated)}, nil
+}
+
+// setTaskDatesHandler handles the set_task_dates tool
+func (h *Handlers) setTaskDatesHandler(ctx context.Context, _ *mcp.CallToolRequest, input SetTaskDatesInput) (*mcp.CallToolResult, SetTaskDatesOutput, error) {
+	if h.isReadonlyFor("set_task_dates") {
+		return h.buildErrorResult("Operation not available in readonly mode"), SetTaskDatesOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	taskID, err := parseID("task_id", input.TaskID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskDatesOutput{}, err
+	}
+	start, end, err := parseTaskDates(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskDatesOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, SetTaskDatesOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	// A date left out keeps its stored value, so the order is checked against the stored task
+	updated, err := updateStoredTask(ctx, client, taskID, func(task *vikunja.Task) error {
+		if !start.IsZero() {
+			task.StartDate = start.Format(time.RFC3339)
+		}
+		if !end.IsZero() {
+			task.EndDate = end.Format(time.RFC3339)
+		}
+		return validateDateOrder(storedTaskDate(task.StartDate), storedTaskDate(task.EndDate))
+	})
+	if err != nil {
+		return h.buildErrorResult(err.Error()), SetTaskDatesOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, SetTaskDatesOutput{Planned: planned}, err
+	}
+
+	result, err := h.formatResult(updated)
+	if err != nil {
+		return nil, SetTaskDatesOutput{}, err
+	}
+	return result, SetTaskDatesOutput{Task: toTask(updated)}, nil
+}
+
+// parseTaskDates parses the dates of a set_task_dates call, at least one of which must be given
+func parseTaskDates(input SetTaskDatesInput) (start, end time.Time, err error) {
+	if input.StartDate == "" && input.EndDate == "" {
+		return start, end, ValidationError{Field: "start_date", Message: "either start_date or end_date must be given"}
+	}
+	if start, err = parseTaskDate("start_date", input.StartDate); err != nil {
+		return start, end, err
+	}
+	if end, err = parseTaskDate("end_date", input.EndDate); err != nil {
+		return start, end, err
+	}
+	return start, end, validateDateOrder(start, end)
+}
+
+// parseTaskDate parses a YYYY-MM-DD or RFC 3339 date. A bare date means midnight UTC.
+func parseTaskDate(fieldName, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, ValidationError{Field: fieldName, Message: fmt.Sprintf("must be a date as YYYY-MM-DD or RFC 3339, got: %q", value)}
+}
+
+// storedTaskDate parses a date as Vikunja returns it. Unset dates come back as 0001-01-01 and
+// parse to the zero time.
+func storedTaskDate(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// validateDateOrder checks that a task does not end before it starts; unset dates are not compared
+func validateDateOrder(start, end time.Time) error {
+	if start.IsZero() || end.IsZero() || !start.After(end) {
+		return nil
+	}
+	return ValidationError{Field: "end_date", Message: fmt.Sprintf("must not be before start_date (%s), got: %s", start.Format(time.RFC3339), end.Format(time.RFC3339))}
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePercentDone(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value   float64
+		wantErr bool
+	}{
+		{0, false},
+		{0.5, false},
+		{1, false},
+		{-0.1, true},
+		{1.01, true},
+		{50, true},
+		{math.NaN(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.value), func(t *testing.T) {
+			t.Parallel()
+			err := validatePercentDone(tt.value)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "percent_done: must be between 0.0 and 1.0")
+		})
+	}
+}
+
+func TestSetTaskProgressHandler(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/5":
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","project_id":1,"priority":3}`) //nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tasks/5":
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			fmt.Fprint(w, `{"id":5,"title":"Ship it","project_id":1,"priority":3,"percent_done":0.5}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.setTaskProgressHandler(t.Context(), nil, SetTaskProgressInput{TaskID: "5", PercentDone: 0.5})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 0.5, sent["percent_done"], 0.0001)
+	assert.InDelta(t, 3, sent["priority"], 0.0001, "unchanged fields must be sent back")
+	assert.InDelta(t, 0.5, output.Task.PercentDone, 0.0001)
+}
+
+func TestSetTaskProgressHandler_Rejected(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		input   SetTaskProgressInput
+		wantErr string
+	}{
+		{"readonly", &config.Config{Readonly: true}, SetTaskProgressInput{TaskID: "5", PercentDone: 0.5}, "readonly mode"},
+		{"out of range", nil, SetTaskProgressInput{TaskID: "5", PercentDone: 1.5}, "percent_done: must be between 0.0 and 1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, tt.cfg, unexpectedRequest(t))
+
+			result, _, err := h.setTaskProgressHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+// scheduledTaskServer serves task 5, stored with end, and records the task sent back on update
+func scheduledTaskServer(t *testing.T, end string, sent *map[string]any) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks/5":
+			fmt.Fprintf(w, `{"id":5,"title":"Ship it","start_date":"0001-01-01T00:00:00Z","end_date":%q}`, end) //nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tasks/5":
+			if err := json.NewDecoder(r.Body).Decode(sent); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			json.NewEncoder(w).Encode(*sent) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestSetTaskDatesHandler(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	h := newTestHandlers(t, nil, scheduledTaskServer(t, "2026-03-20T00:00:00Z", &sent))
+
+	_, output, err := h.setTaskDatesHandler(t.Context(), nil, SetTaskDatesInput{TaskID: "5", StartDate: "2026-03-10"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "2026-03-10T00:00:00Z", sent["start_date"])
+	assert.Equal(t, "2026-03-20T00:00:00Z", sent["end_date"], "the stored end date must be kept")
+	assert.Equal(t, "2026-03-10T00:00:00Z", output.Task.StartDate)
+}
+
+func TestSetTaskDatesHandler_StartAfterEnd(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		storedEnd string
+		input     SetTaskDatesInput
+	}{
+		{
+			name:      "both given",
+			storedEnd: "",
+			input:     SetTaskDatesInput{TaskID: "5", StartDate: "2026-03-12", EndDate: "2026-03-10T17:00:00Z"},
+		},
+		{
+			name:      "start after stored end",
+			storedEnd: "2026-03-01T00:00:00Z",
+			input:     SetTaskDatesInput{TaskID: "5", StartDate: "2026-03-12"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var sent map[string]any
+			server := unexpectedRequest(t)
+			if tt.storedEnd != "" {
+				server = scheduledTaskServer(t, tt.storedEnd, &sent)
+			}
+			h := newTestHandlers(t, nil, server)
+
+			result, _, err := h.setTaskDatesHandler(t.Context(), nil, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "end_date: must not be before start_date")
+			assert.True(t, result.IsError)
+			assert.Nil(t, sent, "the task must not be updated")
+		})
+	}
+}
+
+func TestParseTaskDates_Invalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   SetTaskDatesInput
+		wantErr string
+	}{
+		{"no dates", SetTaskDatesInput{TaskID: "5"}, "either start_date or end_date must be given"},
+		{"malformed start", SetTaskDatesInput{TaskID: "5", StartDate: "10/03/2026"}, "start_date: must be a date"},
+		{"malformed end", SetTaskDatesInput{TaskID: "5", EndDate: "tomorrow"}, "end_date: must be a date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, _, err := parseTaskDates(tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -3,42 +3,72 @@ package handlers
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"slices"
 
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // listTasksHandler handles the list_tasks tool
 func (h *Handlers) listTasksHandler(ctx context.Context, _ *mcp.CallToolRequest, input ListTasksInput) (*mcp.CallToolResult, ListTasksOutput, error) {
-	client, err := createVikunjaClient()
+	fields, filterID, err := parseListTasksInput(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ListTasksOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
 	if err != nil {
 		return nil, ListTasksOutput{}, err
 	}
 
+	if filterID != 0 {
+		return h.listSavedFilterTasks(ctx, client, filterID, fields)
+	}
+
 	project, targetProjectID, err := h.resolveProjectByValue(ctx, client, input.Project)
 	if err != nil {
 		return h.buildErrorResult(err.Error()), ListTasksOutput{}, err
 	}
 
-	targetViewID, targetViewTitle, err := h.resolveViewByValue(ctx, client, targetProjectID, input.View)
+	targetView, err := h.resolveView(ctx, client, targetProjectID, input.View)
 	if err != nil {
 		return h.buildErrorResult(err.Error()), ListTasksOutput{}, err
 	}
 
-	targetBucketID, targetBucketTitle, err := h.resolveBucketByValue(ctx, client, targetProjectID, targetViewID, input.Bucket)
+	targetBucketID, targetBucketTitle, err := h.resolveBucketByValue(ctx, client, targetProjectID, targetView.ID, input.Bucket)
 	if err != nil {
 		return h.buildErrorResult(err.Error()), ListTasksOutput{}, err
 	}
 
-	viewTasksResp, err := h.getViewTasks(ctx, client, targetProjectID, targetViewID, targetBucketID, targetBucketTitle, targetViewTitle)
+	viewTasksResp, err := h.getViewTasks(ctx, client, targetProjectID, targetView, targetBucketID, targetBucketTitle)
 	if err != nil {
 		return h.buildErrorResult(err.Error()), ListTasksOutput{}, err
 	}
 
-	vt := h.buildViewTasksSummary(targetViewID, targetViewTitle, viewTasksResp)
+	vt := h.buildViewTasksSummary(targetView.ID, targetView.Title, viewTasksResp, fields)
+	return h.listTasksResult(project, vt, fields)
+}
 
-	vikunjaVT := h.convertToVikunjaViewTasksSummary(vt)
+// parseListTasksInput parses the field selection and, when given, the saved filter ID
+func parseListTasksInput(input ListTasksInput) (fields []string, filterID int64, err error) {
+	var errs ValidationErrors
+	fields, err = parseTaskFields(input.Fields)
+	errs.add(err)
+	if input.FilterID != "" {
+		filterID, err = parseSavedFilterID("filter_id", input.FilterID)
+		errs.add(err)
+	}
+	if err := errs.err(); err != nil {
+		return nil, 0, err
+	}
+	return fields, filterID, nil
+}
+
+// listTasksResult formats the list_tasks response for a view's tasks. Task URIs only appear in
+// the text when the caller selected them.
+func (h *Handlers) listTasksResult(project *Project, vt ViewTasksSummary, fields []string) (*mcp.CallToolResult, ListTasksOutput, error) {
+	vikunjaVT := h.convertToVikunjaViewTasksSummary(vt, slices.Contains(fields, "uri"))
 
 	data, err := h.deps.OutputFormatter.Format(vikunjaVT)
 	if err != nil {
@@ -55,80 +85,29 @@ func (h *Handlers) listTasksHandler(ctx context.Context, _ *mcp.CallToolRequest,
 		}, nil
 }
 
-// resolveProjectByValue resolves project from ID (integer string) or title
+// resolveProjectByValue resolves project from ID (integer string) or title, falling back to the
+// default project when value is empty
 func (h *Handlers) resolveProjectByValue(ctx context.Context, client *vikunja.Client, value string) (*Project, int64, error) {
 	if value == "" {
-		return h.findProjectByTitle(ctx, client, "Inbox")
-	}
-
-	if id, err := strconv.ParseInt(value, 10, 64); err == nil && id > 0 {
-		project, err := client.GetProject(ctx, id)
+		project, err := h.defaultProject(ctx, client)
 		if err != nil {
-			return nil, 0, fmt.Errorf("project with ID %d not found: %w", id, err)
+			return nil, 0, err
 		}
-		return &Project{
-			ID:    project.ID,
-			Title: project.Title,
-			URI:   fmt.Sprintf("vikunja://project/%d", project.ID),
-		}, id, nil
+		return project, project.ID, nil
 	}
 
-	return h.findProjectByTitle(ctx, client, value)
-}
-
-// findProjectByTitle finds a project by its title
-func (h *Handlers) findProjectByTitle(ctx context.Context, client *vikunja.Client, projectTitle string) (*Project, int64, error) {
-	projects, err := client.GetProjects(ctx)
+	project, err := h.resolver(client).Project(ctx, value)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list projects: %w", err)
+		return nil, 0, err
 	}
-
-	for _, p := range projects {
-		if p.Title == projectTitle {
-			project := &Project{
-				ID:    p.ID,
-				Title: p.Title,
-				URI:   fmt.Sprintf("vikunja://project/%d", p.ID),
-			}
-			return project, p.ID, nil
-		}
-	}
-
-	return nil, 0, fmt.Errorf("project with title %q not found", projectTitle)
+	p := toProject(project)
+	return &p, p.ID, nil
 }
 
-// resolveViewByValue resolves view from ID (integer string) or title
-func (h *Handlers) resolveViewByValue(ctx context.Context, client *vikunja.Client, projectID int64, value string) (viewID int64, viewTitle string, err error) {
-	views, err := client.GetProjectViews(ctx, projectID)
-	if err != nil {
-		return 0, "", fmt.Errorf("failed to get project views: %w", err)
-	}
-
-	if value == "" {
-		return h.resolveViewByTitle("Kanban", views, projectID)
-	}
-
-	if id, err := strconv.ParseInt(value, 10, 64); err == nil && id > 0 {
-		for _, v := range views {
-			if v.ID == id {
-				return id, v.Title, nil
-			}
-		}
-		return 0, "", fmt.Errorf("view with ID %d not found in project %d", id, projectID)
-	}
-
-	return h.resolveViewByTitle(value, views, projectID)
-}
-
-// resolveViewByTitle resolves view by title
-func (h *Handlers) resolveViewByTitle(viewTitle string, views []*vikunja.ProjectView, targetProjectID int64) (viewID int64, title string, err error) {
-	for _, v := range views {
-		if v.Title == viewTitle {
-			return v.ID, v.Title, nil
-		}
-	}
-
-	return 0, "", fmt.Errorf("view with title %q not found in project %d", viewTitle, targetProjectID)
+// resolveView resolves a view from ID (integer string) or title, falling back as configured
+// when the default Kanban view is missing
+func (h *Handlers) resolveView(ctx context.Context, client *vikunja.Client, projectID int64, value string) (*vikunja.ProjectView, error) {
+	return h.resolver(client).View(ctx, projectID, value)
 }
 
 // resolveBucketByValue resolves bucket from ID (integer string) or title
@@ -137,51 +116,30 @@ func (h *Handlers) resolveBucketByValue(ctx context.Context, client *vikunja.Cli
 		return 0, "", nil
 	}
 
-	buckets, err := client.GetViewBuckets(ctx, projectID, viewID)
+	bucket, err := resolution.ResolveBucket(ctx, client, projectID, viewID, value)
 	if err != nil {
-		return 0, "", fmt.Errorf("failed to get view buckets: %w", err)
-	}
-
-	return h.findBucketByIDOrTitle(buckets, value, viewID)
-}
-
-func (h *Handlers) findBucketByIDOrTitle(buckets []*vikunja.Bucket, value string, viewID int64) (bucketID int64, bucketTitle string, err error) {
-	if id, err := strconv.ParseInt(value, 10, 64); err == nil && id > 0 {
-		for _, b := range buckets {
-			if b.ID == id {
-				return id, b.Title, nil
-			}
-		}
-		return 0, "", fmt.Errorf("bucket with ID %d not found in view %d", id, viewID)
-	}
-
-	for _, b := range buckets {
-		if b.Title == value {
-			return b.ID, b.Title, nil
-		}
+		return 0, "", err
 	}
-	return 0, "", fmt.Errorf("bucket with title %q not found in view %d", value, viewID)
+	return bucket.ID, bucket.Title, nil
 }
 
 // getViewTasks gets view tasks with optional bucket filtering
-func (h *Handlers) getViewTasks(ctx context.Context, client *vikunja.Client, targetProjectID, targetViewID, targetBucketID int64, targetBucketTitle, targetViewTitle string) (*vikunja.ViewTasksResponse, error) {
-	buckets, err := client.GetViewBuckets(ctx, targetProjectID, targetViewID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get view buckets: %w", err)
-	}
-
-	tasks, err := client.GetViewTasks(ctx, targetProjectID, targetViewID)
+func (h *Handlers) getViewTasks(ctx context.Context, client *vikunja.Client, targetProjectID int64, targetView *vikunja.ProjectView, targetBucketID int64, targetBucketTitle string) (*vikunja.ViewTasksResponse, error) {
+	response, err := client.GetViewTasks(ctx, targetProjectID, targetView.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get view tasks: %w", err)
 	}
 
-	response := &vikunja.ViewTasksResponse{
-		Buckets: buckets,
-		Tasks:   tasks,
+	// Vikunja answers fresh kanban boards with a flat empty list, so ask for the buckets themselves
+	if len(response.Buckets) == 0 && len(response.Tasks) == 0 && targetView.ViewKind == string(vikunja.ViewKindKanban) {
+		response.Buckets, err = client.GetViewBuckets(ctx, targetProjectID, targetView.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get view buckets: %w", err)
+		}
 	}
 
 	if targetBucketID != 0 || targetBucketTitle != "" {
-		return h.filterViewTasksByBucket(response, buckets, targetBucketID, targetBucketTitle, targetViewTitle)
+		return h.filterViewTasksByBucket(response, response.Buckets, targetBucketID, targetBucketTitle, targetView.Title)
 	}
 
 	return response, nil
@@ -226,7 +184,7 @@ func (h *Handlers) findBucket(buckets []*vikunja.Bucket, targetBucketID int64, t
 }
 
 // buildViewTasksSummary builds the view tasks summary
-func (h *Handlers) buildViewTasksSummary(targetViewID int64, targetViewTitle string, viewTasksResp *vikunja.ViewTasksResponse) ViewTasksSummary {
+func (h *Handlers) buildViewTasksSummary(targetViewID int64, targetViewTitle string, viewTasksResp *vikunja.ViewTasksResponse, fields []string) ViewTasksSummary {
 	vt := ViewTasksSummary{
 		ViewID:    targetViewID,
 		ViewTitle: targetViewTitle,
@@ -238,13 +196,13 @@ func (h *Handlers) buildViewTasksSummary(targetViewID int64, targetViewTitle str
 			vikunjaBucket := b // Explicitly use vikunja.Bucket type
 			vt.Buckets = append(vt.Buckets, BucketTasksSummary{
 				Bucket: toBucketSummary(vikunjaBucket),
-				Tasks:  toTasksSummary(vikunjaBucket.Tasks),
+				Tasks:  toSelectedTasksSummary(vikunjaBucket.Tasks, fields),
 			})
 		}
 	} else {
 		vt.Buckets = append(vt.Buckets, BucketTasksSummary{
 			Bucket: BucketSummary{ID: 0, Title: "All Tasks"},
-			Tasks:  toTasksSummary(viewTasksResp.Tasks),
+			Tasks:  toSelectedTasksSummary(viewTasksResp.Tasks, fields),
 		})
 	}
 
@@ -252,7 +210,7 @@ func (h *Handlers) buildViewTasksSummary(targetViewID int64, targetViewTitle str
 }
 
 // convertToVikunjaViewTasksSummary converts handlers ViewTasksSummary to vikunja.ViewTasksSummary
-func (h *Handlers) convertToVikunjaViewTasksSummary(vt ViewTasksSummary) vikunja.ViewTasksSummary {
+func (h *Handlers) convertToVikunjaViewTasksSummary(vt ViewTasksSummary, withURI bool) vikunja.ViewTasksSummary {
 	vikunjaVT := vikunja.ViewTasksSummary{
 		ViewID:    vt.ViewID,
 		ViewTitle: vt.ViewTitle,
@@ -267,10 +225,11 @@ func (h *Handlers) convertToVikunjaViewTasksSummary(vt ViewTasksSummary) vikunja
 			Tasks: make([]vikunja.TaskSummary, len(bucket.Tasks)),
 		}
 		for j, task := range bucket.Tasks {
-			vikunjaVT.Buckets[i].Tasks[j] = vikunja.TaskSummary{
-				ID:    task.ID,
-				Title: task.Title,
+			summary := vikunja.TaskSummary(task)
+			if !withURI {
+				summary.URI = ""
 			}
+			vikunjaVT.Buckets[i].Tasks[j] = summary
 		}
 	}
 	return vikunjaVT
@@ -285,3 +244,16 @@ func (h *Handlers) buildErrorResult(message string) *mcp.CallToolResult {
 		},
 	}
 }
+
+// formatResult formats data as the text content of a tool result
+func (h *Handlers) formatResult(data any) (*mcp.CallToolResult, error) {
+	text, err := h.deps.OutputFormatter.Format(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to format response: %w", err)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: text},
+		},
+	}, nil
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/resolution"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// kanbanServer serves project 7 with kanban view 3; tasksBody is the view task listing
+func kanbanServer(t *testing.T, tasksBody string) http.HandlerFunc {
+	t.Helper()
+	return viewServer(t, "kanban", tasksBody)
+}
+
+// viewServer serves project 7 with view 3 of the given kind; tasksBody is the view task listing.
+// Only kanban views have buckets.
+func viewServer(t *testing.T, viewKind, tasksBody string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/7":
+			fmt.Fprint(w, `{"id":7,"title":"Board"}`) //nolint:errcheck
+		case "/api/v1/projects/7/views":
+			fmt.Fprintf(w, `[{"id":3,"title":"Kanban","project_id":7,"view_kind":%q}]`, viewKind) //nolint:errcheck
+		case "/api/v1/projects/7/views/3/tasks":
+			fmt.Fprint(w, tasksBody) //nolint:errcheck
+		case "/api/v1/projects/7/views/3/buckets":
+			if viewKind != "kanban" {
+				t.Errorf("buckets requested for a %s view", viewKind)
+			}
+			fmt.Fprint(w, `[{"id":10,"title":"Todo"},{"id":11,"title":"Doing"},{"id":12,"title":"Done"}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestListTasksHandler_Buckets(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		viewKind    string
+		tasksBody   string
+		wantBuckets []string
+		wantTasks   []int
+	}{
+		{
+			name:        "buckets with tasks",
+			viewKind:    "kanban",
+			tasksBody:   `[{"id":10,"title":"Todo","tasks":[{"id":1,"title":"One"}]},{"id":12,"title":"Done","tasks":[]}]`,
+			wantBuckets: []string{"Todo", "Done"},
+			wantTasks:   []int{1, 0},
+		},
+		{
+			name:        "empty kanban returned as flat list",
+			viewKind:    "kanban",
+			tasksBody:   `[]`,
+			wantBuckets: []string{"Todo", "Doing", "Done"},
+			wantTasks:   []int{0, 0, 0},
+		},
+		{
+			name:        "empty list view",
+			viewKind:    "list",
+			tasksBody:   `[]`,
+			wantBuckets: []string{"All Tasks"},
+			wantTasks:   []int{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, viewServer(t, tt.viewKind, tt.tasksBody))
+
+			_, output, err := h.listTasksHandler(t.Context(), nil, ListTasksInput{Project: "7", View: "3"})
+			require.NoError(t, err)
+
+			require.Len(t, output.View.Buckets, len(tt.wantBuckets))
+			for i, b := range output.View.Buckets {
+				assert.Equal(t, tt.wantBuckets[i], b.Bucket.Title)
+				assert.Len(t, b.Tasks, tt.wantTasks[i])
+			}
+		})
+	}
+}
+
+// listViewServer serves project 7 with only a list view 4 holding a single task
+func listViewServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/7":
+			fmt.Fprint(w, `{"id":7,"title":"Board"}`) //nolint:errcheck
+		case "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":4,"title":"List","project_id":7,"view_kind":"list"},{"id":5,"title":"Table","project_id":7,"view_kind":"table"}]`) //nolint:errcheck
+		case "/api/v1/projects/7/views/4/tasks", "/api/v1/projects/7/views/5/tasks":
+			fmt.Fprint(w, `[{"id":1,"title":"One"}]`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestListTasksHandler_ViewFallback(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		fallback *resolution.ViewFallback
+		wantView string
+		wantErr  string
+	}{
+		{name: "strict by default", wantErr: `view with title "Kanban" not found in project 7`},
+		{name: "first view", fallback: &resolution.ViewFallback{}, wantView: "List"},
+		{name: "preferred kind order", fallback: &resolution.ViewFallback{Kinds: []string{"kanban", "table", "list"}}, wantView: "Table"},
+		{name: "no preferred kind present", fallback: &resolution.ViewFallback{Kinds: []string{"gantt"}}, wantErr: `view with title "Kanban" not found`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, &config.Config{ViewFallback: tt.fallback}, listViewServer(t))
+
+			_, output, err := h.listTasksHandler(t.Context(), nil, ListTasksInput{Project: "7"})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantView, output.View.ViewTitle)
+		})
+	}
+}
+
+func TestListTasksHandler_ViewFallbackKeepsExplicitView(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{ViewFallback: &resolution.ViewFallback{}}, listViewServer(t))
+
+	_, _, err := h.listTasksHandler(t.Context(), nil, ListTasksInput{Project: "7", View: "Kanban"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `view with title "Kanban" not found`)
+}
+
+func TestListTasksHandler_Fields(t *testing.T) {
+	t.Parallel()
+	tasksBody := `[{"id":10,"title":"Todo","tasks":[
+		{"id":1,"title":"One","description":"Secret","priority":3,"due_date":"2026-11-01T00:00:00Z"},
+		{"id":2,"title":"Two","due_date":"0001-01-01T00:00:00Z"}]}]`
+	h := newTestHandlers(t, nil, kanbanServer(t, tasksBody))
+
+	result, output, err := h.listTasksHandler(t.Context(), nil, ListTasksInput{Project: "7", View: "3", Fields: "id, due_date"})
+	require.NoError(t, err)
+
+	require.Len(t, output.View.Buckets, 1)
+	assert.Equal(t, []TaskSummary{
+		{ID: 1, DueDate: "2026-11-01T00:00:00Z"},
+		{ID: 2},
+	}, output.View.Buckets[0].Tasks)
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.Contains(t, text, `"due_date": "2026-11-01T00:00:00Z"`)
+	assert.NotContains(t, text, "title\": \"One")
+	assert.NotContains(t, text, "Secret")
+	assert.NotContains(t, text, "priority")
+}
+
+func TestListTasksHandler_UnknownField(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.listTasksHandler(t.Context(), nil, ListTasksInput{Fields: "id,owner"})
+	require.Error(t, err)
+	var validationErr ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "fields", validationErr.Field)
+	assert.Contains(t, err.Error(), `"owner"`)
+	assert.True(t, result.IsError)
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"os"
+)
+
+// ReloadToken reads the Vikunja API token again and, when it changed, drops the client built with
+// the old one so the next tool call connects with the new token. An injected client is kept.
+func (h *Handlers) ReloadToken() (bool, error) {
+	if h.deps.Config == nil {
+		return false, nil
+	}
+	h.clientMu.Lock()
+	defer h.clientMu.Unlock()
+	changed, err := h.deps.Config.Vikunja.ReloadToken()
+	if err != nil || !changed {
+		return false, err
+	}
+	h.client = nil
+	return true, nil
+}
+
+// ReloadTokenOn reloads the API token each time a signal arrives on signals, such as SIGHUP, until
+// ctx is done. A token which cannot be read is logged and the current one kept.
+func (h *Handlers) ReloadTokenOn(ctx context.Context, signals <-chan os.Signal) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig := <-signals:
+			changed, err := h.ReloadToken()
+			if err != nil {
+				h.deps.Logger.Error("failed to reload Vikunja token", "signal", sig.String(), "error", err)
+				continue
+			}
+			h.deps.Logger.Info("reloaded Vikunja token", "signal", sig.String(), "changed", changed)
+		}
+	}
+}
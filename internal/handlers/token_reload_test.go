@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenRecorder serves task 5 and remembers the API token of every request
+type tokenRecorder struct {
+	mu     sync.Mutex
+	tokens []string
+}
+
+func (r *tokenRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	r.tokens = append(r.tokens, req.Header.Get("Authorization"))
+	r.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, `{"id":5,"title":"Write report"}`) //nolint:errcheck
+}
+
+func (r *tokenRecorder) last() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.tokens[len(r.tokens)-1]
+}
+
+func TestReloadTokenOn_UsesRotatedTokenFile(t *testing.T) {
+	recorder := &tokenRecorder{}
+	ts := httptest.NewServer(recorder)
+	t.Cleanup(ts.Close)
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("old-token\n"), 0o600))
+	t.Setenv("VIKUNJA_TOKEN", "")
+	t.Setenv("VIKUNJA_TOKEN_FILE", path)
+
+	h := NewHandlers(&HandlerDependencies{
+		OutputFormatter: vikunja.NewJSONFormatter(),
+		Config:          &config.Config{Vikunja: config.VikunjaConfig{Host: ts.URL, Token: "old-token"}},
+		Logger:          slog.New(slog.DiscardHandler),
+	})
+	hangups := make(chan os.Signal)
+	go h.ReloadTokenOn(t.Context(), hangups)
+
+	_, _, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer old-token", recorder.last())
+
+	require.NoError(t, os.WriteFile(path, []byte("new-token\n"), 0o600))
+	hangups <- syscall.SIGHUP
+	hangups <- syscall.SIGHUP // returns once the first hangup has been handled
+
+	_, _, err = h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer new-token", recorder.last())
+}
+
+func TestWithAPIErrorHints_ReloadsRejectedToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("new-token"), 0o600))
+	t.Setenv("VIKUNJA_TOKEN", "")
+	t.Setenv("VIKUNJA_TOKEN_FILE", path)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"invalid token"}`) //nolint:errcheck
+			return
+		}
+		fmt.Fprint(w, `{"id":5,"title":"Write report"}`) //nolint:errcheck
+	}))
+	t.Cleanup(ts.Close)
+
+	h := NewHandlers(&HandlerDependencies{
+		OutputFormatter: vikunja.NewJSONFormatter(),
+		Config:          &config.Config{Vikunja: config.VikunjaConfig{Host: ts.URL, Token: "old-token"}},
+		Logger:          slog.New(slog.DiscardHandler),
+	})
+	getTask := withAPIErrorHints(h, h.getTaskHandler)
+
+	_, _, err := getTask(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	require.ErrorIs(t, err, vikunja.ErrUnauthorized)
+	assert.Contains(t, err.Error(), "a rotated token has been loaded")
+
+	_, _, err = getTask(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+)
+
+// toolSelection decides which tools Register adds to the MCP server, remembering every tool offered
+// so names in the configuration matching no tool can be reported
+type toolSelection struct {
+	enabled  []string
+	disabled []string
+	known    []string
+}
+
+func newToolSelection(cfg *config.Config) *toolSelection {
+	if cfg == nil {
+		return &toolSelection{}
+	}
+	return &toolSelection{enabled: cfg.EnabledTools, disabled: cfg.DisabledTools}
+}
+
+// allows records the named tool and reports whether it should be registered: it must be on the
+// enabled list when there is one, and must not be on the disabled list
+func (t *toolSelection) allows(name string) bool {
+	t.known = append(t.known, name)
+	if len(t.enabled) > 0 && !slices.Contains(t.enabled, name) {
+		return false
+	}
+	return !slices.Contains(t.disabled, name)
+}
+
+// validate returns an error naming the configured tools which match none of the tools offered
+func (t *toolSelection) validate() error {
+	var errs ValidationErrors
+	t.checkKnown(&errs, "MCP_ENABLED_TOOLS", t.enabled)
+	t.checkKnown(&errs, "MCP_DISABLED_TOOLS", t.disabled)
+	return errs.err()
+}
+
+func (t *toolSelection) checkKnown(errs *ValidationErrors, field string, names []string) {
+	var unknown []string
+	for _, name := range names {
+		if !slices.Contains(t.known, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		errs.add(ValidationError{Field: field, Message: "unknown tools: " + strings.Join(unknown, ", ")})
+	}
+}
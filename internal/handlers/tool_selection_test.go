@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connectRegistered registers the tools cfg selects and returns a client session connected to them
+func connectRegistered(t *testing.T, cfg *config.Config) *mcp.ClientSession {
+	t.Helper()
+	s := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.0"}, nil)
+	require.NoError(t, Register(s, cfg))
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	_, err := s.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	client := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "0.0.0"}, nil)
+	session, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
+
+func toolNames(t *testing.T, session *mcp.ClientSession) []string {
+	t.Helper()
+	tools, err := session.ListTools(t.Context(), nil)
+	require.NoError(t, err)
+	names := make([]string, len(tools.Tools))
+	for i, tool := range tools.Tools {
+		names[i] = tool.Name
+	}
+	return names
+}
+
+func TestRegister_DisabledTools(t *testing.T) {
+	t.Parallel()
+	session := connectRegistered(t, &config.Config{
+		OutputFormat:  vikunja.OutputFormatJSON,
+		DisabledTools: []string{"import_project", "delete_webhook"},
+	})
+
+	names := toolNames(t, session)
+	assert.Contains(t, names, "list_tasks")
+	assert.NotContains(t, names, "import_project")
+	assert.NotContains(t, names, "delete_webhook")
+}
+
+func TestRegister_EnabledToolsRespectReadonly(t *testing.T) {
+	t.Parallel()
+	session := connectRegistered(t, &config.Config{
+		OutputFormat:  vikunja.OutputFormatJSON,
+		Readonly:      true,
+		EnabledTools:  []string{"list_tasks", "create_task", "delete_webhook"},
+		DisabledTools: []string{"delete_webhook"},
+	})
+
+	assert.ElementsMatch(t, []string{"list_tasks", "create_task"}, toolNames(t, session))
+
+	result, err := session.CallTool(t.Context(), &mcp.CallToolParams{
+		Name:      "create_task",
+		Arguments: map[string]any{"title": "Blocked"},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.IsError, "enabling a mutating tool must not bypass readonly mode")
+}
+
+func TestRegister_UnknownToolNames(t *testing.T) {
+	t.Parallel()
+	s := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.0"}, nil)
+
+	err := Register(s, &config.Config{
+		OutputFormat:  vikunja.OutputFormatJSON,
+		EnabledTools:  []string{"list_tasks", "list_taks"},
+		DisabledTools: []string{"drop_everything"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "MCP_ENABLED_TOOLS: unknown tools: list_taks")
+	assert.Contains(t, err.Error(), "MCP_DISABLED_TOOLS: unknown tools: drop_everything")
+}
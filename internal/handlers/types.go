@@ -8,16 +8,26 @@ import (
 
 // ListTasksInput defines input for listing tasks.
 type ListTasksInput struct {
-	Project string `json:"project,omitempty" jsonschema:"Optional project ID (integer) or title (string). Defaults to 'Inbox'"`
-	View    string `json:"view,omitempty" jsonschema:"Optional view ID (integer) or title (string). Defaults to 'Kanban'"`
-	Bucket  string `json:"bucket,omitempty" jsonschema:"Optional bucket ID (integer) or title (string)"`
+	Project  string `json:"project,omitempty" jsonschema:"Optional project ID (integer) or title (string). Defaults to the account's default project"`
+	View     string `json:"view,omitempty" jsonschema:"Optional view ID (integer) or title (string). Defaults to 'Kanban'"`
+	Bucket   string `json:"bucket,omitempty" jsonschema:"Optional bucket ID (integer) or title (string)"`
+	FilterID string `json:"filter_id,omitempty" jsonschema:"Optional saved filter ID, or its negative pseudo-project ID. When set, project, view and bucket are ignored"`
+	Fields   string `json:"fields,omitempty" jsonschema:"Optional comma separated task fields to include, e.g. id,title,due_date. Known fields: id, title, uri, description, done, due_date, start_date, end_date, priority, percent_done. Defaults to id,title,uri"`
 }
 
-// TaskSummary is a minimal version of a task for listing
+// TaskSummary is a minimal version of a task for listing. The optional fields are only set
+// when list_tasks is asked for them.
 type TaskSummary struct {
-	ID    int64  `json:"id"`
-	Title string `json:"title"`
-	URI   string `json:"uri"`
+	ID          int64    `json:"id,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	URI         string   `json:"uri,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Done        *bool    `json:"done,omitempty"`
+	DueDate     string   `json:"due_date,omitempty"`
+	StartDate   string   `json:"start_date,omitempty"`
+	EndDate     string   `json:"end_date,omitempty"`
+	Priority    *int64   `json:"priority,omitempty"`
+	PercentDone *float64 `json:"percent_done,omitempty"`
 }
 
 // BucketSummary is a minimal version of a bucket for listing
@@ -47,19 +57,30 @@ type ListTasksOutput struct {
 
 // GetTaskInput defines input for retrieving a task.
 type GetTaskInput struct {
-	TaskID         string `json:"task_id" jsonschema:"The ID of task to retrieve"`
-	IncludeBuckets bool   `json:"include_buckets,omitempty" jsonschema:"Whether to include bucket information across all project views (default: true)"`
+	TaskID                string `json:"task_id" jsonschema:"The ID of task to retrieve"`
+	IncludeBuckets        bool   `json:"include_buckets,omitempty" jsonschema:"Whether to include bucket information across all project views (default: true)"`
+	IncludeLabels         bool   `json:"include_labels,omitempty" jsonschema:"Whether to include the task's labels (default: false)"`
+	IncludeAssignees      bool   `json:"include_assignees,omitempty" jsonschema:"Whether to include the users assigned to the task (default: false)"`
+	IncludeSubtasks       bool   `json:"include_subtasks,omitempty" jsonschema:"Whether to include the task's subtasks (default: false)"`
+	IncludeCommentSummary bool   `json:"include_comment_summary,omitempty" jsonschema:"Whether to include the number of comments and when and by whom the latest was written, without the comments themselves (default: false)"`
 }
 
 // GetTaskOutput defines output for retrieving a task.
 type GetTaskOutput struct {
-	Task    Task                    `json:"task"`
-	Buckets *vikunja.TaskBucketInfo `json:"buckets,omitempty"`
+	Task      Task                    `json:"task"`
+	Buckets   *vikunja.TaskBucketInfo `json:"buckets,omitempty"`
+	Labels    []Label                 `json:"labels,omitempty"`
+	Assignees []Assignee              `json:"assignees,omitempty"`
+	Subtasks  []TaskSummary           `json:"subtasks,omitempty"`
+	// Comments summarizes the task's discussion, when include_comment_summary is set
+	Comments *vikunja.CommentSummary `json:"comments,omitempty"`
+	// Subscribed reports whether the user watches the task
+	Subscribed bool `json:"subscribed"`
 }
 
 // ListBucketsInput defines input for listing buckets.
 type ListBucketsInput struct {
-	ProjectTitle string `json:"project_title,omitempty" jsonschema:"Optional project title to list buckets for (defaults to 'Inbox')"`
+	ProjectTitle string `json:"project_title,omitempty" jsonschema:"Optional project title to list buckets for (defaults to the account's default project)"`
 	ViewTitle    string `json:"view_title,omitempty" jsonschema:"Optional view title to list buckets for (defaults to 'Kanban')"`
 }
 
@@ -70,6 +91,9 @@ type ListBucketsOutput struct {
 
 // ListProjectsInput defines input for listing projects.
 type ListProjectsInput struct {
+	IncludeArchived bool   `json:"include_archived,omitempty" jsonschema:"Whether to include archived projects (default: false)"`
+	Sort            string `json:"sort,omitempty" jsonschema:"Optional order of the projects: title, id or created (oldest first). Defaults to the server's order"`
+	Limit           int    `json:"limit,omitempty" jsonschema:"Optional maximum number of projects to return, applied after sorting. Defaults to no limit"`
 }
 
 // ListProjectsOutput defines output for listing projects.
@@ -79,15 +103,18 @@ type ListProjectsOutput struct {
 
 // CreateTaskInput defines input for creating a task.
 type CreateTaskInput struct {
-	Title       string `json:"title" jsonschema:"The title of task"`
-	Description string `json:"description,omitempty" jsonschema:"Optional task description"`
-	ProjectID   string `json:"project_id" jsonschema:"Project ID (numeric) or project title to create task in"`
-	BucketID    string `json:"bucket_id,omitempty" jsonschema:"Optional bucket ID (numeric) or bucket title to assign task to. Bucket must be in the project's Kanban view."`
+	Title          string `json:"title" jsonschema:"The title of task"`
+	Description    string `json:"description,omitempty" jsonschema:"Optional task description"`
+	ProjectID      string `json:"project_id" jsonschema:"Project ID (numeric) or project title to create task in"`
+	BucketID       string `json:"bucket_id,omitempty" jsonschema:"Optional bucket ID (numeric) or bucket title to assign task to. Bucket must be in the project's Kanban view."`
+	Bucket         string `json:"bucket,omitempty" jsonschema:"Optional bucket ID (numeric) or bucket title in the project's Kanban view to move the task to once it is created, instead of leaving it in the view's default bucket. The task's resulting buckets are reported."`
+	IdempotencyKey string `json:"idempotency_key,omitempty" jsonschema:"Optional key identifying this request; retrying with the same key returns the original result instead of creating a duplicate"`
 }
 
 // CreateTaskOutput defines output for creating a task.
 type CreateTaskOutput struct {
-	Task Task `json:"task"`
+	Task    Task                     `json:"task"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
 }
 
 // FindProjectByNameInput defines input for finding a project by name.
@@ -100,18 +127,42 @@ type FindProjectByNameOutput struct {
 	Project Project `json:"project"`
 }
 
-// FindViewInput defines input for finding a view.
-type FindViewInput struct {
-	ProjectID    string `json:"project_id,omitempty" jsonschema:"Optional project ID to search in (overrides project_title)"`
-	ProjectTitle string `json:"project_title,omitempty" jsonschema:"Optional project title to search in"`
-	ViewName     string `json:"view_name" jsonschema:"The name/title of view to find"`
-	Fuzzy        bool   `json:"fuzzy,omitempty" jsonschema:"Enable fuzzy/partial matching for view names (default: false)"`
+// SetTaskProgressInput defines input for setting how far along a task is.
+type SetTaskProgressInput struct {
+	TaskID      string  `json:"task_id" jsonschema:"The ID of the task"`
+	PercentDone float64 `json:"percent_done" jsonschema:"Progress as a fraction from 0.0 (not started) to 1.0 (finished), e.g. 0.5 for 50%"`
 }
 
-// FindViewOutput defines output for finding a view.
-type FindViewOutput struct {
-	Project Project `json:"project"`
-	View    View    `json:"view"`
+// SetTaskProgressOutput defines output for setting how far along a task is.
+type SetTaskProgressOutput struct {
+	Task    Task                     `json:"task"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// SetTaskDatesInput defines input for scheduling a task on a Gantt chart. Empty fields keep their current value.
+type SetTaskDatesInput struct {
+	TaskID    string `json:"task_id" jsonschema:"The ID of the task"`
+	StartDate string `json:"start_date,omitempty" jsonschema:"Optional start date as YYYY-MM-DD or RFC 3339 (e.g. 2026-03-10T09:00:00Z)"`
+	EndDate   string `json:"end_date,omitempty" jsonschema:"Optional end date as YYYY-MM-DD or RFC 3339; must not be before the start date"`
+}
+
+// SetTaskDatesOutput defines output for scheduling a task.
+type SetTaskDatesOutput struct {
+	Task    Task                     `json:"task"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// ResolveURIInput defines input for resolving a vikunja:// URI.
+type ResolveURIInput struct {
+	URI string `json:"uri" jsonschema:"A vikunja:// URI from earlier output, e.g. vikunja://task/123 or vikunja://project/1/view/2"`
+}
+
+// ResolveURIOutput defines output for resolving a vikunja:// URI. Only the field named by Kind is set.
+type ResolveURIOutput struct {
+	Kind    vikunja.ResourceKind `json:"kind"`
+	Task    *Task                `json:"task,omitempty"`
+	Project *Project             `json:"project,omitempty"`
+	View    *View                `json:"view,omitempty"`
 }
 
 // ListViewsInput defines input for listing views.
@@ -127,18 +178,90 @@ type ListViewsOutput struct {
 	Views   []View  `json:"views"`
 }
 
+// MoveTaskToProjectInput defines input for moving a task to another project.
+type MoveTaskToProjectInput struct {
+	TaskID    string `json:"task_id" jsonschema:"The ID of the task to move"`
+	ProjectID string `json:"project_id" jsonschema:"The ID of the project to move the task to"`
+}
+
+// MoveTaskToProjectOutput defines output for moving a task to another project.
+type MoveTaskToProjectOutput struct {
+	Task    Task                     `json:"task"`
+	Message string                   `json:"message"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// ImportTaskItem is one task to create with import_tasks.
+type ImportTaskItem struct {
+	Title       string `json:"title" jsonschema:"The title of the task"`
+	Description string `json:"description,omitempty" jsonschema:"Optional task description"`
+	DueDate     string `json:"due_date,omitempty" jsonschema:"Optional due date as YYYY-MM-DD or RFC 3339"`
+}
+
+// ImportTasksInput defines input for creating many tasks at once.
+type ImportTasksInput struct {
+	ProjectID      string           `json:"project_id" jsonschema:"Project ID (numeric) or project title to create the tasks in"`
+	Tasks          []ImportTaskItem `json:"tasks" jsonschema:"The tasks to create, in order"`
+	IdempotencyKey string           `json:"idempotency_key,omitempty" jsonschema:"Optional key identifying this request; retrying with the same key returns the original result instead of creating duplicates"`
+}
+
+// ImportedTask is a task import_tasks created.
+type ImportedTask struct {
+	Index int    `json:"index" jsonschema:"Position of the item in the tasks list"`
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	URI   string `json:"uri"`
+}
+
+// ImportTaskError is an item import_tasks or create_tasks_in_buckets could not create.
+type ImportTaskError struct {
+	Index int    `json:"index" jsonschema:"Position of the item in the tasks list"`
+	Title string `json:"title,omitempty"`
+	Error string `json:"error"`
+}
+
+// ImportTasksOutput defines output for creating many tasks at once.
+type ImportTasksOutput struct {
+	ProjectID int64                    `json:"project_id"`
+	Created   []ImportedTask           `json:"created"`
+	Errors    []ImportTaskError        `json:"errors,omitempty"`
+	Planned   []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// WatchTaskInput defines input for subscribing to a task's notifications.
+type WatchTaskInput struct {
+	TaskID string `json:"task_id" jsonschema:"The ID of the task to watch"`
+}
+
+// UnwatchTaskInput defines input for unsubscribing from a task's notifications.
+type UnwatchTaskInput struct {
+	TaskID string `json:"task_id" jsonschema:"The ID of the task to stop watching"`
+}
+
+// WatchTaskOutput defines output for watching or unwatching a task.
+type WatchTaskOutput struct {
+	TaskID     int64                    `json:"task_id"`
+	Subscribed bool                     `json:"subscribed"`
+	Message    string                   `json:"message"`
+	Planned    []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
 // MoveTaskToBucketInput defines input for moving a task to a bucket.
 type MoveTaskToBucketInput struct {
-	TaskID    string `json:"task_id" jsonschema:"The ID of task to move"`
-	ProjectID string `json:"project_id" jsonschema:"The project ID containing task"`
-	ViewID    string `json:"view_id" jsonschema:"The view ID containing task"`
-	BucketID  string `json:"bucket_id" jsonschema:"The bucket ID to move task to"`
+	TaskID      string `json:"task_id" jsonschema:"The ID of task to move"`
+	ProjectID   string `json:"project_id" jsonschema:"The project ID containing task"`
+	ViewID      string `json:"view_id" jsonschema:"The view ID containing task"`
+	BucketID    string `json:"bucket_id,omitempty" jsonschema:"The bucket ID to move task to (use either bucket_id or bucket_title)"`
+	BucketTitle string `json:"bucket_title,omitempty" jsonschema:"The title of the bucket to move task to, e.g. 'Done' (use either bucket_id or bucket_title)"`
+	StrictWIP   bool   `json:"strict_wip,omitempty" jsonschema:"Refuse the move when it would put more tasks in the bucket than its limit allows, instead of moving with a warning"`
 }
 
 // MoveTaskToBucketOutput defines output for moving a task to a bucket.
 type MoveTaskToBucketOutput struct {
-	TaskBucket vikunja.TaskBucket `json:"task_bucket"`
-	Message    string             `json:"message"`
+	TaskBucket TaskBucket               `json:"task_bucket"`
+	Message    string                   `json:"message"`
+	Warning    string                   `json:"warning,omitempty"`
+	Planned    []vikunja.PlannedRequest `json:"planned,omitempty"`
 }
 
 // Core types
@@ -168,6 +291,25 @@ type Task struct {
 	Updated     string   `json:"updated"`
 	Buckets     []Bucket `json:"buckets,omitempty"`
 	Position    float64  `json:"position"`
+	Priority    int64    `json:"priority,omitempty"`
+	HexColor    string   `json:"hex_color,omitempty"`
+	PercentDone float64  `json:"percent_done,omitempty"`
+	StartDate   string   `json:"start_date,omitempty"`
+	EndDate     string   `json:"end_date,omitempty"`
+}
+
+// Label is a simplified version of vikunja.Label
+type Label struct {
+	ID       int64  `json:"id"`
+	Title    string `json:"title"`
+	HexColor string `json:"hex_color,omitempty"`
+}
+
+// Assignee is a simplified version of vikunja.User
+type Assignee struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name,omitempty"`
 }
 
 // Bucket is a simplified version of vikunja.Bucket to avoid recursive cycles in JSON schema
@@ -178,11 +320,20 @@ type Bucket struct {
 	Position      float64 `json:"position"`
 }
 
+// TaskBucket is a simplified version of vikunja.TaskBucket to avoid recursive cycles in JSON schema
+type TaskBucket struct {
+	TaskID        int64 `json:"task_id"`
+	BucketID      int64 `json:"bucket_id"`
+	ProjectViewID int64 `json:"project_view_id,omitempty"`
+}
+
 // Project is a simplified version of vikunja.Project
 type Project struct {
-	ID    int64  `json:"id"`
-	Title string `json:"title"`
-	URI   string `json:"uri"`
+	ID       int64  `json:"id"`
+	Title    string `json:"title"`
+	URI      string `json:"uri"`
+	HexColor string `json:"hex_color,omitempty"`
+	MaxRight string `json:"max_right,omitempty"`
 }
 
 // BucketTasks represents a bucket and its associated tasks
@@ -197,3 +348,40 @@ type ViewTasks struct {
 	ViewTitle string        `json:"view_title"`
 	Buckets   []BucketTasks `json:"buckets,omitempty"`
 }
+
+// DryRunOutput is returned in place of a mutating tool's normal response in dry-run mode.
+type DryRunOutput struct {
+	DryRun  bool                     `json:"dry_run"`
+	Message string                   `json:"message"`
+	Planned []vikunja.PlannedRequest `json:"planned"`
+}
+
+// ExportProjectInput defines input for exporting a project.
+type ExportProjectInput struct {
+	Project string `json:"project" jsonschema:"Project ID (integer) or title (string)"`
+}
+
+// ExportProjectOutput defines output for exporting a project. The snapshot itself is the text
+// content of the result; the generated models it holds are cyclic and cannot be put in a schema.
+type ExportProjectOutput struct {
+	ProjectID int64 `json:"project_id"`
+	Views     int   `json:"views"`
+	Buckets   int   `json:"buckets"`
+	Tasks     int   `json:"tasks"`
+}
+
+// ImportProjectInput defines input for recreating a project from an export.
+type ImportProjectInput struct {
+	Snapshot string `json:"snapshot" jsonschema:"The JSON document produced by export_project"`
+}
+
+// ImportProjectOutput defines output for recreating a project from an export. Views, Buckets
+// and Tasks map IDs in the snapshot to the IDs they were recreated with.
+type ImportProjectOutput struct {
+	ProjectID int64                    `json:"project_id,omitempty"`
+	Views     map[string]int64         `json:"views,omitempty"`
+	Buckets   map[string]int64         `json:"buckets,omitempty"`
+	Tasks     map[string]int64         `json:"tasks,omitempty"`
+	Failures  []vikunja.ImportFailure  `json:"failures,omitempty"`
+	Planned   []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
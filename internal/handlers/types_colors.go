@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// Input/Output types for coloring tasks and projects
+
+// SetTaskColorInput defines input for setting a task's color.
+type SetTaskColorInput struct {
+	TaskID   string `json:"task_id" jsonschema:"The ID of the task to color"`
+	HexColor string `json:"hex_color" jsonschema:"The color as a hex triplet, with or without the leading # (e.g. #1973ff)"`
+}
+
+// SetTaskColorOutput defines output for setting a task's color.
+type SetTaskColorOutput struct {
+	Task    Task                     `json:"task"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// SetProjectColorInput defines input for setting a project's color.
+type SetProjectColorInput struct {
+	Project  string `json:"project" jsonschema:"Project ID (integer) or title (string)"`
+	HexColor string `json:"hex_color" jsonschema:"The color as a hex triplet, with or without the leading # (e.g. #1973ff)"`
+}
+
+// SetProjectColorOutput defines output for setting a project's color.
+type SetProjectColorOutput struct {
+	Project Project                  `json:"project"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
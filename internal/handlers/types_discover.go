@@ -0,0 +1,55 @@
+package handlers
+
+// Input/Output types for discovering what a Vikunja server holds
+
+// DiscoverInput defines input for discovering the Vikunja server.
+type DiscoverInput struct {
+	MaxProjects        int    `json:"max_projects,omitempty" jsonschema:"Maximum number of projects to describe (defaults to the server's configured cap, usually 5; max 100)"`
+	IncludeCounts      bool   `json:"include_counts,omitempty" jsonschema:"Also report each project's number of open tasks (default: false)"`
+	MaxViewsPerProject int    `json:"max_views_per_project,omitempty" jsonschema:"Maximum number of views listed for each project (default: 25; max 1000)"`
+	MaxViews           int    `json:"max_views,omitempty" jsonschema:"Maximum number of views listed across all projects (default: 250; max 1000)"`
+	Profile            string `json:"profile,omitempty" jsonschema:"full (default) also describes the server, its default project and operator notes; minimal returns only projects and their views"`
+}
+
+// DiscoverOutput defines output for discovering the Vikunja server.
+type DiscoverOutput struct {
+	ServerInfo *DiscoverServerInfo `json:"server_info,omitempty" jsonschema:"The server and how much of it is described; left out by the minimal profile"`
+	Projects   []DiscoveredProject `json:"projects"`
+	Hint       string              `json:"hint,omitempty" jsonschema:"Guidance when the project list was cut short"`
+	Notes      []string            `json:"notes,omitempty" jsonschema:"Guidance from the server's operator on how to work with this Vikunja; follow it"`
+	Warnings   []string            `json:"warnings,omitempty" jsonschema:"Parts of the server that could not be described; the rest of the output is still valid"`
+}
+
+// DiscoverServerInfo describes the server and how much of it the discovery covers.
+type DiscoverServerInfo struct {
+	Version  string `json:"version,omitempty"`
+	Readonly bool   `json:"readonly"`
+	// ReadonlyAllow lists the mutating tools readonly mode still permits
+	ReadonlyAllow []string `json:"readonly_allow,omitempty" jsonschema:"Mutating tools still permitted in readonly mode"`
+	TotalProjects int      `json:"total_projects" jsonschema:"Number of projects the server holds"`
+	Truncated     bool     `json:"truncated" jsonschema:"True when only the first max_projects projects are described"`
+	// ViewsTruncated reports that a view cap left some projects' views out
+	ViewsTruncated bool `json:"views_truncated,omitempty" jsonschema:"True when some projects list only part of their views because of max_views or max_views_per_project"`
+	// DefaultProject is the project tools use when a call names none
+	DefaultProject *Project `json:"default_project,omitempty" jsonschema:"The project tools use when a call names none"`
+}
+
+// DiscoveredProject is a project together with its views.
+type DiscoveredProject struct {
+	ID    int64            `json:"id"`
+	Title string           `json:"title"`
+	Views []DiscoveredView `json:"views"`
+	// ViewsTruncated reports that Views lists only part of the project's views
+	ViewsTruncated bool `json:"views_truncated,omitempty" jsonschema:"True when only some of the project's views are listed; list_views lists them all"`
+	// OpenTasks is the number of incomplete tasks, when counts were asked for
+	OpenTasks *int64 `json:"open_tasks,omitempty" jsonschema:"Number of incomplete tasks, reported when include_counts is set"`
+	// DefaultViewID is the view Vikunja opens the project with
+	DefaultViewID int64 `json:"default_view_id,omitempty" jsonschema:"The view Vikunja opens the project with (the lowest positioned view)"`
+}
+
+// DiscoveredView is a simplified version of vikunja.ProjectView
+type DiscoveredView struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	Kind  string `json:"kind"`
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// Input/Output types for webhooks and link shares
+
+// ListWebhooksInput defines input for listing a project's webhooks.
+type ListWebhooksInput struct {
+	ProjectID string `json:"project_id" jsonschema:"The project ID to list webhooks for"`
+}
+
+// ListWebhooksOutput defines output for listing a project's webhooks.
+type ListWebhooksOutput struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// CreateWebhookInput defines input for creating a webhook.
+type CreateWebhookInput struct {
+	ProjectID      string   `json:"project_id" jsonschema:"The project ID to register the webhook on"`
+	TargetURL      string   `json:"target_url" jsonschema:"The http(s) URL Vikunja should deliver events to"`
+	Events         []string `json:"events" jsonschema:"Events to subscribe to, e.g. task.created, task.updated, project.updated"`
+	IdempotencyKey string   `json:"idempotency_key,omitempty" jsonschema:"Optional key identifying this request; retrying with the same key returns the original result instead of creating a duplicate"`
+}
+
+// CreateWebhookOutput defines output for creating a webhook.
+type CreateWebhookOutput struct {
+	Webhook Webhook                  `json:"webhook"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// DeleteWebhookInput defines input for deleting a webhook.
+type DeleteWebhookInput struct {
+	ProjectID string `json:"project_id" jsonschema:"The project ID the webhook belongs to"`
+	WebhookID string `json:"webhook_id" jsonschema:"The ID of the webhook to delete"`
+}
+
+// DeleteWebhookOutput defines output for deleting a webhook.
+type DeleteWebhookOutput struct {
+	Message string                   `json:"message"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// Webhook is a simplified version of vikunja.Webhook
+type Webhook struct {
+	ID        int64    `json:"id"`
+	ProjectID int64    `json:"project_id"`
+	TargetURL string   `json:"target_url"`
+	Events    []string `json:"events"`
+	Secret    string   `json:"secret,omitempty"`
+	Created   string   `json:"created,omitempty"`
+}
+
+// CreateProjectShareInput defines input for creating a project link share.
+type CreateProjectShareInput struct {
+	ProjectID      string `json:"project_id" jsonschema:"The project ID to share"`
+	Right          string `json:"right,omitempty" jsonschema:"Access granted through the link: read, read_write or admin (defaults to read)"`
+	IdempotencyKey string `json:"idempotency_key,omitempty" jsonschema:"Optional key identifying this request; retrying with the same key returns the original result instead of creating a duplicate"`
+}
+
+// CreateProjectShareOutput defines output for creating a project link share.
+type CreateProjectShareOutput struct {
+	Share   ProjectShare             `json:"share"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// ProjectShare is a simplified version of vikunja.LinkShare
+type ProjectShare struct {
+	ID        int64  `json:"id"`
+	ProjectID int64  `json:"project_id"`
+	Hash      string `json:"hash"`
+	Right     string `json:"right"`
+	URL       string `json:"url"`
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import "github.com/meschbach/mcp-vikunja/pkg/vikunja"
+
+// ListWritableProjectsInput defines input for listing the projects the token can write to.
+type ListWritableProjectsInput struct{}
+
+// ListWritableProjectsOutput defines output for listing the projects the token can write to.
+type ListWritableProjectsOutput struct {
+	Projects []Project `json:"projects"`
+}
+
+// GetProjectInput defines input for retrieving a project.
+type GetProjectInput struct {
+	Project        string `json:"project" jsonschema:"Project ID (integer) or title (string)"`
+	IncludeViews   bool   `json:"include_views,omitempty" jsonschema:"Also return the project's views"`
+	IncludeBuckets bool   `json:"include_buckets,omitempty" jsonschema:"Also return the buckets of the project's kanban views; implies include_views"`
+}
+
+// GetProjectOutput defines output for retrieving a project.
+type GetProjectOutput struct {
+	Project Project       `json:"project"`
+	Views   []View        `json:"views,omitempty"`
+	Buckets []ViewBuckets `json:"buckets,omitempty"`
+}
+
+// ViewBuckets holds the buckets of one kanban view.
+type ViewBuckets struct {
+	ViewID    int64    `json:"view_id"`
+	ViewTitle string   `json:"view_title"`
+	Buckets   []Bucket `json:"buckets"`
+}
+
+// EnsureProjectInput defines input for finding a project by title, creating it when absent.
+type EnsureProjectInput struct {
+	Title string `json:"title" jsonschema:"The exact title of the project to find or create"`
+}
+
+// EnsureProjectOutput defines output for finding a project by title, creating it when absent.
+type EnsureProjectOutput struct {
+	Project Project                  `json:"project"`
+	Created bool                     `json:"created"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// WatchProjectInput defines input for waiting until a project's board changes.
+type WatchProjectInput struct {
+	Project         string `json:"project,omitempty" jsonschema:"Optional project ID (integer) or title (string). Defaults to the account's default project"`
+	View            string `json:"view,omitempty" jsonschema:"Optional view ID (integer) or title (string). Defaults to 'Kanban'"`
+	IntervalSeconds int    `json:"interval_seconds,omitempty" jsonschema:"Seconds between polls of the board, 1 to 60. Defaults to 5"`
+	TimeoutSeconds  int    `json:"timeout_seconds,omitempty" jsonschema:"Seconds to wait for a change before giving up, 1 to 300. Defaults to 60"`
+}
+
+// WatchProjectOutput defines output for waiting until a project's board changes.
+type WatchProjectOutput struct {
+	ProjectID int64         `json:"project_id"`
+	ViewID    int64         `json:"view_id"`
+	Changed   bool          `json:"changed"`
+	Polls     int           `json:"polls"`
+	Added     []WatchedTask `json:"added,omitempty"`
+	Updated   []WatchedTask `json:"updated,omitempty"`
+	Removed   []WatchedTask `json:"removed,omitempty"`
+}
+
+// WatchedTask is a task that changed while a board was watched, with the bucket it is in and, when
+// it moved, the bucket it was in before.
+type WatchedTask struct {
+	Task           Task   `json:"task"`
+	Bucket         string `json:"bucket,omitempty"`
+	PreviousBucket string `json:"previous_bucket,omitempty"`
+}
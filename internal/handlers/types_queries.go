@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// Input/Output types for cross-project task queries
+
+// ListAllTasksInput defines input for listing tasks across all projects.
+type ListAllTasksInput struct {
+	Done     *bool  `json:"done,omitempty" jsonschema:"Only return tasks with this done state. Defaults to false (incomplete tasks only)"`
+	Filter   string `json:"filter,omitempty" jsonschema:"Optional Vikunja filter query combined with the done state, e.g. 'priority >= 3' or 'due_date < now+7d'"`
+	FilterID string `json:"filter_id,omitempty" jsonschema:"Optional saved filter ID, or its negative pseudo-project ID, whose query is applied. The done state then only applies when set explicitly"`
+	Page     int    `json:"page,omitempty" jsonschema:"Page of results to return, starting at 1 (default: 1). Pass the previous call's next_page to continue"`
+}
+
+// ListAllTasksOutput defines output for listing tasks across all projects.
+type ListAllTasksOutput struct {
+	Tasks    []Task `json:"tasks"`
+	Page     int64  `json:"page" jsonschema:"The page of results returned"`
+	NextPage int64  `json:"next_page,omitempty" jsonschema:"The page to request next; omitted on the last page"`
+}
+
+// ListUpcomingTasksInput defines input for listing tasks by upcoming due date.
+type ListUpcomingTasksInput struct {
+	Days           int  `json:"days,omitempty" jsonschema:"Number of days ahead to look, from now (default: 1, max: 365)"`
+	IncludeOverdue bool `json:"include_overdue,omitempty" jsonschema:"Also include incomplete tasks whose due date has already passed (default: false)"`
+}
+
+// ListUpcomingTasksOutput defines output for listing tasks by upcoming due date.
+type ListUpcomingTasksOutput struct {
+	From  string `json:"from,omitempty" jsonschema:"Start of the due date window; omitted when overdue tasks are included"`
+	Until string `json:"until" jsonschema:"End of the due date window"`
+	Tasks []Task `json:"tasks"`
+}
+
+// ListSavedFiltersInput defines input for listing saved filters.
+type ListSavedFiltersInput struct {
+}
+
+// ListSavedFiltersOutput defines output for listing saved filters.
+type ListSavedFiltersOutput struct {
+	Filters []vikunja.SavedFilter `json:"filters"`
+}
+
+// CountTasksInput defines input for counting a project's tasks.
+type CountTasksInput struct {
+	ProjectID    string `json:"project_id,omitempty" jsonschema:"The ID of the project (use either project_id or project_title)"`
+	ProjectTitle string `json:"project_title,omitempty" jsonschema:"The title of the project (use either project_id or project_title)"`
+	Done         *bool  `json:"done,omitempty" jsonschema:"Only count tasks with this done state. By default both done and open tasks are counted"`
+}
+
+// CountTasksOutput defines output for counting a project's tasks.
+type CountTasksOutput struct {
+	Project Project `json:"project"`
+	Total   int64   `json:"total"`
+	Done    *int64  `json:"done,omitempty" jsonschema:"Number of done tasks, omitted when only open tasks were counted"`
+	Open    *int64  `json:"open,omitempty" jsonschema:"Number of open tasks, omitted when only done tasks were counted"`
+}
+
+// GroupTasksByLabelInput defines input for grouping a project's tasks by label.
+type GroupTasksByLabelInput struct {
+	ProjectID    string `json:"project_id,omitempty" jsonschema:"The ID of the project (use either project_id or project_title)"`
+	ProjectTitle string `json:"project_title,omitempty" jsonschema:"The title of the project (use either project_id or project_title)"`
+	Done         *bool  `json:"done,omitempty" jsonschema:"Only include tasks with this done state. By default both done and open tasks are included"`
+}
+
+// TaskLabelGroup holds the tasks carrying one label. LabelID is 0 for the "(unlabeled)" group.
+type TaskLabelGroup struct {
+	LabelID int64         `json:"label_id,omitempty"`
+	Label   string        `json:"label"`
+	Tasks   []TaskSummary `json:"tasks"`
+}
+
+// GroupTasksByLabelOutput defines output for grouping a project's tasks by label.
+type GroupTasksByLabelOutput struct {
+	Project Project          `json:"project"`
+	Groups  []TaskLabelGroup `json:"groups" jsonschema:"One group per label ordered by label title, followed by the unlabeled tasks. A task with several labels is in each of their groups"`
+}
+
+// DueReportInput defines input for reporting incomplete tasks by when they are due.
+type DueReportInput struct {
+}
+
+// DueReportOutput defines output for reporting incomplete tasks by when they are due.
+type DueReportOutput struct {
+	Overdue   []Task `json:"overdue"`
+	Today     []Task `json:"today" jsonschema:"Tasks due later today"`
+	ThisWeek  []Task `json:"this_week" jsonschema:"Tasks due after today and before the week ends on Sunday night"`
+	Later     []Task `json:"later"`
+	NoDueDate []Task `json:"no_due_date"`
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import "github.com/meschbach/mcp-vikunja/pkg/vikunja"
+
+// ClearTaskFieldInput defines input for removing a task's due date, assignees or labels.
+type ClearTaskFieldInput struct {
+	TaskID string `json:"task_id" jsonschema:"The ID of the task"`
+	Field  string `json:"clear_field" jsonschema:"What to remove from the task: due_date, assignees or labels"`
+}
+
+// ClearTaskFieldOutput defines output for removing a task's due date, assignees or labels.
+type ClearTaskFieldOutput struct {
+	Task    Task                     `json:"task"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
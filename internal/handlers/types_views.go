@@ -0,0 +1,273 @@
+package handlers
+
+import (
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// Input/Output types for editing views and their buckets
+
+// CreateViewInput defines input for creating a project view.
+type CreateViewInput struct {
+	ProjectID               string `json:"project_id,omitempty" jsonschema:"Optional project ID to create the view in (overrides project_title)"`
+	ProjectTitle            string `json:"project_title,omitempty" jsonschema:"Optional project title to create the view in"`
+	Title                   string `json:"title" jsonschema:"The title of the new view"`
+	ViewKind                string `json:"view_kind" jsonschema:"The view kind (list, kanban, gantt, table)"`
+	BucketConfigurationMode string `json:"bucket_configuration_mode,omitempty" jsonschema:"Optional bucket configuration mode (none, manual, filter)"`
+	DefaultBucketID         string `json:"default_bucket_id,omitempty" jsonschema:"Optional ID of the bucket new tasks are added to"`
+	DoneBucketID            string `json:"done_bucket_id,omitempty" jsonschema:"Optional ID of the bucket that marks tasks as done"`
+	IdempotencyKey          string `json:"idempotency_key,omitempty" jsonschema:"Optional key identifying this request; retrying with the same key returns the original result instead of creating a duplicate"`
+}
+
+// CreateViewOutput defines output for creating a project view.
+type CreateViewOutput struct {
+	Project Project                  `json:"project"`
+	View    View                     `json:"view"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// UpdateViewInput defines input for updating a project view. Empty fields keep their current value.
+type UpdateViewInput struct {
+	ProjectID               string `json:"project_id,omitempty" jsonschema:"Optional project ID the view belongs to (overrides project_title)"`
+	ProjectTitle            string `json:"project_title,omitempty" jsonschema:"Optional project title the view belongs to"`
+	ViewID                  string `json:"view_id" jsonschema:"The ID of the view to update"`
+	Title                   string `json:"title,omitempty" jsonschema:"Optional new title"`
+	ViewKind                string `json:"view_kind,omitempty" jsonschema:"Optional new view kind (list, kanban, gantt, table)"`
+	BucketConfigurationMode string `json:"bucket_configuration_mode,omitempty" jsonschema:"Optional new bucket configuration mode (none, manual, filter)"`
+	DefaultBucketID         string `json:"default_bucket_id,omitempty" jsonschema:"Optional ID of the bucket new tasks are added to"`
+	DoneBucketID            string `json:"done_bucket_id,omitempty" jsonschema:"Optional ID of the bucket that marks tasks as done"`
+}
+
+// UpdateViewOutput defines output for updating a project view.
+type UpdateViewOutput struct {
+	Project Project                  `json:"project"`
+	View    View                     `json:"view"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// ReorderBucketsInput defines input for reordering the buckets of a view.
+type ReorderBucketsInput struct {
+	ProjectID string   `json:"project_id" jsonschema:"The project ID containing the view"`
+	ViewID    string   `json:"view_id" jsonschema:"The view ID whose buckets are reordered"`
+	BucketIDs []string `json:"bucket_ids" jsonschema:"Bucket IDs in the desired left-to-right order"`
+}
+
+// ReorderBucketsOutput defines output for reordering the buckets of a view.
+type ReorderBucketsOutput struct {
+	Buckets []Bucket                 `json:"buckets"`
+	Message string                   `json:"message"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// RenameBucketInput defines input for renaming a bucket of a view.
+type RenameBucketInput struct {
+	ProjectID   string `json:"project_id" jsonschema:"The project ID containing the view"`
+	ViewID      string `json:"view_id" jsonschema:"The view ID containing the bucket"`
+	BucketID    string `json:"bucket_id,omitempty" jsonschema:"The ID of the bucket to rename (use either bucket_id or bucket_title)"`
+	BucketTitle string `json:"bucket_title,omitempty" jsonschema:"The current title of the bucket to rename, e.g. 'Doing' (use either bucket_id or bucket_title)"`
+	NewTitle    string `json:"new_title" jsonschema:"The new title of the bucket"`
+}
+
+// RenameBucketOutput defines output for renaming a bucket of a view.
+type RenameBucketOutput struct {
+	Bucket  Bucket                   `json:"bucket"`
+	Message string                   `json:"message"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// EnsureBucketInput defines input for finding a bucket of a view by title, creating it when absent.
+type EnsureBucketInput struct {
+	ProjectID string `json:"project_id" jsonschema:"The project ID containing the view"`
+	ViewID    string `json:"view_id" jsonschema:"The ID of the kanban view the bucket belongs to"`
+	Title     string `json:"title" jsonschema:"The exact title of the bucket to find or create"`
+}
+
+// EnsureBucketOutput defines output for finding a bucket of a view by title, creating it when absent.
+type EnsureBucketOutput struct {
+	Bucket  Bucket                   `json:"bucket"`
+	Created bool                     `json:"created"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// GetBoardInput defines input for reading a view as a board.
+type GetBoardInput struct {
+	Project string `json:"project,omitempty" jsonschema:"Optional project ID (integer) or title (string). Defaults to the account's default project"`
+	View    string `json:"view,omitempty" jsonschema:"Optional view ID (integer) or title (string). Defaults to 'Kanban'"`
+	Bucket  string `json:"bucket,omitempty" jsonschema:"Optional bucket ID (integer) or title (string) to show on its own"`
+}
+
+// BoardBucket is a bucket together with its full tasks
+type BoardBucket struct {
+	Bucket       Bucket `json:"bucket"`
+	IsDoneBucket bool   `json:"is_done_bucket,omitempty"`
+	Tasks        []Task `json:"tasks,omitempty"`
+}
+
+// Board represents all buckets of a view with their full tasks
+type Board struct {
+	ViewID    int64         `json:"view_id"`
+	ViewTitle string        `json:"view_title"`
+	Buckets   []BoardBucket `json:"buckets,omitempty" jsonschema:"Buckets tasks are organized into"`
+}
+
+// GetBoardOutput defines output for reading a view as a board.
+type GetBoardOutput struct {
+	Project *Project `json:"project,omitempty" jsonschema:"Project the board belongs to"`
+	Board   Board    `json:"board"`
+}
+
+// GetBucketInput defines input for reading a single bucket with its tasks.
+type GetBucketInput struct {
+	ProjectID string `json:"project_id" jsonschema:"The ID of the project"`
+	ViewID    string `json:"view_id" jsonschema:"The ID of the kanban view the bucket belongs to"`
+	BucketID  string `json:"bucket_id" jsonschema:"The ID of the bucket"`
+}
+
+// GetBucketOutput defines output for reading a single bucket with its tasks.
+type GetBucketOutput struct {
+	Bucket BoardBucket `json:"bucket"`
+}
+
+// GetViewInput defines input for reading a single view.
+type GetViewInput struct {
+	ProjectID string `json:"project_id" jsonschema:"The ID of the project"`
+	ViewID    string `json:"view_id" jsonschema:"The ID of the view"`
+}
+
+// GetViewOutput defines output for reading a single view.
+type GetViewOutput struct {
+	View View `json:"view"`
+}
+
+// FindViewInput defines input for finding a view.
+type FindViewInput struct {
+	ProjectID    string `json:"project_id,omitempty" jsonschema:"Optional project ID to search in (overrides project_title)"`
+	ProjectTitle string `json:"project_title,omitempty" jsonschema:"Optional project title to search in"`
+	ViewName     string `json:"view_name" jsonschema:"The name/title of view to find"`
+	Fuzzy        bool   `json:"fuzzy,omitempty" jsonschema:"Enable fuzzy/partial matching for view names (default: false)"`
+	AllProjects  bool   `json:"all_projects,omitempty" jsonschema:"Search the views of every project when no project is given, returning every match (default: false)"`
+}
+
+// FindViewOutput defines output for finding a view.
+type FindViewOutput struct {
+	Project Project `json:"project"`
+	View    View    `json:"view"`
+	// Matches lists every view found when all projects were searched; Project and View hold the first
+	Matches   []FoundView `json:"matches,omitempty" jsonschema:"Every matching view with its project, when all projects were searched"`
+	Truncated bool        `json:"truncated,omitempty" jsonschema:"True when only the first projects were searched"`
+}
+
+// FoundView is a view found by name together with the project holding it.
+type FoundView struct {
+	Project Project `json:"project"`
+	View    View    `json:"view"`
+}
+
+// UndoLastMoveInput defines input for undoing the most recent bucket move.
+type UndoLastMoveInput struct{}
+
+// UndoLastMoveOutput defines output for undoing the most recent bucket move.
+type UndoLastMoveOutput struct {
+	TaskBucket TaskBucket               `json:"task_bucket"`
+	Message    string                   `json:"message"`
+	Planned    []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// CompleteTaskInput defines input for marking a task done.
+type CompleteTaskInput struct {
+	TaskID           string `json:"task_id" jsonschema:"The ID of the task to mark done"`
+	MoveToDoneBucket bool   `json:"move_to_done_bucket,omitempty" jsonschema:"Also move the task to the done bucket of 'view' (default: false)"`
+	View             string `json:"view,omitempty" jsonschema:"View ID (integer) or title of the task's project whose done bucket the task moves to. Defaults to 'Kanban'"`
+}
+
+// CompleteTaskOutput defines output for marking a task done.
+type CompleteTaskOutput struct {
+	Task         Task                     `json:"task"`
+	DoneBucketID int64                    `json:"done_bucket_id,omitempty" jsonschema:"The done bucket the task was moved to"`
+	Planned      []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// GetTaskByIndexInput defines input for looking up a task by its position in a view.
+type GetTaskByIndexInput struct {
+	Project string `json:"project,omitempty" jsonschema:"Project ID (integer) or title (string); defaults to the account's default project"`
+	View    string `json:"view,omitempty" jsonschema:"View ID (integer) or title (string); defaults to 'Kanban'"`
+	Bucket  string `json:"bucket,omitempty" jsonschema:"Optional bucket ID (integer) or title (string) to count within, e.g. 'To Do'"`
+	Index   int    `json:"index" jsonschema:"1-based position of the task, in the order list_tasks shows the view's tasks"`
+}
+
+// GetTaskByIndexOutput defines output for looking up a task by its position in a view.
+type GetTaskByIndexOutput struct {
+	Task   Task           `json:"task"`
+	Index  int            `json:"index"`
+	Bucket *BucketSummary `json:"bucket,omitempty" jsonschema:"The bucket holding the task, when the view has buckets"`
+}
+
+// SetTaskBucketEverywhereInput defines input for moving a task to a same-titled bucket in every kanban view.
+type SetTaskBucketEverywhereInput struct {
+	TaskID      string `json:"task_id" jsonschema:"The ID of the task to move"`
+	BucketTitle string `json:"bucket_title" jsonschema:"Title of the bucket to move the task to in each kanban view, e.g. 'Done'"`
+}
+
+// SetTaskBucketEverywhereOutput defines output for moving a task to a same-titled bucket in every kanban view.
+type SetTaskBucketEverywhereOutput struct {
+	TaskID  int64                    `json:"task_id"`
+	Views   []ViewBucketMove         `json:"views" jsonschema:"What happened to the task in each kanban view of its project"`
+	Planned []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// ViewBucketMove reports the outcome of moving a task within one kanban view.
+type ViewBucketMove struct {
+	ViewID    int64  `json:"view_id"`
+	ViewTitle string `json:"view_title"`
+	BucketID  int64  `json:"bucket_id,omitempty"`
+	// Status is moved, skipped when the view has no bucket with the title, or failed
+	Status string `json:"status" jsonschema:"moved, skipped when the view has no bucket with the title, or failed"`
+	Error  string `json:"error,omitempty"`
+	// Warning is set when the move took the bucket past its task limit
+	Warning string `json:"warning,omitempty" jsonschema:"Set when the move took the bucket past its task limit"`
+}
+
+// BucketTaskItem is one task to create with create_tasks_in_buckets.
+type BucketTaskItem struct {
+	Title       string `json:"title" jsonschema:"The title of the task"`
+	BucketTitle string `json:"bucket_title" jsonschema:"Title of the view's bucket to place the task in, e.g. 'To Do'"`
+}
+
+// CreateTasksInBucketsInput defines input for creating tasks placed in the buckets of a kanban view.
+type CreateTasksInBucketsInput struct {
+	ProjectID      string           `json:"project_id" jsonschema:"Project ID (numeric) or project title to create the tasks in"`
+	ViewID         string           `json:"view_id" jsonschema:"ID (numeric) or title of the project's kanban view holding the buckets"`
+	Tasks          []BucketTaskItem `json:"tasks" jsonschema:"The tasks to create, in order"`
+	IdempotencyKey string           `json:"idempotency_key,omitempty" jsonschema:"Optional key identifying this request; retrying with the same key returns the original result instead of creating duplicates"`
+}
+
+// BucketTask is a task create_tasks_in_buckets created and placed.
+type BucketTask struct {
+	Index       int    `json:"index" jsonschema:"Position of the item in the tasks list"`
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	URI         string `json:"uri"`
+	BucketID    int64  `json:"bucket_id"`
+	BucketTitle string `json:"bucket_title"`
+	// Warning is set when placing the task took the bucket past its task limit
+	Warning string `json:"warning,omitempty" jsonschema:"Set when placing the task took the bucket past its task limit"`
+}
+
+// CreateTasksInBucketsOutput defines output for creating tasks placed in the buckets of a kanban view.
+type CreateTasksInBucketsOutput struct {
+	ProjectID int64                    `json:"project_id"`
+	ViewID    int64                    `json:"view_id"`
+	Created   []BucketTask             `json:"created"`
+	Errors    []ImportTaskError        `json:"errors,omitempty"`
+	Planned   []vikunja.PlannedRequest `json:"planned,omitempty"`
+}
+
+// BoardSummaryInput defines input for counting the tasks in a project's kanban buckets.
+type BoardSummaryInput struct {
+	Project string `json:"project,omitempty" jsonschema:"Optional project ID (integer) or title (string). Defaults to the account's default project"`
+}
+
+// BoardSummaryOutput defines output for counting the tasks in a project's kanban buckets.
+type BoardSummaryOutput struct {
+	Project Project               `json:"project"`
+	Views   []vikunja.ViewSummary `json:"views" jsonschema:"One entry per kanban view with its task count per bucket and its done/total ratio"`
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	defaultUpcomingDays = 1
+	maxUpcomingDays     = 365
+)
+
+// listUpcomingTasksHandler handles the list_upcoming_tasks tool
+func (h *Handlers) listUpcomingTasksHandler(ctx context.Context, _ *mcp.CallToolRequest, input ListUpcomingTasksInput) (*mcp.CallToolResult, ListUpcomingTasksOutput, error) {
+	days, err := validateUpcomingDays(input.Days)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ListUpcomingTasksOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ListUpcomingTasksOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	tasks, err := client.GetAllTasks(ctx, "done = false")
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ListUpcomingTasksOutput{}, err
+	}
+
+	now := h.deps.Now()
+	until := now.AddDate(0, 0, days)
+	from := now
+	if input.IncludeOverdue {
+		from = time.Time{}
+	}
+	upcoming := filterTasksDueBetween(tasks, from, until)
+
+	output := ListUpcomingTasksOutput{Until: until.Format(time.RFC3339), Tasks: make([]Task, len(upcoming))}
+	if !from.IsZero() {
+		output.From = from.Format(time.RFC3339)
+	}
+	for i, t := range upcoming {
+		output.Tasks[i] = toTask(t)
+	}
+
+	data, err := h.deps.OutputFormatter.Format(upcoming)
+	if err != nil {
+		return nil, ListUpcomingTasksOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+func validateUpcomingDays(days int) (int, error) {
+	if days == 0 {
+		return defaultUpcomingDays, nil
+	}
+	if days < 0 || days > maxUpcomingDays {
+		return 0, ValidationError{Field: "days", Message: fmt.Sprintf("must be between 1 and %d, got: %d", maxUpcomingDays, days)}
+	}
+	return days, nil
+}
+
+// filterTasksDueBetween returns the tasks due within [from, until], soonest first.
+// A zero from leaves the window open towards the past. Tasks without a due date are skipped.
+func filterTasksDueBetween(tasks []*vikunja.Task, from, until time.Time) []*vikunja.Task {
+	type dueTask struct {
+		task *vikunja.Task
+		due  time.Time
+	}
+
+	matched := make([]dueTask, 0, len(tasks))
+	for _, t := range tasks {
+		due, ok := taskDueDate(t)
+		if !ok || due.After(until) || (!from.IsZero() && due.Before(from)) {
+			continue
+		}
+		matched = append(matched, dueTask{task: t, due: due})
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].due.Before(matched[j].due) })
+
+	result := make([]*vikunja.Task, len(matched))
+	for i, m := range matched {
+		result[i] = m.task
+	}
+	return result
+}
+
+// taskDueDate parses a task's due date. Vikunja reports "no due date" as the zero time.
+func taskDueDate(t *vikunja.Task) (time.Time, bool) {
+	if t.DueDate == "" {
+		return time.Time{}, false
+	}
+	due, err := time.Parse(time.RFC3339, t.DueDate)
+	if err != nil || due.Year() <= 1 {
+		return time.Time{}, false
+	}
+	return due, true
+}
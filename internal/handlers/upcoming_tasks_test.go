@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upcomingTasksServer serves incomplete tasks due around 2026-03-10T12:00:00Z
+func upcomingTasksServer(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks", r.URL.Path)
+		assert.Equal(t, "done = false", r.URL.Query().Get("filter"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"id":1,"title":"Next week","due_date":"2026-03-17T09:00:00Z"},
+			{"id":2,"title":"Tonight","due_date":"2026-03-10T20:00:00Z"},
+			{"id":3,"title":"No due date","due_date":"0001-01-01T00:00:00Z"},
+			{"id":4,"title":"Yesterday","due_date":"2026-03-09T08:00:00Z"},
+			{"id":5,"title":"Tomorrow morning","due_date":"2026-03-11T08:00:00Z"},
+			{"id":6,"title":"In three days","due_date":"2026-03-13T10:00:00Z"}
+		]`) //nolint:errcheck
+	}
+}
+
+func TestListUpcomingTasksHandler_Window(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   ListUpcomingTasksInput
+		wantIDs []int64
+	}{
+		{"defaults to one day", ListUpcomingTasksInput{}, []int64{2, 5}},
+		{"several days", ListUpcomingTasksInput{Days: 3}, []int64{2, 5, 6}},
+		{"overdue included first", ListUpcomingTasksInput{IncludeOverdue: true}, []int64{4, 2, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, nil, upcomingTasksServer(t))
+			h.deps.Now = func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+			_, output, err := h.listUpcomingTasksHandler(t.Context(), nil, tt.input)
+			require.NoError(t, err)
+
+			ids := make([]int64, len(output.Tasks))
+			for i, task := range output.Tasks {
+				ids[i] = task.ID
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.input.IncludeOverdue, output.From == "")
+		})
+	}
+}
+
+func TestListUpcomingTasksHandler_InvalidDays(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	_, _, err := h.listUpcomingTasksHandler(t.Context(), nil, ListUpcomingTasksInput{Days: -2})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "days: must be between 1 and 365")
+}
+
+func TestListUpcomingTasksHandler_ReadsEveryPage(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, pagedTasksServer(t, `[{"id":5,"title":"Tomorrow morning","due_date":"2026-03-11T08:00:00Z"}]`))
+	h.deps.Now = func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+	_, output, err := h.listUpcomingTasksHandler(t.Context(), nil, ListUpcomingTasksInput{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{5}, taskIDs(output.Tasks), "tasks past the first page are listed")
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHandlers_NoRawURIs keeps resource URIs routed through vikunja.TaskURI, ProjectURI and ViewURI
+// so the forms handlers emit cannot drift from the formatter's. Literal example URIs in
+// tool descriptions are fine; format strings that build one are not.
+func TestHandlers_NoRawURIs(t *testing.T) {
+	t.Parallel()
+	rawURI := regexp.MustCompile(`vikunja://[^"\s]*%`)
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		assert.Empty(t, rawURI.FindAllString(string(src), -1), "%s builds a URI by hand", file)
+	}
+}
+
+func TestToTaskSummary_CanonicalURIs(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "vikunja://task/42", toTaskSummary(&vikunja.Task{ID: 42}).URI)
+	assert.Equal(t, "vikunja://project/7", toProject(&vikunja.Project{ID: 7}).URI)
+}
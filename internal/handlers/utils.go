@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/meschbach/mcp-vikunja/internal/config"
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 )
 
@@ -16,7 +18,7 @@ func toTaskSummary(t *vikunja.Task) TaskSummary {
 	return TaskSummary{
 		ID:    t.ID,
 		Title: t.Title,
-		URI:   fmt.Sprintf("vikunja://task/%d", t.ID),
+		URI:   vikunja.TaskURI(t.ID),
 	}
 }
 
@@ -50,9 +52,46 @@ func toTask(t *vikunja.Task) Task {
 		Updated:     t.Updated,
 		Buckets:     toBuckets(t.Buckets),
 		Position:    t.Position,
+		Priority:    t.Priority,
+		HexColor:    t.HexColor,
+		PercentDone: t.PercentDone,
+		StartDate:   t.StartDate,
+		EndDate:     t.EndDate,
 	}
 }
 
+func toProject(p *vikunja.Project) Project {
+	return Project{
+		ID:       p.ID,
+		Title:    p.Title,
+		URI:      vikunja.ProjectURI(p.ID),
+		HexColor: p.HexColor,
+		MaxRight: vikunja.RightForPermission(p.MaxPermission),
+	}
+}
+
+func toLabels(labels []*vikunja.Label) []Label {
+	if labels == nil {
+		return nil
+	}
+	res := make([]Label, len(labels))
+	for i, l := range labels {
+		res[i] = Label{ID: l.ID, Title: l.Title, HexColor: l.HexColor}
+	}
+	return res
+}
+
+func toAssignees(users []*vikunja.User) []Assignee {
+	if users == nil {
+		return nil
+	}
+	res := make([]Assignee, len(users))
+	for i, u := range users {
+		res[i] = Assignee{ID: u.ID, Username: u.Username, Name: u.Name}
+	}
+	return res
+}
+
 func toBucket(b *vikunja.Bucket) Bucket {
 	return Bucket{
 		ID:            b.ID,
@@ -83,7 +122,7 @@ func toView(v *vikunja.ProjectView) View {
 		BucketConfigurationMode: v.BucketConfigurationMode,
 		DefaultBucketID:         v.DefaultBucketID,
 		DoneBucketID:            v.DoneBucketID,
-		URI:                     fmt.Sprintf("vikunja://project/%d/view/%d", v.ProjectID, v.ID),
+		URI:                     vikunja.ViewURI(v.ProjectID, v.ID),
 	}
 }
 
@@ -117,28 +156,78 @@ func toVikunjaBuckets(buckets []Bucket) []*vikunja.Bucket {
 
 // Client creation and utility functions
 
-func createVikunjaClient() (*vikunja.Client, error) {
+// createVikunjaClient creates a client for the configured Vikunja server and token, reading both
+// from the environment when the configuration lacks them
+func createVikunjaClient(cfg *config.Config, opts vikunja.ClientOptions) (*vikunja.Client, error) {
+	if cfg != nil && cfg.Vikunja.Host != "" && cfg.Vikunja.Token != "" {
+		return vikunja.NewClientWithOptions(cfg.Vikunja.Host, cfg.Vikunja.Token, cfg.Vikunja.Insecure, opts)
+	}
+
 	host := os.Getenv("VIKUNJA_HOST")
-	token := os.Getenv("VIKUNJA_TOKEN")
+	token, err := config.LoadVikunjaToken()
+	if err != nil {
+		return nil, err
+	}
 	if host == "" || token == "" {
-		return nil, fmt.Errorf("VIKUNJA_HOST and VIKUNJA_TOKEN environment variables required")
+		return nil, fmt.Errorf("VIKUNJA_HOST and VIKUNJA_TOKEN or VIKUNJA_TOKEN_FILE environment variables required")
 	}
 
 	insecure := os.Getenv("VIKUNJA_INSECURE") == "true"
-	return vikunja.NewClient(host, token, insecure)
+	return vikunja.NewClientWithOptions(host, token, insecure, opts)
+}
+
+// vikunjaClient returns the injected client, falling back to one configured from the environment.
+// In dry-run mode the client records writes instead of sending them.
+func (h *Handlers) vikunjaClient() (*vikunja.Client, error) {
+	client := h.deps.Client
+	if client == nil {
+		var err error
+		if client, err = h.envClient(); err != nil {
+			return nil, err
+		}
+	}
+	if h.deps.DryRun {
+		return client.WithDryRun(), nil
+	}
+	return client, nil
+}
+
+// envClient returns the client configured from the environment, creating it on first use.
+// The client is kept so every tool call shares its connection pool.
+func (h *Handlers) envClient() (*vikunja.Client, error) {
+	h.clientMu.Lock()
+	defer h.clientMu.Unlock()
+	if h.client != nil {
+		return h.client, nil
+	}
+
+	opts := vikunja.DefaultClientOptions()
+	if h.deps.Config != nil {
+		opts = h.deps.Config.Vikunja.ClientOptions()
+	}
+	opts.Logger = h.deps.Logger
+	if h.deps.Metrics != nil {
+		opts.Observer = h.deps.Metrics
+	}
+	client, err := createVikunjaClient(h.deps.Config, opts)
+	if err != nil {
+		return nil, err
+	}
+	h.client = client
+	return client, nil
 }
 
 // findProjectByIDOrTitle finds a project by ID or title
-func findProjectByIDOrTitle(ctx context.Context, client *vikunja.Client, projectID, projectTitle string) (*Project, error) {
+func (h *Handlers) findProjectByIDOrTitle(ctx context.Context, client *vikunja.Client, projectID, projectTitle string) (*Project, error) {
 	if projectID != "" {
-		id, err := strconv.ParseInt(projectID, 10, 64)
+		id, err := parseIDAllowingSpecial("project_id", projectID)
 		if err != nil {
-			return nil, fmt.Errorf("invalid project_id: %s", projectID)
+			return nil, err
 		}
 		return &Project{
 			ID:    id,
 			Title: fmt.Sprintf("Project %d", id),
-			URI:   fmt.Sprintf("vikunja://project/%d", id),
+			URI:   vikunja.ProjectURI(id),
 		}, nil
 	}
 
@@ -146,30 +235,14 @@ func findProjectByIDOrTitle(ctx context.Context, client *vikunja.Client, project
 		return nil, fmt.Errorf("either project_id or project_title must be specified")
 	}
 
-	projects, err := client.GetProjects(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list projects: %w", err)
-	}
-
-	matches := findProjectsByTitle(projects, projectTitle)
-	if len(matches) == 0 {
-		return nil, enhancedProjectNotFoundError(projectTitle, extractProjectTitles(projects))
-	}
-	if len(matches) > 1 {
-		return nil, fmt.Errorf("multiple projects found with title %q, please use project ID", projectTitle)
-	}
-	return &matches[0], nil
+	return h.projectByTitle(ctx, client, projectTitle)
 }
 
 func findProjectsByTitle(projects []*vikunja.Project, title string) []Project {
 	var matches []Project
 	for _, p := range projects {
 		if p.Title == title {
-			matches = append(matches, Project{
-				ID:    p.ID,
-				Title: p.Title,
-				URI:   fmt.Sprintf("vikunja://project/%d", p.ID),
-			})
+			matches = append(matches, toProject(p))
 		}
 	}
 	return matches
@@ -188,10 +261,7 @@ func findViewByName(views []*vikunja.ProjectView, viewName string, fuzzy bool, p
 	var viewTitles []string
 	for _, v := range views {
 		viewTitles = append(viewTitles, v.Title)
-		if fuzzy && containsIgnoreCase(v.Title, viewName) {
-			return v, nil
-		}
-		if !fuzzy && v.Title == viewName {
+		if viewNameMatches(v.Title, viewName, fuzzy) {
 			return v, nil
 		}
 	}
@@ -267,19 +337,64 @@ func parseID(fieldName, value string) (int64, error) {
 	return id, nil
 }
 
+// parseIDAllowingSpecial parses a project ID like parseID, but also accepts Vikunja's
+// pseudo-project IDs: the Favorites project (-1) and saved filters (below -1).
+// Use it only where pseudo-projects are valid; task, bucket and view IDs stay strict.
+func parseIDAllowingSpecial(fieldName, value string) (int64, error) {
+	if value == "" {
+		return 0, ValidationError{Field: fieldName, Message: "is required"}
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, ValidationError{Field: fieldName, Message: fmt.Sprintf("must be a valid integer, got: %s", value)}
+	}
+	if id == 0 {
+		return 0, ValidationError{Field: fieldName, Message: "must be a positive integer, -1 (Favorites) or a saved filter project ID, got: 0"}
+	}
+	return id, nil
+}
+
 // validateViewKind checks if a view kind is valid
 func validateViewKind(kind string) error {
 	if kind == "" {
 		return nil // Optional field
 	}
-	validKinds := map[string]bool{
-		"list":   true,
-		"kanban": true,
-		"gantt":  true,
-		"table":  true,
-	}
-	if !validKinds[kind] {
+	if !slices.Contains(vikunja.ViewKinds(), kind) {
 		return ValidationError{Field: "view_kind", Message: fmt.Sprintf("must be one of: list, kanban, gantt, table. Got: %s", kind)}
 	}
 	return nil
 }
+
+// validateBucketConfigurationMode checks if a bucket configuration mode is valid
+func validateBucketConfigurationMode(mode string) error {
+	if mode == "" {
+		return nil // Optional field
+	}
+	if !slices.Contains(vikunja.BucketConfigurationModes(), mode) {
+		return ValidationError{Field: "bucket_configuration_mode", Message: fmt.Sprintf("must be one of: none, manual, filter. Got: %s", mode)}
+	}
+	return nil
+}
+
+// enhancedBucketIDNotFoundError provides contextual error message with available buckets in a view
+func enhancedBucketIDNotFoundError(bucketID, viewID int64, availableBuckets []string) error {
+	return fmt.Errorf("bucket with ID %d not found in view %d.%s Try: list_buckets() to see view buckets", bucketID, viewID, bucketSuggestion(viewID, availableBuckets))
+}
+
+// enhancedBucketTitleNotFoundError creates a helpful error message for bucket title not found
+func enhancedBucketTitleNotFoundError(title string, viewID int64, availableBuckets []string) error {
+	return fmt.Errorf("bucket with title %q not found in view %d.%s Try: list_buckets() to see view buckets", title, viewID, bucketSuggestion(viewID, availableBuckets))
+}
+
+// bucketSuggestion lists some of the view's buckets for a not-found error
+func bucketSuggestion(viewID int64, availableBuckets []string) string {
+	switch {
+	case len(availableBuckets) == 0:
+		return ""
+	case len(availableBuckets) <= 3:
+		return fmt.Sprintf(" Available buckets in view %d: %v", viewID, availableBuckets)
+	default:
+		return fmt.Sprintf(" Available buckets in view %d include: %s, %s, and %d others",
+			viewID, availableBuckets[0], availableBuckets[1], len(availableBuckets)-2)
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseID_PseudoProjects(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value         string
+		strictOK      bool
+		allowsSpecial bool
+	}{
+		{value: "7", strictOK: true, allowsSpecial: true},
+		{value: "-1", strictOK: false, allowsSpecial: true},
+		{value: "-5", strictOK: false, allowsSpecial: true},
+		{value: "0", strictOK: false, allowsSpecial: false},
+		{value: "abc", strictOK: false, allowsSpecial: false},
+		{value: "", strictOK: false, allowsSpecial: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+			id, err := parseID("project_id", tt.value)
+			if tt.strictOK {
+				require.NoError(t, err)
+				assert.Positive(t, id)
+			} else {
+				require.Error(t, err)
+			}
+
+			_, err = parseIDAllowingSpecial("project_id", tt.value)
+			if tt.allowsSpecial {
+				require.NoError(t, err)
+			} else {
+				var validationErr ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, "project_id", validationErr.Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"errors"
+	"strings"
+)
+
+// ValidationErrors collects the problems of several fields so a caller can fix them all at once
+// instead of one call at a time. errors.As finds each collected ValidationError.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap exposes the collected field errors to errors.Is and errors.As
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fieldErr := range e {
+		errs[i] = fieldErr
+	}
+	return errs
+}
+
+// add collects err, a ValidationError or ValidationErrors as returned by the validate and parse
+// helpers; nil is ignored
+func (e *ValidationErrors) add(err error) {
+	var many ValidationErrors
+	var one ValidationError
+	switch {
+	case err == nil:
+	case errors.As(err, &many):
+		*e = append(*e, many...)
+	case errors.As(err, &one):
+		*e = append(*e, one)
+	default:
+		*e = append(*e, ValidationError{Field: "input", Message: err.Error()})
+	}
+}
+
+// parseID parses value like the package level parseID, collecting any problem; the ID is 0 then
+func (e *ValidationErrors) parseID(fieldName, value string) int64 {
+	id, err := parseID(fieldName, value)
+	e.add(err)
+	return id
+}
+
+// err returns the collected errors, or nil when there are none
+func (e ValidationErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationErrors(t *testing.T) {
+	t.Parallel()
+	var errs ValidationErrors
+	errs.add(nil)
+	require.NoError(t, errs.err())
+
+	errs.add(validateRequiredString("project_id", ""))
+	errs.add(ValidationErrors{{Field: "view_id", Message: "must be a valid integer"}, {Field: "title", Message: "is required"}})
+	errs.add(errors.New("boom"))
+
+	err := errs.err()
+	require.Error(t, err)
+	assert.Equal(t, "project_id: is required; view_id: must be a valid integer; title: is required; input: boom", err.Error())
+
+	var fieldErr ValidationError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "project_id", fieldErr.Field)
+}
+
+func TestMoveTaskToBucketHandler_ReportsAllFieldProblems(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.moveTaskToBucketHandler(t.Context(), nil, MoveTaskToBucketInput{TaskID: "42", ViewID: "kanban", BucketID: "10"})
+	require.Error(t, err)
+	assert.Equal(t, "project_id: is required; view_id: must be a valid integer, got: kanban", err.Error())
+	assert.True(t, result.IsError)
+}
+
+func TestCreateTaskHandler_ReportsAllFieldProblems(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	_, _, err := h.createTaskHandler(t.Context(), nil, CreateTaskInput{BucketID: "-3"})
+	require.Error(t, err)
+	assert.Equal(t, "title: is required; project_id: is required; bucket_id: must be a positive integer", err.Error())
+}
@@ -15,12 +15,16 @@ func (h *Handlers) findViewHandler(ctx context.Context, _ *mcp.CallToolRequest,
 		return h.buildErrorResult(err.Error()), FindViewOutput{}, err
 	}
 
-	client, err := createVikunjaClient()
+	client, err := h.vikunjaClient()
 	if err != nil {
 		return nil, FindViewOutput{}, fmt.Errorf("failed to create client: %w", err)
 	}
 
-	project, err := findProjectByIDOrTitle(ctx, client, input.ProjectID, input.ProjectTitle)
+	if input.AllProjects && input.ProjectID == "" && input.ProjectTitle == "" {
+		return h.findViewInAllProjects(ctx, client, input)
+	}
+
+	project, err := h.findProjectByIDOrTitle(ctx, client, input.ProjectID, input.ProjectTitle)
 	if err != nil {
 		return h.buildErrorResult(err.Error()), FindViewOutput{}, err
 	}
@@ -77,7 +81,7 @@ func (h *Handlers) listViewsHandler(ctx context.Context, _ *mcp.CallToolRequest,
 		return h.buildErrorResult(err.Error()), ListViewsOutput{}, err
 	}
 
-	client, err := createVikunjaClient()
+	client, err := h.vikunjaClient()
 	if err != nil {
 		return nil, ListViewsOutput{}, fmt.Errorf("failed to create client: %w", err)
 	}
@@ -93,7 +97,7 @@ func (h *Handlers) listViewsHandler(ctx context.Context, _ *mcp.CallToolRequest,
 }
 
 func (h *Handlers) resolveProjectAndViews(ctx context.Context, client *vikunja.Client, input ListViewsInput) (*Project, []*vikunja.ProjectView, error) {
-	project, err := findProjectByIDOrTitle(ctx, client, input.ProjectID, input.ProjectTitle)
+	project, err := h.findProjectByIDOrTitle(ctx, client, input.ProjectID, input.ProjectTitle)
 	if err != nil {
 		return nil, nil, err
 	}
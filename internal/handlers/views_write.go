@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// viewSettings holds the user-editable view fields shared by create_view and update_view
+type viewSettings struct {
+	title           string
+	viewKind        string
+	bucketMode      string
+	defaultBucketID string
+	doneBucketID    string
+}
+
+// createViewHandler handles the create_view tool
+func (h *Handlers) createViewHandler(ctx context.Context, _ *mcp.CallToolRequest, input CreateViewInput) (*mcp.CallToolResult, CreateViewOutput, error) {
+	if h.isReadonlyFor("create_view") {
+		return h.buildErrorResult("Operation not available in readonly mode"), CreateViewOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	view, err := validateCreateViewInput(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateViewOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, CreateViewOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	project, err := h.findProjectByIDOrTitle(ctx, client, input.ProjectID, input.ProjectTitle)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateViewOutput{}, err
+	}
+
+	view.ProjectID = project.ID
+	created, err := client.CreateProjectView(ctx, project.ID, view)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateViewOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, CreateViewOutput{Planned: planned}, err
+	}
+
+	output := CreateViewOutput{Project: *project, View: toView(created)}
+	result, err := h.formatViewResult(project, created)
+	if err != nil {
+		return nil, CreateViewOutput{}, err
+	}
+	return result, output, nil
+}
+
+// updateViewHandler handles the update_view tool
+func (h *Handlers) updateViewHandler(ctx context.Context, _ *mcp.CallToolRequest, input UpdateViewInput) (*mcp.CallToolResult, UpdateViewOutput, error) {
+	if h.isReadonlyFor("update_view") {
+		return h.buildErrorResult("Operation not available in readonly mode"), UpdateViewOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	viewID, err := parseID("view_id", input.ViewID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), UpdateViewOutput{}, err
+	}
+	settings := viewSettings{input.Title, input.ViewKind, input.BucketConfigurationMode, input.DefaultBucketID, input.DoneBucketID}
+	if err := settings.validate(); err != nil {
+		return h.buildErrorResult(err.Error()), UpdateViewOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, UpdateViewOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	project, existing, err := h.findProjectViewByID(ctx, client, input.ProjectID, input.ProjectTitle, viewID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), UpdateViewOutput{}, err
+	}
+
+	// Vikunja replaces the whole view on update, so start from the stored settings
+	if err := settings.applyTo(existing); err != nil {
+		return h.buildErrorResult(err.Error()), UpdateViewOutput{}, err
+	}
+	updated, err := client.UpdateProjectView(ctx, project.ID, viewID, existing)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), UpdateViewOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, UpdateViewOutput{Planned: planned}, err
+	}
+
+	output := UpdateViewOutput{Project: *project, View: toView(updated)}
+	result, err := h.formatViewResult(project, updated)
+	if err != nil {
+		return nil, UpdateViewOutput{}, err
+	}
+	return result, output, nil
+}
+
+func validateCreateViewInput(input CreateViewInput) (*vikunja.ProjectView, error) {
+	var errs ValidationErrors
+	errs.add(validateRequiredString("title", input.Title))
+	errs.add(validateRequiredString("view_kind", input.ViewKind))
+	settings := viewSettings{input.Title, input.ViewKind, input.BucketConfigurationMode, input.DefaultBucketID, input.DoneBucketID}
+	errs.add(settings.validate())
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+
+	view := &vikunja.ProjectView{}
+	if err := settings.applyTo(view); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (s viewSettings) validate() error {
+	var errs ValidationErrors
+	errs.add(validateViewKind(s.viewKind))
+	errs.add(validateBucketConfigurationMode(s.bucketMode))
+	return errs.err()
+}
+
+// applyTo copies every non-empty setting onto view
+func (s viewSettings) applyTo(view *vikunja.ProjectView) error {
+	if s.title != "" {
+		view.Title = s.title
+	}
+	if s.viewKind != "" {
+		view.ViewKind = s.viewKind
+	}
+	if s.bucketMode != "" {
+		view.BucketConfigurationMode = s.bucketMode
+	}
+	if s.defaultBucketID != "" {
+		id, err := parseID("default_bucket_id", s.defaultBucketID)
+		if err != nil {
+			return err
+		}
+		view.DefaultBucketID = id
+	}
+	if s.doneBucketID != "" {
+		id, err := parseID("done_bucket_id", s.doneBucketID)
+		if err != nil {
+			return err
+		}
+		view.DoneBucketID = id
+	}
+	return nil
+}
+
+// findProjectViewByID resolves the project and returns the stored view with the given ID
+func (h *Handlers) findProjectViewByID(ctx context.Context, client *vikunja.Client, projectID, projectTitle string, viewID int64) (*Project, *vikunja.ProjectView, error) {
+	project, err := h.findProjectByIDOrTitle(ctx, client, projectID, projectTitle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	views, err := client.GetProjectViews(ctx, project.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get project views: %w", err)
+	}
+	for _, v := range views {
+		if v.ID == viewID {
+			return project, v, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("view %d not found in project %q. Try: list_views() to see project views", viewID, project.Title)
+}
+
+func (h *Handlers) formatViewResult(project *Project, view *vikunja.ProjectView) (*mcp.CallToolResult, error) {
+	data, err := h.deps.OutputFormatter.Format(vikunja.ViewOutput{
+		Project: vikunja.Project{ID: project.ID, Title: project.Title},
+		View:    *view,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, nil
+}
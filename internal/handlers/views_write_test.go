@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCreateViewInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   CreateViewInput
+		wantErr string
+	}{
+		{"list", CreateViewInput{Title: "Todo", ViewKind: "list"}, ""},
+		{"kanban", CreateViewInput{Title: "Board", ViewKind: "kanban", BucketConfigurationMode: "manual"}, ""},
+		{"gantt", CreateViewInput{Title: "Plan", ViewKind: "gantt"}, ""},
+		{"table", CreateViewInput{Title: "Grid", ViewKind: "table"}, ""},
+		{"missing title", CreateViewInput{ViewKind: "list"}, "title: is required"},
+		{"missing kind", CreateViewInput{Title: "Todo"}, "view_kind: is required"},
+		{"unknown kind", CreateViewInput{Title: "Todo", ViewKind: "calendar"}, "view_kind: must be one of: list, kanban, gantt, table. Got: calendar"},
+		{"wrong case kind", CreateViewInput{Title: "Todo", ViewKind: "Kanban"}, "view_kind: must be one of"},
+		{"unknown bucket mode", CreateViewInput{Title: "Board", ViewKind: "kanban", BucketConfigurationMode: "auto"}, "bucket_configuration_mode: must be one of: none, manual, filter"},
+		{"bad done bucket", CreateViewInput{Title: "Board", ViewKind: "kanban", DoneBucketID: "x"}, "done_bucket_id: must be a valid integer"},
+		{"negative default bucket", CreateViewInput{Title: "Board", ViewKind: "kanban", DefaultBucketID: "-1"}, "default_bucket_id: must be a positive integer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			view, err := validateCreateViewInput(tt.input)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.input.Title, view.Title)
+				assert.Equal(t, tt.input.ViewKind, view.ViewKind)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCreateViewHandler_Readonly(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{Readonly: true}, unexpectedRequest(t))
+
+	result, _, err := h.createViewHandler(t.Context(), nil, CreateViewInput{ProjectID: "7", Title: "Board", ViewKind: "kanban"})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestCreateViewHandler_CreatesView(t *testing.T) {
+	t.Parallel()
+	var received vikunja.ProjectView
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/projects/7/views", r.URL.Path)
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":12,"project_id":7,"title":"Board","view_kind":"kanban","bucket_configuration_mode":"manual","done_bucket_id":3}`) //nolint:errcheck
+	})
+
+	_, output, err := h.createViewHandler(t.Context(), nil, CreateViewInput{
+		ProjectID:               "7",
+		Title:                   "Board",
+		ViewKind:                "kanban",
+		BucketConfigurationMode: "manual",
+		DoneBucketID:            "3",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(7), received.ProjectID)
+	assert.Equal(t, int64(3), received.DoneBucketID)
+	assert.Equal(t, int64(12), output.View.ID)
+	assert.Equal(t, "vikunja://project/7/view/12", output.View.URI)
+}
+
+func TestUpdateViewHandler_KeepsUnsetFields(t *testing.T) {
+	t.Parallel()
+	var received vikunja.ProjectView
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":12,"project_id":7,"title":"Board","view_kind":"kanban","bucket_configuration_mode":"manual","default_bucket_id":2}]`) //nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/projects/7/views/12":
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			fmt.Fprint(w, `{"id":12,"project_id":7,"title":"Board","view_kind":"kanban","default_bucket_id":2,"done_bucket_id":5}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, output, err := h.updateViewHandler(t.Context(), nil, UpdateViewInput{ProjectID: "7", ViewID: "12", DoneBucketID: "5"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Board", received.Title)
+	assert.Equal(t, int64(2), received.DefaultBucketID)
+	assert.Equal(t, int64(5), received.DoneBucketID)
+	assert.Equal(t, int64(5), output.View.DoneBucketID)
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// defaultWatchInterval is the wait between polls when a call does not set interval_seconds
+	defaultWatchInterval = 5 * time.Second
+	// maxWatchInterval bounds interval_seconds
+	maxWatchInterval = time.Minute
+	// defaultWatchTimeout is how long a watch lasts when a call does not set timeout_seconds
+	defaultWatchTimeout = time.Minute
+	// maxWatchTimeout bounds timeout_seconds, so one call cannot hold the connection indefinitely
+	maxWatchTimeout = 5 * time.Minute
+	// watchPollAllowance is the time left before the tool call's deadline for the last poll's requests
+	watchPollAllowance = 5 * time.Second
+)
+
+// watchProjectHandler handles the watch_project tool: it polls a board until one of its tasks
+// changes or the watch times out, and returns what changed.
+func (h *Handlers) watchProjectHandler(ctx context.Context, _ *mcp.CallToolRequest, input WatchProjectInput) (*mcp.CallToolResult, WatchProjectOutput, error) {
+	interval, timeout, err := parseWatchDurations(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), WatchProjectOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, WatchProjectOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	_, projectID, err := h.resolveProjectByValue(ctx, client, input.Project)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), WatchProjectOutput{}, err
+	}
+
+	view, err := h.resolveView(ctx, client, projectID, input.View)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), WatchProjectOutput{}, err
+	}
+
+	output, err := h.watchBoard(ctx, client, projectID, view, interval, watchDeadline(ctx, timeout))
+	if err != nil {
+		return h.buildErrorResult(err.Error()), WatchProjectOutput{}, err
+	}
+
+	result, err := h.formatResult(output)
+	if err != nil {
+		return nil, WatchProjectOutput{}, err
+	}
+	return result, output, nil
+}
+
+func parseWatchDurations(input WatchProjectInput) (interval, timeout time.Duration, err error) {
+	var errs ValidationErrors
+	interval, err = watchSeconds("interval_seconds", input.IntervalSeconds, defaultWatchInterval, maxWatchInterval)
+	errs.add(err)
+	timeout, err = watchSeconds("timeout_seconds", input.TimeoutSeconds, defaultWatchTimeout, maxWatchTimeout)
+	errs.add(err)
+	return interval, timeout, errs.err()
+}
+
+// watchSeconds returns the requested duration, or def when the call does not set one
+func watchSeconds(field string, requested int, def, maximum time.Duration) (time.Duration, error) {
+	limit := int(maximum / time.Second)
+	switch {
+	case requested < 0 || requested > limit:
+		return 0, ValidationError{Field: field, Message: fmt.Sprintf("must be between 1 and %d, got: %d", limit, requested)}
+	case requested > 0:
+		return time.Duration(requested) * time.Second, nil
+	default:
+		return def, nil
+	}
+}
+
+// watchDeadline returns when a watch of the given timeout ends. A watch ends early enough for its
+// last poll to finish within the tool call, so a quiet board is reported as unchanged rather than
+// as a timed out call.
+func watchDeadline(ctx context.Context, timeout time.Duration) time.Time {
+	deadline := time.Now().Add(timeout)
+	if callDeadline, ok := ctx.Deadline(); ok && callDeadline.Add(-watchPollAllowance).Before(deadline) {
+		return callDeadline.Add(-watchPollAllowance)
+	}
+	return deadline
+}
+
+// watchBoard polls a view every interval until its tasks differ from the first poll or deadline
+// passes. The last wait is shortened so the view is polled once more at the deadline.
+func (h *Handlers) watchBoard(ctx context.Context, client *vikunja.Client, projectID int64, view *vikunja.ProjectView, interval time.Duration, deadline time.Time) (WatchProjectOutput, error) {
+	output := WatchProjectOutput{ProjectID: projectID, ViewID: view.ID, Polls: 1}
+	before, err := h.boardSnapshot(ctx, client, projectID, view)
+	if err != nil {
+		return WatchProjectOutput{}, err
+	}
+
+	for {
+		wait := min(interval, time.Until(deadline))
+		if wait <= 0 {
+			return output, nil
+		}
+		if err := waitContext(ctx, wait); err != nil {
+			return WatchProjectOutput{}, err
+		}
+		after, err := h.boardSnapshot(ctx, client, projectID, view)
+		if err != nil {
+			return WatchProjectOutput{}, err
+		}
+		output.Polls++
+		diffBoards(&output, before, after)
+		if output.Changed {
+			return output, nil
+		}
+	}
+}
+
+// boardTask is a task as last seen on a watched board, with the title of the bucket holding it
+type boardTask struct {
+	task   *vikunja.Task
+	bucket string
+}
+
+// boardSnapshot returns the tasks of a view by ID
+func (h *Handlers) boardSnapshot(ctx context.Context, client *vikunja.Client, projectID int64, view *vikunja.ProjectView) (map[int64]boardTask, error) {
+	response, err := h.getViewTasks(ctx, client, projectID, view, 0, "")
+	if err != nil {
+		return nil, err
+	}
+	snapshot := make(map[int64]boardTask, len(response.Tasks))
+	for _, t := range response.Tasks {
+		snapshot[t.ID] = boardTask{task: t}
+	}
+	for _, b := range response.Buckets {
+		for _, t := range b.Tasks {
+			snapshot[t.ID] = boardTask{task: t, bucket: b.Title}
+		}
+	}
+	return snapshot, nil
+}
+
+// diffBoards records in output the tasks added to, changed on and removed from a board between two
+// snapshots. A task counts as changed when its updated timestamp differs or it moved bucket.
+func diffBoards(output *WatchProjectOutput, before, after map[int64]boardTask) {
+	for id, now := range after {
+		then, seen := before[id]
+		switch {
+		case !seen:
+			output.Added = append(output.Added, WatchedTask{Task: toTask(now.task), Bucket: now.bucket})
+		case then.bucket != now.bucket:
+			output.Updated = append(output.Updated, WatchedTask{Task: toTask(now.task), Bucket: now.bucket, PreviousBucket: then.bucket})
+		case then.task.Updated != now.task.Updated:
+			output.Updated = append(output.Updated, WatchedTask{Task: toTask(now.task), Bucket: now.bucket})
+		}
+	}
+	for id, then := range before {
+		if _, kept := after[id]; !kept {
+			output.Removed = append(output.Removed, WatchedTask{Task: toTask(then.task), Bucket: then.bucket})
+		}
+	}
+	for _, tasks := range [][]WatchedTask{output.Added, output.Updated, output.Removed} {
+		slices.SortFunc(tasks, func(a, b WatchedTask) int { return cmp.Compare(a.Task.ID, b.Task.ID) })
+	}
+	output.Changed = len(output.Added)+len(output.Updated)+len(output.Removed) > 0
+}
+
+// waitContext waits for d, returning early with the context's error when ctx is done
+func waitContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
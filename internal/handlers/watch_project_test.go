@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// watchedBoardServer serves project 7 with kanban view 3, calling onPoll with the number of each
+// read of the view's tasks. From the second read, task 1 has moved to Done and task 3 was added.
+func watchedBoardServer(t *testing.T, onPoll func(int64)) http.HandlerFunc {
+	t.Helper()
+	var polls atomic.Int64
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/7":
+			fmt.Fprint(w, `{"id":7,"title":"Board"}`) //nolint:errcheck
+		case "/api/v1/projects/7/views":
+			fmt.Fprint(w, `[{"id":3,"title":"Kanban","project_id":7,"view_kind":"kanban"}]`) //nolint:errcheck
+		case "/api/v1/projects/7/views/3/tasks":
+			poll := polls.Add(1)
+			onPoll(poll)
+			if poll == 1 {
+				fmt.Fprint(w, `[{"id":10,"title":"Todo","tasks":[`+ //nolint:errcheck
+					`{"id":1,"title":"Write report","updated":"2026-03-10T09:00:00Z"},{"id":2,"title":"Book venue","updated":"2026-03-10T09:00:00Z"}]},`+
+					`{"id":12,"title":"Done","tasks":[]}]`)
+				return
+			}
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","tasks":[`+ //nolint:errcheck
+				`{"id":2,"title":"Book venue","updated":"2026-03-10T09:00:00Z"},{"id":3,"title":"Call caterer","updated":"2026-03-10T09:05:00Z"}]},`+
+				`{"id":12,"title":"Done","tasks":[{"id":1,"title":"Write report","done":true,"updated":"2026-03-10T09:05:00Z"}]}]`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestWatchProjectHandler_ReturnsDiff(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, watchedBoardServer(t, func(int64) {}))
+
+	_, output, err := h.watchProjectHandler(t.Context(), nil, WatchProjectInput{Project: "7", IntervalSeconds: 1, TimeoutSeconds: 10})
+	require.NoError(t, err)
+
+	assert.True(t, output.Changed)
+	assert.Equal(t, 2, output.Polls, "the watch ends at the first poll seeing a change")
+	assert.Equal(t, int64(3), output.ViewID)
+	require.Len(t, output.Added, 1)
+	assert.Equal(t, "Call caterer", output.Added[0].Task.Title)
+	assert.Equal(t, "Todo", output.Added[0].Bucket)
+	require.Len(t, output.Updated, 1)
+	assert.Equal(t, int64(1), output.Updated[0].Task.ID)
+	assert.Equal(t, "Done", output.Updated[0].Bucket)
+	assert.Equal(t, "Todo", output.Updated[0].PreviousBucket)
+	assert.Empty(t, output.Removed)
+}
+
+func TestWatchProjectHandler_TimeoutShorterThanInterval(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, watchedBoardServer(t, func(int64) {}))
+
+	_, output, err := h.watchProjectHandler(t.Context(), nil, WatchProjectInput{Project: "7", IntervalSeconds: 5, TimeoutSeconds: 1})
+	require.NoError(t, err)
+
+	assert.True(t, output.Changed, "the board is polled again when the timeout ends")
+	assert.Equal(t, 2, output.Polls)
+}
+
+func TestWatchProjectHandler_Unchanged(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, boardServer(t))
+
+	_, output, err := h.watchProjectHandler(t.Context(), nil, WatchProjectInput{Project: "7", TimeoutSeconds: 1})
+	require.NoError(t, err)
+
+	assert.False(t, output.Changed)
+	assert.Equal(t, 2, output.Polls)
+	assert.Empty(t, output.Added)
+	assert.Empty(t, output.Updated)
+	assert.Empty(t, output.Removed)
+}
+
+func TestWatchProjectHandler_StopsWhenCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(t.Context())
+	h := newTestHandlers(t, nil, watchedBoardServer(t, func(poll int64) {
+		if poll > 1 {
+			t.Errorf("the board was polled again after the call was canceled")
+		}
+		cancel()
+	}))
+
+	_, _, err := h.watchProjectHandler(ctx, nil, WatchProjectInput{Project: "7", IntervalSeconds: 1, TimeoutSeconds: 10})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWatchProjectHandler_RejectsOutOfRangeDurations(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, unexpectedRequest(t))
+
+	result, _, err := h.watchProjectHandler(t.Context(), nil, WatchProjectInput{Project: "7", IntervalSeconds: -1, TimeoutSeconds: 301})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "interval_seconds: must be between 1 and 60, got: -1")
+	assert.Contains(t, err.Error(), "timeout_seconds: must be between 1 and 300, got: 301")
+}
+
+func TestRegister_WatchProjectOnlyOverHTTP(t *testing.T) {
+	t.Parallel()
+	stdio := connectRegistered(t, &config.Config{OutputFormat: vikunja.OutputFormatJSON, Transport: config.TransportStdio})
+	assert.NotContains(t, toolNames(t, stdio), "watch_project")
+
+	overHTTP := connectRegistered(t, &config.Config{OutputFormat: vikunja.OutputFormatJSON, Transport: config.TransportHTTP})
+	assert.Contains(t, toolNames(t, overHTTP), "watch_project")
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// watchTaskHandler handles the watch_task tool
+func (h *Handlers) watchTaskHandler(ctx context.Context, _ *mcp.CallToolRequest, input WatchTaskInput) (*mcp.CallToolResult, WatchTaskOutput, error) {
+	if h.isReadonlyFor("watch_task") {
+		return h.buildErrorResult("Operation not available in readonly mode"), WatchTaskOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	taskID, err := parseID("task_id", input.TaskID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), WatchTaskOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, WatchTaskOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if _, err := client.SubscribeToTask(ctx, taskID); err != nil {
+		return h.buildErrorResult(err.Error()), WatchTaskOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, WatchTaskOutput{Planned: planned}, err
+	}
+
+	return h.formatWatchTaskOutput(WatchTaskOutput{
+		TaskID:     taskID,
+		Subscribed: true,
+		Message:    fmt.Sprintf("Now watching task %d; you will be notified about its changes", taskID),
+	})
+}
+
+// unwatchTaskHandler handles the unwatch_task tool
+func (h *Handlers) unwatchTaskHandler(ctx context.Context, _ *mcp.CallToolRequest, input UnwatchTaskInput) (*mcp.CallToolResult, WatchTaskOutput, error) {
+	if h.isReadonlyFor("unwatch_task") {
+		return h.buildErrorResult("Operation not available in readonly mode"), WatchTaskOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	taskID, err := parseID("task_id", input.TaskID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), WatchTaskOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, WatchTaskOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := client.UnsubscribeFromTask(ctx, taskID); err != nil {
+		return h.buildErrorResult(err.Error()), WatchTaskOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, WatchTaskOutput{Planned: planned}, err
+	}
+
+	return h.formatWatchTaskOutput(WatchTaskOutput{
+		TaskID:  taskID,
+		Message: fmt.Sprintf("Stopped watching task %d", taskID),
+	})
+}
+
+// formatWatchTaskOutput renders the outcome of watch_task or unwatch_task
+func (h *Handlers) formatWatchTaskOutput(output WatchTaskOutput) (*mcp.CallToolResult, WatchTaskOutput, error) {
+	result, err := h.formatResult(output)
+	if err != nil {
+		return nil, WatchTaskOutput{}, err
+	}
+	return result, output, nil
+}
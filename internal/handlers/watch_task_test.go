@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchTaskHandlers_Rejected(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		taskID  string
+		wantErr string
+	}{
+		{"readonly", &config.Config{Readonly: true}, "5", "readonly mode"},
+		{"missing task", nil, "", "task_id: is required"},
+		{"bad task", nil, "five", "task_id: must be a valid integer"},
+		{"negative task", nil, "-5", "task_id: must be a positive integer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := newTestHandlers(t, tt.cfg, unexpectedRequest(t))
+
+			result, _, err := h.watchTaskHandler(t.Context(), nil, WatchTaskInput{TaskID: tt.taskID})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.True(t, result.IsError)
+
+			result, _, err = h.unwatchTaskHandler(t.Context(), nil, UnwatchTaskInput{TaskID: tt.taskID})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestWatchTaskHandler(t *testing.T) {
+	t.Parallel()
+	var method string
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/subscriptions/task/5" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		method = r.Method
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusCreated)
+		}
+		w.Write([]byte(`{"id":9,"entity":2,"entity_id":5}`)) //nolint:errcheck
+	})
+
+	_, output, err := h.watchTaskHandler(t.Context(), nil, WatchTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.True(t, output.Subscribed)
+
+	_, output, err = h.unwatchTaskHandler(t.Context(), nil, UnwatchTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodDelete, method)
+	assert.False(t, output.Subscribed)
+	assert.Equal(t, "Stopped watching task 5", output.Message)
+}
+
+func TestGetTaskHandler_Subscribed(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tasks/5" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":5,"title":"Ship it","subscription":{"id":9,"entity":2,"entity_id":5}}`)) //nolint:errcheck
+	})
+
+	_, output, err := h.getTaskHandler(t.Context(), nil, GetTaskInput{TaskID: "5"})
+	require.NoError(t, err)
+	assert.True(t, output.Subscribed)
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// listWebhooksHandler handles the list_webhooks tool
+func (h *Handlers) listWebhooksHandler(ctx context.Context, _ *mcp.CallToolRequest, input ListWebhooksInput) (*mcp.CallToolResult, ListWebhooksOutput, error) {
+	projectID, err := parseID("project_id", input.ProjectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ListWebhooksOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ListWebhooksOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	hooks, err := client.ListWebhooks(ctx, projectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), ListWebhooksOutput{}, err
+	}
+
+	output := ListWebhooksOutput{Webhooks: toWebhooks(hooks)}
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, ListWebhooksOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// createWebhookHandler handles the create_webhook tool
+func (h *Handlers) createWebhookHandler(ctx context.Context, _ *mcp.CallToolRequest, input CreateWebhookInput) (*mcp.CallToolResult, CreateWebhookOutput, error) {
+	if h.isReadonlyFor("create_webhook") {
+		return h.buildErrorResult("Operation not available in readonly mode"), CreateWebhookOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	projectID, err := validateCreateWebhookInput(input)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateWebhookOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, CreateWebhookOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	hook, err := client.CreateWebhook(ctx, projectID, input.TargetURL, input.Events)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), CreateWebhookOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, CreateWebhookOutput{Planned: planned}, err
+	}
+
+	output := CreateWebhookOutput{Webhook: toWebhook(hook)}
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, CreateWebhookOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+// deleteWebhookHandler handles the delete_webhook tool
+func (h *Handlers) deleteWebhookHandler(ctx context.Context, _ *mcp.CallToolRequest, input DeleteWebhookInput) (*mcp.CallToolResult, DeleteWebhookOutput, error) {
+	if h.isReadonlyFor("delete_webhook") {
+		return h.buildErrorResult("Operation not available in readonly mode"), DeleteWebhookOutput{}, fmt.Errorf("operation not available in readonly mode")
+	}
+
+	projectID, err := parseID("project_id", input.ProjectID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), DeleteWebhookOutput{}, err
+	}
+	webhookID, err := parseID("webhook_id", input.WebhookID)
+	if err != nil {
+		return h.buildErrorResult(err.Error()), DeleteWebhookOutput{}, err
+	}
+
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, DeleteWebhookOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := client.DeleteWebhook(ctx, projectID, webhookID); err != nil {
+		return h.buildErrorResult(err.Error()), DeleteWebhookOutput{}, err
+	}
+
+	if client.IsDryRun() {
+		result, planned, err := h.plannedResult(client)
+		return result, DeleteWebhookOutput{Planned: planned}, err
+	}
+
+	output := DeleteWebhookOutput{
+		Message: fmt.Sprintf("Webhook %d successfully deleted from project %d", webhookID, projectID),
+	}
+	data, err := h.deps.OutputFormatter.Format(output)
+	if err != nil {
+		return nil, DeleteWebhookOutput{}, fmt.Errorf("failed to format response: %w", err)
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: data},
+		},
+	}, output, nil
+}
+
+func validateCreateWebhookInput(input CreateWebhookInput) (int64, error) {
+	var errs ValidationErrors
+	projectID := errs.parseID("project_id", input.ProjectID)
+	errs.add(validateTargetURL(input.TargetURL))
+	errs.add(validateWebhookEvents(input.Events))
+	if err := errs.err(); err != nil {
+		return 0, err
+	}
+	return projectID, nil
+}
+
+// validateTargetURL checks the webhook target is an absolute http(s) URL
+func validateTargetURL(target string) error {
+	if err := validateRequiredString("target_url", target); err != nil {
+		return err
+	}
+	parsed, err := url.Parse(target)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ValidationError{Field: "target_url", Message: fmt.Sprintf("must be an absolute http or https URL, got: %s", target)}
+	}
+	return nil
+}
+
+// validateWebhookEvents checks every event is one Vikunja can deliver
+func validateWebhookEvents(events []string) error {
+	if len(events) == 0 {
+		return ValidationError{Field: "events", Message: "at least one event is required"}
+	}
+	known := vikunja.WebhookEvents()
+	validEvents := make(map[string]bool, len(known))
+	for _, e := range known {
+		validEvents[e] = true
+	}
+	for _, e := range events {
+		if !validEvents[e] {
+			return ValidationError{Field: "events", Message: fmt.Sprintf("must be one of: %s. Got: %s", strings.Join(known, ", "), e)}
+		}
+	}
+	return nil
+}
+
+func toWebhook(w *vikunja.Webhook) Webhook {
+	return Webhook{
+		ID:        w.ID,
+		ProjectID: w.ProjectID,
+		TargetURL: w.TargetURL,
+		Events:    w.Events,
+		Secret:    w.Secret,
+		Created:   w.Created,
+	}
+}
+
+func toWebhooks(hooks []*vikunja.Webhook) []Webhook {
+	res := make([]Webhook, len(hooks))
+	for i, w := range hooks {
+		res[i] = toWebhook(w)
+	}
+	return res
+}
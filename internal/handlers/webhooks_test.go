@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWebhookEvents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		events  []string
+		wantErr string
+	}{
+		{"single known event", []string{"task.created"}, ""},
+		{"several known events", []string{"task.updated", "task.comment.created", "project.deleted"}, ""},
+		{"no events", nil, "at least one event is required"},
+		{"unknown event", []string{"task.created", "task.exploded"}, "Got: task.exploded"},
+		{"wrong case", []string{"Task.Created"}, "Got: Task.Created"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateWebhookEvents(tt.events)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "events:")
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateCreateWebhookInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   CreateWebhookInput
+		wantErr string
+	}{
+		{"valid", CreateWebhookInput{ProjectID: "1", TargetURL: "https://example.com/hook", Events: []string{"task.created"}}, ""},
+		{"missing project", CreateWebhookInput{TargetURL: "https://example.com", Events: []string{"task.created"}}, "project_id: is required"},
+		{"missing target", CreateWebhookInput{ProjectID: "1", Events: []string{"task.created"}}, "target_url: is required"},
+		{"relative target", CreateWebhookInput{ProjectID: "1", TargetURL: "/hook", Events: []string{"task.created"}}, "target_url: must be an absolute"},
+		{"non-http target", CreateWebhookInput{ProjectID: "1", TargetURL: "ftp://example.com", Events: []string{"task.created"}}, "target_url: must be an absolute"},
+		{"bad event", CreateWebhookInput{ProjectID: "1", TargetURL: "https://example.com", Events: []string{"nope"}}, "events: must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := validateCreateWebhookInput(tt.input)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCreateWebhookHandler_Readonly(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, &config.Config{Readonly: true}, unexpectedRequest(t))
+
+	result, _, err := h.createWebhookHandler(t.Context(), nil, CreateWebhookInput{
+		ProjectID: "1", TargetURL: "https://example.com", Events: []string{"task.created"},
+	})
+	require.Error(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, err.Error(), "readonly")
+}
+
+func TestCreateWebhookHandler_ReturnsSecret(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/projects/1/webhooks", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":9,"project_id":1,"target_url":"https://example.com","events":["task.created"],"secret":"abc"}`) //nolint:errcheck
+	})
+
+	_, output, err := h.createWebhookHandler(t.Context(), nil, CreateWebhookInput{
+		ProjectID: "1", TargetURL: "https://example.com", Events: []string{"task.created"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), output.Webhook.ID)
+	assert.Equal(t, "abc", output.Webhook.Secret)
+}
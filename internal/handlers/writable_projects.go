@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// listWritableProjectsHandler handles the list_writable_projects tool
+func (h *Handlers) listWritableProjectsHandler(ctx context.Context, _ *mcp.CallToolRequest, _ ListWritableProjectsInput) (*mcp.CallToolResult, ListWritableProjectsOutput, error) {
+	client, err := h.vikunjaClient()
+	if err != nil {
+		return nil, ListWritableProjectsOutput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	projects, err := client.GetProjects(ctx, false)
+	if err != nil {
+		return nil, ListWritableProjectsOutput{}, fmt.Errorf("failed to list projects: %w", err)
+	}
+	h.projects.store(projects)
+
+	writable := writableProjects(projects)
+	output := ListWritableProjectsOutput{Projects: make([]Project, len(writable))}
+	for i, p := range writable {
+		output.Projects[i] = toProject(p)
+	}
+
+	result, err := h.formatResult(writable)
+	if err != nil {
+		return nil, ListWritableProjectsOutput{}, err
+	}
+	return result, output, nil
+}
+
+// writableProjects keeps the projects the token has at least read & write permission on
+func writableProjects(projects []*vikunja.Project) []*vikunja.Project {
+	writable := []*vikunja.Project{}
+	for _, p := range projects {
+		if vikunja.CanWrite(p) {
+			writable = append(writable, p)
+		}
+	}
+	return writable
+}
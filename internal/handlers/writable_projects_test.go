@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWritableProjectsHandler_FiltersByPermission(t *testing.T) {
+	t.Parallel()
+	h := newTestHandlers(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/projects" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":-1,"title":"Favorites","max_permission":0},{"id":3,"title":"Shared with me","max_permission":0},`+ //nolint:errcheck
+			`{"id":4,"title":"Team","max_permission":1},{"id":5,"title":"Mine","max_permission":2}]`)
+	})
+
+	result, output, err := h.listWritableProjectsHandler(t.Context(), nil, ListWritableProjectsInput{})
+	require.NoError(t, err)
+
+	require.Len(t, output.Projects, 2)
+	assert.Equal(t, Project{ID: 4, Title: "Team", URI: "vikunja://project/4", MaxRight: "read_write"}, output.Projects[0])
+	assert.Equal(t, Project{ID: 5, Title: "Mine", URI: "vikunja://project/5", MaxRight: "admin"}, output.Projects[1])
+	text := result.Content[0].(*mcp.TextContent).Text
+	assert.NotContains(t, text, "Shared with me")
+}
@@ -0,0 +1,94 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// DefaultReadinessCacheTTL is how long a readiness result is reused before Vikunja is asked again.
+const DefaultReadinessCacheTTL = 5 * time.Second
+
+// InfoClient is the part of the Vikunja client VikunjaInfoCheck needs.
+type InfoClient interface {
+	GetInfo(ctx context.Context) (*vikunja.ServerInfo, error)
+}
+
+// VikunjaInfoCheck checks that Vikunja is reachable by asking for its server info, which
+// needs no authentication and is cheap for Vikunja to answer.
+type VikunjaInfoCheck struct {
+	client InfoClient
+}
+
+// NewVikunjaInfoCheck creates a readiness check against the Vikunja server client talks to
+func NewVikunjaInfoCheck(client InfoClient) *VikunjaInfoCheck {
+	return &VikunjaInfoCheck{client: client}
+}
+
+// Name returns the name of the check
+func (vc *VikunjaInfoCheck) Name() string {
+	return "vikunja"
+}
+
+// Check performs the health check
+func (vc *VikunjaInfoCheck) Check(ctx context.Context) CheckResult {
+	start := time.Now()
+	info, err := vc.client.GetInfo(ctx)
+	duration := time.Since(start)
+
+	if err != nil {
+		return CheckResult{
+			Name:         "vikunja",
+			Status:       StatusUnhealthy,
+			Message:      fmt.Sprintf("Failed to connect to Vikunja: %v", err),
+			ResponseTime: duration,
+		}
+	}
+
+	return CheckResult{
+		Name:         "vikunja",
+		Status:       StatusHealthy,
+		Message:      "Successfully connected to Vikunja",
+		ResponseTime: duration,
+		Metadata:     map[string]interface{}{"version": info.Version},
+	}
+}
+
+// CachedCheck reuses the result of another check for a while, so frequent probes do not
+// hammer the dependency it checks.
+type CachedCheck struct {
+	checker Checker
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	result  CheckResult
+	expires time.Time
+}
+
+// NewCachedCheck wraps checker so its result is reused for ttl
+func NewCachedCheck(checker Checker, ttl time.Duration) *CachedCheck {
+	return &CachedCheck{checker: checker, ttl: ttl, now: time.Now}
+}
+
+// Name returns the name of the wrapped check
+func (cc *CachedCheck) Name() string {
+	return cc.checker.Name()
+}
+
+// Check returns the cached result while it is fresh, and runs the wrapped check otherwise.
+// Concurrent probes wait for a single run rather than each reaching the dependency.
+func (cc *CachedCheck) Check(ctx context.Context) CheckResult {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if now := cc.now(); now.Before(cc.expires) {
+		return cc.result
+	}
+	cc.result = cc.checker.Check(ctx)
+	cc.expires = cc.now().Add(cc.ttl)
+	return cc.result
+}
@@ -0,0 +1,66 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockInfoClient answers GetInfo with a fixed result, counting the calls
+type mockInfoClient struct {
+	version string
+	err     error
+	calls   int
+}
+
+func (m *mockInfoClient) GetInfo(_ context.Context) (*vikunja.ServerInfo, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &vikunja.ServerInfo{Version: m.version}, nil
+}
+
+func TestVikunjaInfoCheck(t *testing.T) {
+	t.Parallel()
+	t.Run("reachable", func(t *testing.T) {
+		t.Parallel()
+		result := NewVikunjaInfoCheck(&mockInfoClient{version: "v0.24.1"}).Check(t.Context())
+
+		assert.Equal(t, "vikunja", result.Name)
+		assert.Equal(t, StatusHealthy, result.Status)
+		assert.Equal(t, "v0.24.1", result.Metadata["version"])
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		t.Parallel()
+		result := NewVikunjaInfoCheck(&mockInfoClient{err: errors.New("connection refused")}).Check(t.Context())
+
+		assert.Equal(t, StatusUnhealthy, result.Status)
+		assert.Contains(t, result.Message, "connection refused")
+	})
+}
+
+func TestCachedCheck(t *testing.T) {
+	t.Parallel()
+	client := &mockInfoClient{err: errors.New("connection refused")}
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	cached := NewCachedCheck(NewVikunjaInfoCheck(client), 5*time.Second)
+	cached.now = func() time.Time { return now }
+
+	assert.Equal(t, "vikunja", cached.Name())
+	assert.Equal(t, StatusUnhealthy, cached.Check(t.Context()).Status)
+
+	client.err = nil
+	now = now.Add(4 * time.Second)
+	assert.Equal(t, StatusUnhealthy, cached.Check(t.Context()).Status, "a fresh result is reused")
+	assert.Equal(t, 1, client.calls)
+
+	now = now.Add(time.Second)
+	assert.Equal(t, StatusHealthy, cached.Check(t.Context()).Status, "an expired result is refreshed")
+	assert.Equal(t, 2, client.calls)
+}
@@ -49,15 +49,16 @@ func DefaultConfig() Config {
 	}
 }
 
-// LoadConfig loads logging configuration from environment variables
+// LoadConfig loads logging configuration from environment variables.
+// MCP_LOG_LEVEL and MCP_LOG_FORMAT take precedence over the older LOG_LEVEL and LOG_FORMAT.
 func LoadConfig() Config {
 	cfg := DefaultConfig()
 
-	if level := os.Getenv("LOG_LEVEL"); level != "" {
+	if level := firstEnv("MCP_LOG_LEVEL", "LOG_LEVEL"); level != "" {
 		cfg.Level = Level(strings.ToLower(level))
 	}
 
-	if format := os.Getenv("LOG_FORMAT"); format != "" {
+	if format := firstEnv("MCP_LOG_FORMAT", "LOG_FORMAT"); format != "" {
 		cfg.Format = Format(strings.ToLower(format))
 	}
 
@@ -68,6 +69,41 @@ func LoadConfig() Config {
 	return cfg
 }
 
+// firstEnv returns the value of the first of the named environment variables that is set
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// Validate reports an unknown level or format. NewLogger falls back to info and JSON
+// for these, so callers that want a misconfiguration to fail call Validate first.
+func (c Config) Validate() error {
+	switch c.Level {
+	case LevelDebug, LevelInfo, LevelWarn, LevelError:
+	default:
+		return fmt.Errorf("invalid log level: %s (must be 'debug', 'info', 'warn', or 'error')", c.Level)
+	}
+	switch c.Format {
+	case FormatJSON, FormatText:
+	default:
+		return fmt.Errorf("invalid log format: %s (must be 'json' or 'text')", c.Format)
+	}
+	return nil
+}
+
+// ForStdio returns the configuration with stdout output moved to stderr.
+// The stdio transport speaks MCP over stdout, so log lines there would corrupt the protocol stream.
+func (c Config) ForStdio() Config {
+	if c.Output == "stdout" {
+		c.Output = "stderr"
+	}
+	return c
+}
+
 // NewLogger creates a new slog.Logger based on configuration
 func NewLogger(cfg Config) (*slog.Logger, error) {
 	output, err := openOutput(cfg.Output)
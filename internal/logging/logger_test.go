@@ -115,6 +115,47 @@ func TestNewLogger(t *testing.T) {
 	}
 }
 
+func TestLoadConfig_MCPVariables(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "error")
+	t.Setenv("LOG_FORMAT", "json")
+	t.Setenv("MCP_LOG_LEVEL", "DEBUG")
+	t.Setenv("MCP_LOG_FORMAT", "text")
+
+	cfg := LoadConfig()
+	assert.Equal(t, LevelDebug, cfg.Level, "MCP_LOG_LEVEL wins over LOG_LEVEL")
+	assert.Equal(t, FormatText, cfg.Format, "MCP_LOG_FORMAT wins over LOG_FORMAT")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{name: "valid", config: Config{Level: LevelWarn, Format: FormatText}},
+		{name: "unknown level", config: Config{Level: "verbose", Format: FormatJSON}, wantErr: "invalid log level: verbose"},
+		{name: "unknown format", config: Config{Level: LevelInfo, Format: "xml"}, wantErr: "invalid log format: xml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestConfig_ForStdio(t *testing.T) {
+	assert.Equal(t, "stderr", DefaultConfig().ForStdio().Output)
+	assert.Equal(t, "stderr", Config{Output: "stderr"}.ForStdio().Output)
+	assert.Equal(t, "/var/log/app.log", Config{Output: "/var/log/app.log"}.ForStdio().Output)
+}
+
 func TestNewLogger_InvalidFile(t *testing.T) {
 	// Try to create a logger with an invalid file path
 	config := Config{
@@ -0,0 +1,170 @@
+// Package metrics collects operational metrics for the MCP Vikunja server and exposes them
+// in the Prometheus text format.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Tool call outcomes recorded by ToolCall.
+const (
+	// OutcomeSuccess is a tool call that returned a result
+	OutcomeSuccess = "success"
+	// OutcomeError is a tool call that failed or returned an error result
+	OutcomeError = "error"
+)
+
+// toolCallKey identifies a tool call counter
+type toolCallKey struct {
+	tool    string
+	outcome string
+}
+
+// requestKey identifies a Vikunja request latency histogram
+type requestKey struct {
+	method string
+	status string
+}
+
+// histogram counts observations into cumulative buckets
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// Registry holds the server's metrics. It is safe for concurrent use.
+type Registry struct {
+	mu          sync.Mutex
+	buckets     []float64
+	toolCalls   map[toolCallKey]uint64
+	requests    map[requestKey]*histogram
+	rateLimited uint64
+}
+
+// New creates an empty metrics registry
+func New() *Registry {
+	return &Registry{
+		buckets:   defaultBuckets(),
+		toolCalls: make(map[toolCallKey]uint64),
+		requests:  make(map[requestKey]*histogram),
+	}
+}
+
+// defaultBuckets returns the latency histogram bounds in seconds, Prometheus' defaults
+func defaultBuckets() []float64 {
+	return []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+}
+
+// ToolCall counts one call of tool with the given outcome
+func (r *Registry) ToolCall(tool, outcome string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.toolCalls[toolCallKey{tool: tool, outcome: outcome}]++
+}
+
+// ObserveRequest records the latency of a request sent to Vikunja. Status is the HTTP status
+// code, or zero when no response arrived. Responses with status 429 also count as rate-limit
+// rejections.
+func (r *Registry) ObserveRequest(method string, status int, duration time.Duration) {
+	key := requestKey{method: method, status: "error"}
+	if status != 0 {
+		key.status = strconv.Itoa(status)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	h, ok := r.requests[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(r.buckets))}
+		r.requests[key] = h
+	}
+	seconds := duration.Seconds()
+	for i, bound := range r.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+	if status == http.StatusTooManyRequests {
+		r.rateLimited++
+	}
+}
+
+// HTTPHandler returns an HTTP handler serving the metrics in the Prometheus text format
+func (r *Registry) HTTPHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if err := r.Write(w); err != nil {
+			slog.Error("failed to write metrics", "error", err)
+		}
+	}
+}
+
+// Write writes the metrics to w in the Prometheus text format
+func (r *Registry) Write(w io.Writer) error {
+	var b strings.Builder
+	r.mu.Lock()
+	r.writeToolCalls(&b)
+	r.writeRequests(&b)
+	r.writeRateLimited(&b)
+	r.mu.Unlock()
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeToolCalls writes the tool call counters, sorted by tool and outcome
+func (r *Registry) writeToolCalls(b *strings.Builder) {
+	b.WriteString("# HELP mcp_vikunja_tool_calls_total MCP tool calls by tool and outcome.\n")
+	b.WriteString("# TYPE mcp_vikunja_tool_calls_total counter\n")
+	keys := make([]toolCallKey, 0, len(r.toolCalls))
+	for k := range r.toolCalls {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b toolCallKey) int {
+		return strings.Compare(a.tool+"\x00"+a.outcome, b.tool+"\x00"+b.outcome)
+	})
+	for _, k := range keys {
+		fmt.Fprintf(b, "mcp_vikunja_tool_calls_total{outcome=%q,tool=%q} %d\n", k.outcome, k.tool, r.toolCalls[k])
+	}
+}
+
+// writeRequests writes the Vikunja request latency histograms, sorted by method and status
+func (r *Registry) writeRequests(b *strings.Builder) {
+	b.WriteString("# HELP mcp_vikunja_request_duration_seconds Latency of requests sent to Vikunja.\n")
+	b.WriteString("# TYPE mcp_vikunja_request_duration_seconds histogram\n")
+	keys := make([]requestKey, 0, len(r.requests))
+	for k := range r.requests {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b requestKey) int {
+		return strings.Compare(a.method+"\x00"+a.status, b.method+"\x00"+b.status)
+	})
+	for _, k := range keys {
+		h := r.requests[k]
+		labels := fmt.Sprintf("method=%q,status=%q", k.method, k.status)
+		for i, bound := range r.buckets {
+			fmt.Fprintf(b, "mcp_vikunja_request_duration_seconds_bucket{%s,le=%q} %d\n", labels, strconv.FormatFloat(bound, 'g', -1, 64), h.counts[i])
+		}
+		fmt.Fprintf(b, "mcp_vikunja_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, h.count)
+		fmt.Fprintf(b, "mcp_vikunja_request_duration_seconds_sum{%s} %g\n", labels, h.sum)
+		fmt.Fprintf(b, "mcp_vikunja_request_duration_seconds_count{%s} %d\n", labels, h.count)
+	}
+}
+
+// writeRateLimited writes the count of Vikunja rate-limit rejections
+func (r *Registry) writeRateLimited(b *strings.Builder) {
+	b.WriteString("# HELP mcp_vikunja_rate_limited_total Vikunja requests rejected with 429 Too Many Requests.\n")
+	b.WriteString("# TYPE mcp_vikunja_rate_limited_total counter\n")
+	fmt.Fprintf(b, "mcp_vikunja_rate_limited_total %d\n", r.rateLimited)
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_HTTPHandler(t *testing.T) {
+	t.Parallel()
+	r := New()
+	r.ToolCall("get_task", OutcomeSuccess)
+	r.ToolCall("get_task", OutcomeSuccess)
+	r.ToolCall("create_task", OutcomeError)
+	r.ObserveRequest(http.MethodGet, http.StatusOK, 30*time.Millisecond)
+	r.ObserveRequest(http.MethodPut, http.StatusTooManyRequests, 2*time.Second)
+	r.ObserveRequest(http.MethodGet, 0, time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	r.HTTPHandler()(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+	body := rec.Body.String()
+	assert.Contains(t, body, "# TYPE mcp_vikunja_tool_calls_total counter\n")
+	assert.Contains(t, body, `mcp_vikunja_tool_calls_total{outcome="error",tool="create_task"} 1`)
+	assert.Contains(t, body, `mcp_vikunja_tool_calls_total{outcome="success",tool="get_task"} 2`)
+	assert.Contains(t, body, `mcp_vikunja_request_duration_seconds_bucket{method="GET",status="200",le="0.025"} 0`)
+	assert.Contains(t, body, `mcp_vikunja_request_duration_seconds_bucket{method="GET",status="200",le="0.05"} 1`)
+	assert.Contains(t, body, `mcp_vikunja_request_duration_seconds_bucket{method="GET",status="200",le="+Inf"} 1`)
+	assert.Contains(t, body, `mcp_vikunja_request_duration_seconds_count{method="PUT",status="429"} 1`)
+	assert.Contains(t, body, `mcp_vikunja_request_duration_seconds_count{method="GET",status="error"} 1`)
+	assert.Contains(t, body, "mcp_vikunja_rate_limited_total 1\n")
+}
+
+func TestRegistry_Empty(t *testing.T) {
+	t.Parallel()
+	rec := httptest.NewRecorder()
+	New().HTTPHandler()(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Contains(t, rec.Body.String(), "mcp_vikunja_rate_limited_total 0\n")
+	assert.NotContains(t, rec.Body.String(), "mcp_vikunja_tool_calls_total{")
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/meschbach/mcp-vikunja/internal/config"
 	"github.com/meschbach/mcp-vikunja/internal/health"
+	"github.com/meschbach/mcp-vikunja/internal/metrics"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -51,35 +52,12 @@ type HTTPServer struct {
 	server        *mcp.Server
 	config        *config.Config
 	healthChecker *health.Manager
+	metrics       *metrics.Registry
 }
 
 // Run starts the MCP server with HTTP transport.
 func (s *HTTPServer) Run(ctx context.Context) error {
-	// Create the streamable HTTP handler
-	mcpHandler := mcp.NewStreamableHTTPHandler(
-		func(*http.Request) *mcp.Server {
-			return s.server
-		},
-		&mcp.StreamableHTTPOptions{
-			SessionTimeout: s.config.HTTP.SessionTimeout,
-			Stateless:      s.config.HTTP.Stateless,
-		},
-	)
-
-	// Create mux and register handlers
-	mux := http.NewServeMux()
-
-	// Register MCP handler
-	mux.Handle("/mcp", mcpHandler)
-	mux.Handle("/mcp/", mcpHandler)
-
-	// Register health check handlers if health checker is configured
-	if s.healthChecker != nil {
-		mux.HandleFunc("/health", s.healthChecker.HTTPHandler(""))
-		mux.HandleFunc("/health/live", s.healthChecker.HTTPHandler(health.CheckTypeLiveness))
-		mux.HandleFunc("/health/ready", s.healthChecker.HTTPHandler(health.CheckTypeReadiness))
-	}
-
+	mux := s.newMux()
 	httpServer := s.createHTTPServer(mux)
 
 	// Start the HTTP server in a goroutine
@@ -117,11 +95,54 @@ func (s *HTTPServer) Run(ctx context.Context) error {
 	}
 }
 
+// newMux routes the MCP endpoint along with the health and metrics endpoints that are configured
+func (s *HTTPServer) newMux() *http.ServeMux {
+	// Create the streamable HTTP handler. It closes a session once SessionTimeout passes without a
+	// request from its client, so idle sessions do not accumulate.
+	mcpHandler := mcp.NewStreamableHTTPHandler(
+		func(*http.Request) *mcp.Server {
+			return s.server
+		},
+		&mcp.StreamableHTTPOptions{
+			SessionTimeout: s.config.HTTP.SessionTimeout,
+			Stateless:      s.config.HTTP.Stateless,
+		},
+	)
+
+	// Create mux and register handlers
+	mux := http.NewServeMux()
+
+	// Register MCP handler
+	mux.Handle("/mcp", mcpHandler)
+	mux.Handle("/mcp/", mcpHandler)
+
+	// Register health check handlers if health checker is configured
+	if s.healthChecker != nil {
+		mux.HandleFunc("/health", s.healthChecker.HTTPHandler(""))
+		mux.HandleFunc("/health/live", s.healthChecker.HTTPHandler(health.CheckTypeLiveness))
+		mux.HandleFunc("/health/ready", s.healthChecker.HTTPHandler(health.CheckTypeReadiness))
+		// Probe aliases in the form container orchestrators expect
+		mux.HandleFunc("/healthz", s.healthChecker.HTTPHandler(health.CheckTypeLiveness))
+		mux.HandleFunc("/readyz", s.healthChecker.HTTPHandler(health.CheckTypeReadiness))
+	}
+
+	// Register the metrics handler if metrics are configured
+	if s.metrics != nil {
+		mux.HandleFunc("/metrics", s.metrics.HTTPHandler())
+	}
+	return mux
+}
+
 // SetHealthChecker sets the health checker for the HTTP server
 func (s *HTTPServer) SetHealthChecker(hc *health.Manager) {
 	s.healthChecker = hc
 }
 
+// SetMetrics makes the HTTP server serve m on /metrics
+func (s *HTTPServer) SetMetrics(m *metrics.Registry) {
+	s.metrics = m
+}
+
 func (s *HTTPServer) createHTTPServer(mux *http.ServeMux) *http.Server {
 	addr := s.config.HTTP.Address()
 	if addr == "" || addr == ":0" {
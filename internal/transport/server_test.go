@@ -2,10 +2,16 @@ package transport
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/meschbach/mcp-vikunja/internal/config"
+	"github.com/meschbach/mcp-vikunja/internal/health"
+	"github.com/meschbach/mcp-vikunja/internal/metrics"
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -169,3 +175,118 @@ func TestHTTPServer_Run_BoundPort(t *testing.T) {
 	assert.NotNil(t, server.server)
 	assert.NotNil(t, server.config)
 }
+
+// getPath fetches path from the server at baseURL, returning the status code and body
+func getPath(t *testing.T, baseURL, path string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, baseURL+path, http.NoBody)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close() //nolint:errcheck
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestHTTPServer_MetricsEndpoint(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{Transport: config.TransportHTTP, HTTP: config.HTTPConfig{MetricsEnabled: true}}
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+
+	registry := metrics.New()
+	server := &HTTPServer{server: mcpServer, config: cfg}
+	server.SetMetrics(registry)
+	ts := httptest.NewServer(server.newMux())
+	t.Cleanup(ts.Close)
+
+	registry.ToolCall("list_tasks", metrics.OutcomeSuccess)
+
+	status, body := getPath(t, ts.URL, "/metrics")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, `mcp_vikunja_tool_calls_total{outcome="success",tool="list_tasks"} 1`)
+}
+
+func TestHTTPServer_MetricsEndpointDisabled(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{Transport: config.TransportHTTP}
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+
+	server := &HTTPServer{server: mcpServer, config: cfg}
+	ts := httptest.NewServer(server.newMux())
+	t.Cleanup(ts.Close)
+
+	status, _ := getPath(t, ts.URL, "/metrics")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestHTTPServer_Probes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		vikunjaUp bool
+		wantReady int
+	}{
+		{"vikunja reachable", true, http.StatusOK},
+		{"vikunja down", false, http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			vikunjaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/info" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, `{"version":"v0.24.1"}`) //nolint:errcheck
+			}))
+			t.Cleanup(vikunjaServer.Close)
+			if !tt.vikunjaUp {
+				vikunjaServer.Close()
+			}
+			client, err := vikunja.NewClient(vikunjaServer.URL, "test-token", true)
+			require.NoError(t, err)
+
+			hc := health.New()
+			hc.Register(&health.ServerCheck{})
+			hc.Register(health.NewCachedCheck(health.NewVikunjaInfoCheck(client), health.DefaultReadinessCacheTTL))
+			server := &HTTPServer{server: mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil), config: &config.Config{}}
+			server.SetHealthChecker(hc)
+			ts := httptest.NewServer(server.newMux())
+			t.Cleanup(ts.Close)
+
+			status, _ := getPath(t, ts.URL, "/healthz")
+			assert.Equal(t, http.StatusOK, status, "liveness does not depend on Vikunja")
+
+			status, body := getPath(t, ts.URL, "/readyz")
+			assert.Equal(t, tt.wantReady, status)
+			assert.Contains(t, body, `"vikunja"`)
+		})
+	}
+}
+
+func TestHTTPServer_ClosesIdleSessions(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{Transport: config.TransportHTTP, HTTP: config.HTTPConfig{SessionTimeout: 50 * time.Millisecond}}
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	server := &HTTPServer{server: mcpServer, config: cfg}
+	ts := httptest.NewServer(server.newMux())
+	t.Cleanup(ts.Close)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(t.Context(), &mcp.StreamableClientTransport{Endpoint: ts.URL + "/mcp"}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { session.Close() }) //nolint:errcheck,gosec
+
+	openSessions := func() int {
+		n := 0
+		for range mcpServer.Sessions() {
+			n++
+		}
+		return n
+	}
+	require.Equal(t, 1, openSessions())
+	assert.Eventually(t, func() bool { return openSessions() == 0 }, 2*time.Second, 10*time.Millisecond,
+		"an idle session must be closed once the session timeout passes")
+}
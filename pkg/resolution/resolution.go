@@ -7,19 +7,22 @@
 //   - FindBucketByIDOrTitle: returns errors like "bucket ... not found in Kanban view of project ...",
 //     "multiple buckets found with title ... please use bucket ID", or "kanban view not found in project ...".
 //   - FindKanbanView: returns "kanban view not found in project ...".
+//   - ResolveView: returns "view with ID ... not found in project ..." or "view with title ... not found in project ...".
+//   - ResolveBucket: returns "bucket with ID ... not found in view ..." or "bucket with title ... not found in view ...".
 package resolution
 
 import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
 )
 
 // Client is the subset of vikunja.Client methods required for resolution.
 type Client interface {
-	GetProjects(ctx context.Context) ([]*vikunja.Project, error)
+	GetProjects(ctx context.Context, includeArchived bool) ([]*vikunja.Project, error)
 	GetProjectViews(ctx context.Context, projectID int64) ([]*vikunja.ProjectView, error)
 	GetViewBuckets(ctx context.Context, projectID int64, viewID int64) ([]*vikunja.Bucket, error)
 	GetProject(ctx context.Context, projectID int64) (*vikunja.Project, error)
@@ -70,7 +73,7 @@ func findProjectByIDOrTitle(ctx context.Context, client Client, projectID, proje
 }
 
 func findProjectByTitle(ctx context.Context, client Client, title string) (*Project, error) {
-	projects, err := client.GetProjects(ctx)
+	projects, err := client.GetProjects(ctx, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
@@ -93,7 +96,11 @@ func findProjectByTitle(ctx context.Context, client Client, title string) (*Proj
 		return nil, enhancedProjectNotFoundError(title, projectTitles)
 	}
 	if len(matches) > 1 {
-		return nil, fmt.Errorf("multiple projects found with title %q, please use project ID", title)
+		ids := make([]string, len(matches))
+		for i, p := range matches {
+			ids[i] = strconv.FormatInt(p.ID, 10)
+		}
+		return nil, fmt.Errorf("multiple projects found with title %q (IDs: %s), please use project ID", title, strings.Join(ids, ", "))
 	}
 	return &matches[0], nil
 }
@@ -115,6 +122,100 @@ func FindKanbanView(ctx context.Context, client Client, projectID int64) (*vikun
 	return nil, fmt.Errorf("kanban view not found in project %d. Project must have a Kanban view to use buckets", projectID)
 }
 
+// DefaultViewTitle is the view ResolveView picks when no view is given.
+const DefaultViewTitle = "Kanban"
+
+// ResolveView resolves a view of a project by an identifier which can be either a numeric ID or a title.
+// An empty identifier selects the view titled DefaultViewTitle.
+func ResolveView(ctx context.Context, client Client, projectID int64, identifier string) (*vikunja.ProjectView, error) {
+	return ResolveViewWithFallback(ctx, client, projectID, identifier, nil)
+}
+
+// ViewFallback chooses a view for a project that has no view titled DefaultViewTitle.
+type ViewFallback struct {
+	// Kinds is the order of view kinds to prefer; empty picks the project's default view
+	Kinds []vikunja.ViewKind
+}
+
+// ResolveViewWithFallback resolves a view as ResolveView does. When no identifier is given and the
+// project has no view titled DefaultViewTitle, a non-nil fallback picks another view instead of failing.
+// Explicit identifiers are never substituted.
+func ResolveViewWithFallback(ctx context.Context, client Client, projectID int64, identifier string, fallback *ViewFallback) (*vikunja.ProjectView, error) {
+	views, err := client.GetProjectViews(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project views: %w", err)
+	}
+
+	view, err := findView(views, projectID, identifier)
+	if err != nil && identifier == "" && fallback != nil {
+		if v := fallback.choose(views); v != nil {
+			return v, nil
+		}
+	}
+	return view, err
+}
+
+// findView finds a view among views by numeric ID or title, defaulting to DefaultViewTitle
+func findView(views []*vikunja.ProjectView, projectID int64, identifier string) (*vikunja.ProjectView, error) {
+	if identifier == "" {
+		identifier = DefaultViewTitle
+	} else if id, err := strconv.ParseInt(identifier, 10, 64); err == nil && id > 0 {
+		for _, v := range views {
+			if v.ID == id {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("view with ID %d not found in project %d", id, projectID)
+	}
+
+	for _, v := range views {
+		if v.Title == identifier {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("view with title %q not found in project %d", identifier, projectID)
+}
+
+// choose returns the first view of the most preferred kind, or nil when none of views qualifies
+func (f *ViewFallback) choose(views []*vikunja.ProjectView) *vikunja.ProjectView {
+	if len(f.Kinds) == 0 {
+		return vikunja.DefaultView(views)
+	}
+	for _, kind := range f.Kinds {
+		for _, v := range views {
+			if v.ViewKind == kind {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// ResolveBucket resolves a bucket of a project view by an identifier which can be either a numeric ID or a title.
+// Unlike FindBucketByIDOrTitle, numeric IDs are checked against the view's buckets.
+func ResolveBucket(ctx context.Context, client Client, projectID, viewID int64, identifier string) (*vikunja.Bucket, error) {
+	buckets, err := client.GetViewBuckets(ctx, projectID, viewID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get view buckets: %w", err)
+	}
+
+	if id, err := strconv.ParseInt(identifier, 10, 64); err == nil && id > 0 {
+		for _, b := range buckets {
+			if b.ID == id {
+				return b, nil
+			}
+		}
+		return nil, fmt.Errorf("bucket with ID %d not found in view %d", id, viewID)
+	}
+
+	for _, b := range buckets {
+		if b.Title == identifier {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("bucket with title %q not found in view %d", identifier, viewID)
+}
+
 // FindBucketByIDOrTitle finds a bucket by ID (if numeric) or title (via Kanban view).
 // If bucketInput is numeric, returns that ID without validation.
 // If bucketInput is non-numeric, it must match exactly one bucket title in the project's Kanban view.
@@ -0,0 +1,198 @@
+package resolution
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/meschbach/mcp-vikunja/pkg/vikunja"
+)
+
+// InboxProjectTitle is the title of the project Vikunja creates for every new account.
+const InboxProjectTitle = "Inbox"
+
+// ResolverClient is the subset of vikunja.Client methods required by a Resolver.
+type ResolverClient interface {
+	Client
+	GetDefaultProjectID(ctx context.Context) (int64, error)
+}
+
+// Resolver resolves projects, views and buckets the way the MCP tools do, so every frontend
+// behaves alike: a missing project falls back to the default project, numeric project IDs must
+// exist, a title shared by several projects is an error unless FirstOfDuplicateTitles is set, and
+// a project without a Kanban view follows ViewFallback.
+type Resolver struct {
+	Client ResolverClient
+	// DefaultProject is the project ID or title used when none is given; empty discovers it
+	DefaultProject string
+	// FirstOfDuplicateTitles resolves a title shared by several projects to the first match
+	FirstOfDuplicateTitles bool
+	// ViewFallback picks another view when a project lacks the default Kanban view; nil keeps lookups strict
+	ViewFallback *ViewFallback
+	// ListProjects, when set, lists the projects instead of Client, e.g. from a cache
+	ListProjects func(ctx context.Context) ([]*vikunja.Project, error)
+	// ProjectsByTitle, when set, finds the projects titled title instead of listing them all. It
+	// returns an error when no project has the title.
+	ProjectsByTitle func(ctx context.Context, title string) ([]*vikunja.Project, error)
+	// Logger reports user settings that could not be read; nil uses slog.Default
+	Logger *slog.Logger
+}
+
+// Project resolves a project from an ID (integer string) or title, falling back to the default
+// project when identifier is empty. Vikunja's pseudo projects, Favorites (-1) and saved filters
+// (below -1), are accepted by ID.
+func (r *Resolver) Project(ctx context.Context, identifier string) (*vikunja.Project, error) {
+	if identifier == "" {
+		return r.FindDefaultProject(ctx)
+	}
+
+	if id, err := strconv.ParseInt(identifier, 10, 64); err == nil && id != 0 {
+		project, err := r.Client.GetProject(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("project with ID %d not found: %w", id, err)
+		}
+		return project, nil
+	}
+	return r.ProjectByTitle(ctx, identifier)
+}
+
+// ProjectByTitle resolves title to a single project. A title shared by several projects is an
+// ambiguity error listing their IDs, unless FirstOfDuplicateTitles resolves it to the first match.
+func (r *Resolver) ProjectByTitle(ctx context.Context, title string) (*vikunja.Project, error) {
+	matches, err := r.projectsByTitle(ctx, title)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) > 1 && !r.FirstOfDuplicateTitles {
+		ids := make([]int64, len(matches))
+		for i, p := range matches {
+			ids[i] = p.ID
+		}
+		return nil, AmbiguousProjectError(title, ids)
+	}
+	return matches[0], nil
+}
+
+func (r *Resolver) projectsByTitle(ctx context.Context, title string) ([]*vikunja.Project, error) {
+	if r.ProjectsByTitle != nil {
+		return r.ProjectsByTitle(ctx, title)
+	}
+
+	projects, err := r.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var matches []*vikunja.Project
+	var titles []string
+	for _, p := range projects {
+		if p.Title == title {
+			matches = append(matches, p)
+		}
+		titles = append(titles, p.Title)
+	}
+	if len(matches) == 0 {
+		return nil, enhancedProjectNotFoundError(title, titles)
+	}
+	return matches, nil
+}
+
+func (r *Resolver) projects(ctx context.Context) ([]*vikunja.Project, error) {
+	if r.ListProjects != nil {
+		return r.ListProjects(ctx)
+	}
+	projects, err := r.Client.GetProjects(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	return projects, nil
+}
+
+// AmbiguousProjectError names the projects sharing title so the caller can pick one by ID.
+func AmbiguousProjectError(title string, ids []int64) error {
+	labels := make([]string, len(ids))
+	for i, id := range ids {
+		labels[i] = strconv.FormatInt(id, 10)
+	}
+	return fmt.Errorf("multiple projects found with title %q (IDs: %s), please use project_id to pick one",
+		title, strings.Join(labels, ", "))
+}
+
+// FindDefaultProject returns the project used when none is given. In order of preference it is
+// DefaultProject, the default project of the user's settings, the project titled "Inbox", and
+// finally the user's first real project.
+func (r *Resolver) FindDefaultProject(ctx context.Context) (*vikunja.Project, error) {
+	if r.DefaultProject != "" {
+		project, err := r.Project(ctx, r.DefaultProject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve configured default project %q: %w", r.DefaultProject, err)
+		}
+		return project, nil
+	}
+
+	if project := r.userDefaultProject(ctx); project != nil {
+		return project, nil
+	}
+	return r.fallbackDefaultProject(ctx)
+}
+
+// userDefaultProject returns the default project of the user's settings, or nil when the user has
+// none or it cannot be read; an unreadable setting only costs the fallback a project list.
+func (r *Resolver) userDefaultProject(ctx context.Context) *vikunja.Project {
+	id, err := r.Client.GetDefaultProjectID(ctx)
+	if err != nil {
+		r.logger().Warn("failed to read the user's default project", slog.Any("error", err))
+		return nil
+	}
+	if id <= 0 {
+		return nil
+	}
+
+	project, err := r.Client.GetProject(ctx, id)
+	if err != nil {
+		r.logger().Warn("failed to get the user's default project", slog.Int64("project_id", id), slog.Any("error", err))
+		return nil
+	}
+	return project
+}
+
+// fallbackDefaultProject picks the project titled "Inbox", or else the first project that is not
+// a pseudo project such as Favorites, which Vikunja lists with a negative ID.
+func (r *Resolver) fallbackDefaultProject(ctx context.Context) (*vikunja.Project, error) {
+	projects, err := r.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range projects {
+		if p.Title == InboxProjectTitle {
+			return p, nil
+		}
+	}
+	for _, p := range projects {
+		if p.ID > 0 {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("no default project found: the account has no projects")
+}
+
+func (r *Resolver) logger() *slog.Logger {
+	if r.Logger != nil {
+		return r.Logger
+	}
+	return slog.Default()
+}
+
+// View resolves a view of a project from an ID (integer string) or title. An empty identifier
+// selects the view titled DefaultViewTitle, or the one ViewFallback picks when there is none.
+func (r *Resolver) View(ctx context.Context, projectID int64, identifier string) (*vikunja.ProjectView, error) {
+	return ResolveViewWithFallback(ctx, r.Client, projectID, identifier, r.ViewFallback)
+}
+
+// Bucket resolves a bucket of a project view from an ID (integer string) or title, checking that
+// the view holds it.
+func (r *Resolver) Bucket(ctx context.Context, projectID, viewID int64, identifier string) (*vikunja.Bucket, error) {
+	return ResolveBucket(ctx, r.Client, projectID, viewID, identifier)
+}
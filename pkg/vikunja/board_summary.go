@@ -0,0 +1,50 @@
+package vikunja
+
+// BoardSummary counts the tasks in each bucket of a project's kanban views.
+type BoardSummary struct {
+	ProjectID    int64         `json:"project_id"`
+	ProjectTitle string        `json:"project_title"`
+	Views        []ViewSummary `json:"views"`
+}
+
+// ViewSummary counts the tasks of one kanban view, per bucket and in total.
+type ViewSummary struct {
+	ViewID    int64         `json:"view_id"`
+	ViewTitle string        `json:"view_title"`
+	Buckets   []BucketCount `json:"buckets"`
+	Total     int           `json:"total"`
+	Done      int           `json:"done"`
+	// DoneRatio is Done divided by Total, or 0 for a view without tasks
+	DoneRatio float64 `json:"done_ratio"`
+}
+
+// BucketCount is the number of tasks in one bucket.
+type BucketCount struct {
+	BucketID     int64  `json:"bucket_id"`
+	Title        string `json:"title"`
+	Tasks        int    `json:"tasks"`
+	IsDoneBucket bool   `json:"is_done_bucket,omitempty"`
+}
+
+// NewViewSummary counts the tasks of a kanban view from its buckets, which must hold all of their tasks.
+func NewViewSummary(view *ProjectView, buckets []*Bucket) ViewSummary {
+	summary := ViewSummary{ViewID: view.ID, ViewTitle: view.Title, Buckets: make([]BucketCount, 0, len(buckets))}
+	for _, b := range buckets {
+		summary.Buckets = append(summary.Buckets, BucketCount{
+			BucketID:     b.ID,
+			Title:        b.Title,
+			Tasks:        len(b.Tasks),
+			IsDoneBucket: view.DoneBucketID != 0 && b.ID == view.DoneBucketID,
+		})
+		summary.Total += len(b.Tasks)
+		for _, t := range b.Tasks {
+			if t.Done {
+				summary.Done++
+			}
+		}
+	}
+	if summary.Total > 0 {
+		summary.DoneRatio = float64(summary.Done) / float64(summary.Total)
+	}
+	return summary
+}
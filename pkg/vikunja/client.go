@@ -13,21 +13,46 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/meschbach/vikunja-client-go/client/assignees"
+	"github.com/meschbach/vikunja-client-go/client/filter"
+	"github.com/meschbach/vikunja-client-go/client/labels"
 	"github.com/meschbach/vikunja-client-go/client/project"
+	"github.com/meschbach/vikunja-client-go/client/service"
+	"github.com/meschbach/vikunja-client-go/client/subscriptions"
 	"github.com/meschbach/vikunja-client-go/client/task"
+	"github.com/meschbach/vikunja-client-go/client/user"
+	"github.com/meschbach/vikunja-client-go/client/webhooks"
 	"github.com/meschbach/vikunja-client-go/models"
 )
 
+// apiBasePath is the path prefix of every Vikunja API endpoint.
+const apiBasePath = "/api/v1"
+
 // Client wraps the Vikunja API client for task and project operations.
 type Client struct {
-	transport runtime.ClientTransport
-	projects  project.ClientService
-	tasks     task.ClientService
-	auth      runtime.ClientAuthInfoWriter
+	transport     runtime.ClientTransport
+	projects      project.ClientService
+	tasks         task.ClientService
+	webhooks      webhooks.ClientService
+	labels        labels.ClientService
+	assignees     assignees.ClientService
+	filters       filter.ClientService
+	service       service.ClientService
+	subscriptions subscriptions.ClientService
+	users         user.ClientService
+	auth          runtime.ClientAuthInfoWriter
+	baseURL       string
+	dryRun        *dryRunTransport
+	http          *http.Client
 }
 
 // NewClient creates a new Vikunja API client configured with the provided host and authentication token.
 func NewClient(host, token string, insecure bool) (*Client, error) {
+	return NewClientWithOptions(host, token, insecure, DefaultClientOptions())
+}
+
+// NewClientWithOptions creates a new Vikunja API client whose connection pool is tuned by opts.
+func NewClientWithOptions(host, token string, insecure bool, opts ClientOptions) (*Client, error) {
 	scheme := "https"
 	if insecure {
 		scheme = "http"
@@ -46,33 +71,56 @@ func NewClient(host, token string, insecure bool) (*Client, error) {
 		host = parsedURL.Host
 	}
 
-	httpTransport := httptransport.New(host, "/api/v1", []string{scheme})
+	httpTransport := httptransport.New(host, apiBasePath, []string{scheme})
 	httpTransport.DefaultAuthentication = httptransport.BearerToken(token)
 	httpTransport.Consumers[runtime.JSONMime] = runtime.JSONConsumer()
 	httpTransport.Producers[runtime.JSONMime] = runtime.JSONProducer()
 
-	formats := strfmt.Default
+	c := &Client{
+		auth:    httptransport.BearerToken(token),
+		baseURL: scheme + "://" + host,
+		http:    newHTTPClient(opts),
+	}
+	c.setTransport(newETagTransport(&statusErrorTransport{next: httpTransport}))
+	return c, nil
+}
 
-	return &Client{
-		transport: httpTransport,
-		projects:  project.New(httpTransport, formats),
-		tasks:     task.New(httpTransport, formats),
-		auth:      httptransport.BearerToken(token),
-	}, nil
+// setTransport points every generated service client at transport.
+func (c *Client) setTransport(transport runtime.ClientTransport) {
+	formats := strfmt.Default
+	c.transport = transport
+	c.projects = project.New(transport, formats)
+	c.tasks = task.New(transport, formats)
+	c.webhooks = webhooks.New(transport, formats)
+	c.labels = labels.New(transport, formats)
+	c.assignees = assignees.New(transport, formats)
+	c.filters = filter.New(transport, formats)
+	c.service = service.New(transport, formats)
+	c.subscriptions = subscriptions.New(transport, formats)
+	c.users = user.New(transport, formats)
 }
 
 func (c *Client) httpClient() *http.Client {
-	return &http.Client{Timeout: 30 * time.Second}
+	return c.http
 }
 
 // GetTasks retrieves all tasks, optionally filtered by project ID.
 func (c *Client) GetTasks(ctx context.Context, projectID int64) ([]*models.ModelsTask, error) {
+	filter := ""
+	if projectID > 0 {
+		filter = fmt.Sprintf("project_id:%d", projectID)
+	}
+	return c.GetFilteredTasks(ctx, filter)
+}
+
+// GetFilteredTasks retrieves tasks across all projects matching a Vikunja filter query
+// such as "done = false". An empty filter returns every task.
+func (c *Client) GetFilteredTasks(ctx context.Context, filter string) ([]*models.ModelsTask, error) {
 	params := task.NewGetTasksParams()
 	params.SetContext(ctx)
 	params.SetHTTPClient(c.httpClient())
 
-	if projectID > 0 {
-		filter := fmt.Sprintf("project_id:%d", projectID)
+	if filter != "" {
 		params.SetFilter(&filter)
 	}
 
@@ -84,20 +132,27 @@ func (c *Client) GetTasks(ctx context.Context, projectID int64) ([]*models.Model
 	return result.Payload, nil
 }
 
-// GetTask retrieves a single task by its ID.
+// GetTask retrieves a single task by its ID, expanding the given relations, by default the
+// buckets it sits in. Servers that ignore expand=buckets leave task.Buckets empty; see FindTaskBuckets.
 //
 // Duplicates GetProject due to generated swagger client patterns. Each method uses
 // a different resource client (tasks vs projects) with identical parameter handling.
 // Refactoring would require interface gymnastics that obscure the straightforward API calls.
 //
 //nolint:dupl
-func (c *Client) GetTask(ctx context.Context, id int64) (*models.ModelsTask, error) {
+func (c *Client) GetTask(ctx context.Context, id int64, expand ...TaskExpand) (*models.ModelsTask, error) {
+	query, err := taskExpandQuery(expand)
+	if err != nil {
+		return nil, err
+	}
+
 	params := task.NewGetTasksIDParams()
 	params.SetContext(ctx)
 	params.SetHTTPClient(c.httpClient())
 	params.SetID(id)
+	params.SetExpand(&query)
 
-	result, err := c.tasks.GetTasksID(params, c.auth)
+	result, err := c.tasks.GetTasksID(params, c.auth, withRelatedTasks)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task: %w", err)
 	}
@@ -124,18 +179,7 @@ func (c *Client) CreateTask(ctx context.Context, title string, projectID int64,
 		taskModel.DueDate = dueDate.Format("2006-01-02")
 	}
 
-	params := task.NewPutProjectsIDTasksParams()
-	params.SetContext(ctx)
-	params.SetHTTPClient(c.httpClient())
-	params.SetID(projectID)
-	params.SetTask(taskModel)
-
-	result, err := c.tasks.PutProjectsIDTasks(params, c.auth)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create task: %w", err)
-	}
-
-	return result.Payload, nil
+	return c.CreateTaskInProject(ctx, projectID, taskModel)
 }
 
 // MoveTaskToBucket moves a task to the specified bucket within a project's view.
@@ -160,11 +204,12 @@ func (c *Client) MoveTaskToBucket(ctx context.Context, projectID, viewID, bucket
 	return result.Payload, nil
 }
 
-// GetProjects retrieves all projects.
-func (c *Client) GetProjects(ctx context.Context) ([]*models.ModelsProject, error) {
+// GetProjects retrieves all projects. Archived projects are only included when includeArchived is set.
+func (c *Client) GetProjects(ctx context.Context, includeArchived bool) ([]*models.ModelsProject, error) {
 	params := project.NewGetProjectsParams()
 	params.SetContext(ctx)
 	params.SetHTTPClient(c.httpClient())
+	params.SetIsArchived(&includeArchived)
 
 	result, err := c.projects.GetProjects(params, c.auth)
 	if err != nil {
@@ -211,12 +256,6 @@ func (c *Client) GetProjectViews(ctx context.Context, projectID int64) ([]*model
 }
 
 // GetViewBuckets retrieves all buckets for the specified project and view.
-//
-// Duplicates GetViewTasks due to generated swagger client patterns. Each method uses
-// a different resource client (projects vs tasks) with identical parameter handling.
-// Refactoring would require interface gymnastics that obscure the straightforward API calls.
-//
-//nolint:dupl
 func (c *Client) GetViewBuckets(ctx context.Context, projectID, viewID int64) ([]*models.ModelsBucket, error) {
 	params := project.NewGetProjectsIDViewsViewBucketsParams()
 	params.SetContext(ctx)
@@ -231,25 +270,3 @@ func (c *Client) GetViewBuckets(ctx context.Context, projectID, viewID int64) ([
 
 	return result.Payload, nil
 }
-
-// GetViewTasks retrieves all tasks for the specified project and view.
-//
-// Duplicates GetViewBuckets due to generated swagger client patterns. Each method uses
-// a different resource client (tasks vs projects) with identical parameter handling.
-// Refactoring would require interface gymnastics that obscure the straightforward API calls.
-//
-//nolint:dupl
-func (c *Client) GetViewTasks(ctx context.Context, projectID, viewID int64) ([]*models.ModelsTask, error) {
-	params := task.NewGetProjectsIDViewsViewTasksParams()
-	params.SetContext(ctx)
-	params.SetHTTPClient(c.httpClient())
-	params.SetID(projectID)
-	params.SetView(viewID)
-
-	result, err := c.tasks.GetProjectsIDViewsViewTasks(params, c.auth)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get view tasks: %w", err)
-	}
-
-	return result.Payload, nil
-}
@@ -0,0 +1,62 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/vikunja-client-go/client/project"
+)
+
+// CreateBucket creates a new bucket in the specified project view.
+func (c *Client) CreateBucket(ctx context.Context, projectID, viewID int64, bucket *Bucket) (*Bucket, error) {
+	params := project.NewPutProjectsIDViewsViewBucketsParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetID(projectID)
+	params.SetView(viewID)
+	params.SetBucket(bucket)
+
+	result, err := c.projects.PutProjectsIDViewsViewBuckets(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bucket: %w", err)
+	}
+
+	return result.Payload, nil
+}
+
+// UpdateBucket stores bucket within the specified project view.
+// Vikunja overwrites the title, limit and position on update, so bucket must carry all of them.
+func (c *Client) UpdateBucket(ctx context.Context, projectID, viewID int64, bucket *Bucket) (*Bucket, error) {
+	params := project.NewPostProjectsProjectIDViewsViewBucketsBucketIDParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetProjectID(projectID)
+	params.SetView(viewID)
+	params.SetBucketID(bucket.ID)
+	params.SetBucket(bucket)
+
+	result, err := c.projects.PostProjectsProjectIDViewsViewBucketsBucketID(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bucket: %w", err)
+	}
+
+	return result.Payload, nil
+}
+
+// SetBucketPosition moves a bucket to the given position within the view, keeping its other settings.
+func (c *Client) SetBucketPosition(ctx context.Context, projectID, viewID, bucketID int64, position float64) error {
+	buckets, err := c.GetViewBuckets(ctx, projectID, viewID)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range buckets {
+		if b.ID == bucketID {
+			updated := *b
+			updated.Position = position
+			_, err := c.UpdateBucket(ctx, projectID, viewID, &updated)
+			return err
+		}
+	}
+	return fmt.Errorf("bucket %d not found in view %d", bucketID, viewID)
+}
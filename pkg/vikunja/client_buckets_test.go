@@ -0,0 +1,49 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetBucketPosition(t *testing.T) {
+	t.Parallel()
+	var received Bucket
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/7/views/3/buckets":
+			fmt.Fprint(w, `[{"id":10,"title":"Todo","position":1,"limit":4},{"id":11,"title":"Done","position":2}]`) //nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/projects/7/views/3/buckets/10":
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			fmt.Fprint(w, `{"id":10,"title":"Todo","position":300}`) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	require.NoError(t, client.SetBucketPosition(t.Context(), 7, 3, 10, 300))
+
+	assert.Equal(t, "Todo", received.Title)
+	assert.InDelta(t, 300, received.Position, 0)
+	require.NotNil(t, received.Limit)
+	assert.Equal(t, int64(4), *received.Limit)
+}
+
+func TestClient_SetBucketPosition_UnknownBucket(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":10,"title":"Todo"}]`) //nolint:errcheck
+	})
+
+	err := client.SetBucketPosition(t.Context(), 7, 3, 99, 100)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bucket 99 not found in view 3")
+}
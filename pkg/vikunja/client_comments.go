@@ -0,0 +1,79 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/meschbach/vikunja-client-go/client/task"
+	"github.com/meschbach/vikunja-client-go/models"
+)
+
+// TaskComment is a comment on a task.
+type TaskComment = models.ModelsTaskComment
+
+// CommentSummary describes a task's discussion without the comments themselves.
+type CommentSummary struct {
+	Count int64 `json:"count"`
+	// LatestAt is when the most recent comment was written, empty without comments
+	LatestAt string `json:"latest_at,omitempty"`
+	// LatestAuthor is the username of the most recent comment's author
+	LatestAuthor string `json:"latest_author,omitempty"`
+}
+
+// SummarizeComments describes the discussion of task, read with TaskExpandCommentCount, from its
+// latest comment, which may be nil.
+func SummarizeComments(task *Task, latest *TaskComment) *CommentSummary {
+	summary := &CommentSummary{Count: task.CommentCount}
+	if latest == nil {
+		return summary
+	}
+	summary.LatestAt = latest.Created
+	if latest.Author != nil {
+		summary.LatestAuthor = latest.Author.Username
+	}
+	return summary
+}
+
+// LatestTaskComment returns the most recent comment on a task, or nil when it has none. Only one
+// comment is requested, so a long discussion is not transferred to find it.
+func (c *Client) LatestTaskComment(ctx context.Context, taskID int64) (*TaskComment, error) {
+	params := task.NewGetTasksTaskIDCommentsParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetTaskID(taskID)
+	newestFirst := "desc"
+	params.SetOrderBy(&newestFirst)
+
+	result, err := c.tasks.GetTasksTaskIDComments(params, c.auth, withQueryParam("per_page", "1"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments of task %d: %w", taskID, err)
+	}
+	return latestComment(result.Payload), nil
+}
+
+// latestComment returns the most recently created of comments, or nil when there are none. A
+// server ignoring the requested order or page size still yields the latest comment.
+func latestComment(comments []*TaskComment) *TaskComment {
+	var latest *TaskComment
+	for _, comment := range comments {
+		if latest == nil || parseDate(comment.Created).After(parseDate(latest.Created)) {
+			latest = comment
+		}
+	}
+	return latest
+}
+
+// withQueryParam adds a query parameter the generated request parameters do not offer.
+func withQueryParam(name, value string) task.ClientOption {
+	return func(op *runtime.ClientOperation) {
+		next := op.Params
+		op.Params = runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, reg strfmt.Registry) error {
+			if err := next.WriteToRequest(req, reg); err != nil {
+				return err
+			}
+			return req.SetQueryParam(name, value)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package vikunja
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestTaskComment_IgnoredOrder(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks/5/comments", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":1,"created":"2026-10-01T08:00:00Z"},{"id":3,"created":"2026-10-14T09:30:00Z"},{"id":2,"created":"2026-10-07T12:00:00Z"}]`) //nolint:errcheck
+	})
+
+	latest, err := client.LatestTaskComment(t.Context(), 5)
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.Equal(t, int64(3), latest.ID)
+}
+
+func TestLatestTaskComment_NoComments(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`) //nolint:errcheck
+	})
+
+	latest, err := client.LatestTaskComment(t.Context(), 5)
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+}
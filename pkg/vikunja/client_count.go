@@ -0,0 +1,100 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+)
+
+// paginationTotalPagesHeader reports how many pages a listing spans at the requested page size.
+const paginationTotalPagesHeader = "x-pagination-total-pages"
+
+// CountTasks returns the number of tasks matching a Vikunja filter query.
+//
+// It asks for one task per page and reads the page count header, so no task list is
+// downloaded. Servers that omit the header are counted by fetching every page instead.
+func (c *Client) CountTasks(ctx context.Context, filter string) (int64, error) {
+	total, ok, err := c.countTasksFromHeader(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+	if ok {
+		return total, nil
+	}
+
+	total, err = c.countTasksByFetching(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+	return total, nil
+}
+
+// countTasksFromHeader reports false when the server did not send the page count header.
+func (c *Client) countTasksFromHeader(ctx context.Context, filter string) (int64, bool, error) {
+	op := tasksPageOperation(filter, 1, 1)
+	reader := runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, _ runtime.Consumer) (any, error) {
+		if err := op.checkStatus(resp); err != nil {
+			return nil, err
+		}
+		return resp.GetHeader(paginationTotalPagesHeader), nil
+	})
+
+	result, err := c.submit(ctx, op, reader)
+	if err != nil {
+		return 0, false, err
+	}
+	header, _ := result.(string)
+	if header == "" {
+		return 0, false, nil
+	}
+	total, err := strconv.ParseInt(header, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s header %q: %w", paginationTotalPagesHeader, header, err)
+	}
+	return total, true, nil
+}
+
+func (c *Client) countTasksByFetching(ctx context.Context, filter string) (int64, error) {
+	var total int64
+	for page := int64(1); ; page++ {
+		tasks, err := c.GetTasksPage(ctx, filter, page)
+		if err != nil {
+			return 0, err
+		}
+		total += int64(len(tasks.Tasks))
+		if len(tasks.Tasks) < viewTasksPageSize {
+			return total, nil
+		}
+	}
+}
+
+// GetAllTasks retrieves every task matching a Vikunja filter query, requesting page after page
+// until a short one. Unlike GetFilteredTasks it is not limited to the server's default page size.
+func (c *Client) GetAllTasks(ctx context.Context, filter string) ([]*Task, error) {
+	var all []*Task
+	for page := int64(1); ; page++ {
+		tasks, err := c.GetTasksPage(ctx, filter, page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, tasks.Tasks...)
+		if len(tasks.Tasks) < viewTasksPageSize {
+			return all, nil
+		}
+	}
+}
+
+// tasksPageOperation builds a request for one page of the cross-project task listing.
+func tasksPageOperation(filter string, page, perPage int64) rawOperation {
+	query := map[string]string{
+		"page":     strconv.FormatInt(page, 10),
+		"per_page": strconv.FormatInt(perPage, 10),
+	}
+	if filter != "" {
+		query["filter"] = filter
+	}
+	return rawOperation{id: "GetTasks", method: http.MethodGet, path: "/tasks", query: query}
+}
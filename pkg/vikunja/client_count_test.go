@@ -0,0 +1,54 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CountTasks_UsesPaginationHeader(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		assert.Equal(t, "project = 7 && done = false", r.URL.Query().Get("filter"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-pagination-total-pages", "128")
+		w.Write([]byte(`[{"id":1,"title":"One"}]`)) //nolint:errcheck
+	})
+
+	count, err := client.CountTasks(t.Context(), "project = 7 && done = false")
+	require.NoError(t, err)
+	assert.Equal(t, int64(128), count)
+}
+
+func TestClient_CountTasks_FallsBackToFetching(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		var tasks []Task
+		switch {
+		case query.Get("per_page") == "1":
+			tasks = make([]Task, 1)
+		case query.Get("page") == "1":
+			tasks = make([]Task, viewTasksPageSize)
+		case query.Get("page") == "2":
+			tasks = make([]Task, 3)
+		default:
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(tasks) //nolint:errcheck
+	})
+
+	count, err := client.CountTasks(t.Context(), "done = true")
+	require.NoError(t, err)
+	assert.Equal(t, int64(viewTasksPageSize+3), count)
+	assert.Equal(t, 3, requests)
+}
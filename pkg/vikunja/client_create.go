@@ -0,0 +1,40 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/vikunja-client-go/client/project"
+	"github.com/meschbach/vikunja-client-go/client/task"
+)
+
+// CreateProject creates a new project from p.
+func (c *Client) CreateProject(ctx context.Context, p *Project) (*Project, error) {
+	params := project.NewPutProjectsParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetProject(p)
+
+	result, err := c.projects.PutProjects(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	return result.Payload, nil
+}
+
+// CreateTaskInProject creates t in the specified project, keeping every field t carries.
+func (c *Client) CreateTaskInProject(ctx context.Context, projectID int64, t *Task) (*Task, error) {
+	params := task.NewPutProjectsIDTasksParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetID(projectID)
+	params.SetTask(t)
+
+	result, err := c.tasks.PutProjectsIDTasks(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task: %w", err)
+	}
+
+	return result.Payload, nil
+}
@@ -0,0 +1,103 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ProjectExportVersion is the format version written into every ProjectExport.
+const ProjectExportVersion = 1
+
+// ProjectExport is a portable snapshot of a project: the project itself, its views with their
+// buckets, and all of its tasks. Bucket membership is kept as task IDs so every task appears once.
+type ProjectExport struct {
+	Version    int           `json:"version"`
+	ExportedAt time.Time     `json:"exported_at"`
+	Project    *Project      `json:"project"`
+	Views      []*ViewExport `json:"views"`
+	Tasks      []*Task       `json:"tasks"`
+}
+
+// ViewExport is one view of a ProjectExport. Only kanban views carry buckets.
+type ViewExport struct {
+	View    *ProjectView    `json:"view"`
+	Buckets []*BucketExport `json:"buckets,omitempty"`
+}
+
+// BucketExport is one kanban bucket of a ViewExport along with the IDs of the tasks it holds.
+type BucketExport struct {
+	Bucket  *Bucket `json:"bucket"`
+	TaskIDs []int64 `json:"task_ids"`
+}
+
+// ExportProject gathers a ProjectExport for the project. The project, its views and its tasks are
+// requested concurrently, followed by the buckets of every kanban view; all listings are paged through.
+func (c *Client) ExportProject(ctx context.Context, projectID int64) (*ProjectExport, error) {
+	export := &ProjectExport{Version: ProjectExportVersion, ExportedAt: time.Now().UTC()}
+	var views []*ProjectView
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		export.Project, err = c.GetProject(gctx, projectID)
+		return err
+	})
+	g.Go(func() (err error) {
+		views, err = c.GetProjectViews(gctx, projectID)
+		return err
+	})
+	g.Go(func() (err error) {
+		export.Tasks, err = c.GetAllTasks(gctx, fmt.Sprintf("project = %d", projectID))
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to export project %d: %w", projectID, err)
+	}
+
+	var err error
+	if export.Views, err = c.exportViews(ctx, projectID, views); err != nil {
+		return nil, fmt.Errorf("failed to export project %d: %w", projectID, err)
+	}
+	return export, nil
+}
+
+// exportViews wraps each view, loading the buckets of kanban views concurrently.
+func (c *Client) exportViews(ctx context.Context, projectID int64, views []*ProjectView) ([]*ViewExport, error) {
+	exports := make([]*ViewExport, len(views))
+	g, gctx := errgroup.WithContext(ctx)
+	for i, view := range views {
+		exports[i] = &ViewExport{View: view}
+		if view.ViewKind != ViewKindKanban {
+			continue
+		}
+		g.Go(func() error {
+			viewTasks, err := c.GetViewTasks(gctx, projectID, view.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get buckets of view %d: %w", view.ID, err)
+			}
+			exports[i].Buckets = exportBuckets(viewTasks.Buckets)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
+
+// exportBuckets replaces each bucket's tasks with their IDs.
+func exportBuckets(buckets []*Bucket) []*BucketExport {
+	exports := make([]*BucketExport, 0, len(buckets))
+	for _, b := range buckets {
+		taskIDs := make([]int64, 0, len(b.Tasks))
+		for _, t := range b.Tasks {
+			taskIDs = append(taskIDs, t.ID)
+		}
+		bucket := *b
+		bucket.Tasks = nil
+		exports = append(exports, &BucketExport{Bucket: &bucket, TaskIDs: taskIDs})
+	}
+	return exports
+}
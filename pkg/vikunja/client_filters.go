@@ -0,0 +1,74 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/vikunja-client-go/client/filter"
+)
+
+// FavoritesProjectID is the pseudo-project Vikunja uses to group favorite tasks and projects.
+const FavoritesProjectID int64 = -1
+
+// SavedFilterProjectID returns the pseudo-project ID under which Vikunja lists a saved filter.
+func SavedFilterProjectID(filterID int64) int64 {
+	return -filterID - 1
+}
+
+// SavedFilterID returns the saved filter behind a pseudo-project ID, reporting false for
+// IDs that do not belong to a saved filter.
+func SavedFilterID(projectID int64) (int64, bool) {
+	if projectID >= FavoritesProjectID {
+		return 0, false
+	}
+	return -projectID - 1, true
+}
+
+// GetSavedFilters lists the user's saved filters. Vikunja has no listing endpoint for them,
+// so they are picked out of the project list. The filter query is left empty; use
+// GetSavedFilter to load it.
+func (c *Client) GetSavedFilters(ctx context.Context) ([]SavedFilter, error) {
+	projects, err := c.GetProjects(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved filters: %w", err)
+	}
+
+	filters := make([]SavedFilter, 0)
+	for _, p := range projects {
+		filterID, ok := SavedFilterID(p.ID)
+		if !ok {
+			continue
+		}
+		filters = append(filters, SavedFilter{
+			ID:          filterID,
+			ProjectID:   p.ID,
+			Title:       p.Title,
+			Description: p.Description,
+			IsFavorite:  p.IsFavorite,
+		})
+	}
+	return filters, nil
+}
+
+// GetSavedFilter retrieves a saved filter, including its filter query.
+func (c *Client) GetSavedFilter(ctx context.Context, filterID int64) (*SavedFilter, error) {
+	params := filter.NewGetFiltersIDParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetID(filterID)
+
+	result, err := c.filters.GetFiltersID(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved filter: %w", err)
+	}
+
+	f := result.Payload
+	return &SavedFilter{
+		ID:          f.ID,
+		ProjectID:   SavedFilterProjectID(f.ID),
+		Title:       f.Title,
+		Description: f.Description,
+		Filter:      f.Filters.Filter,
+		IsFavorite:  f.IsFavorite,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package vikunja
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedFilterID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		projectID int64
+		want      int64
+		ok        bool
+	}{
+		{projectID: -2, want: 1, ok: true},
+		{projectID: -43, want: 42, ok: true},
+		{projectID: FavoritesProjectID, ok: false},
+		{projectID: 0, ok: false},
+		{projectID: 5, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.projectID), func(t *testing.T) {
+			t.Parallel()
+			got, ok := SavedFilterID(tt.projectID)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+			if ok {
+				assert.Equal(t, tt.projectID, SavedFilterProjectID(got))
+			}
+		})
+	}
+}
+
+func TestClient_GetSavedFilters(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/projects", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":-1,"title":"Favorites"},{"id":3,"title":"Inbox"},{"id":-5,"title":"Urgent","description":"High priority"}]`) //nolint:errcheck
+	})
+
+	filters, err := client.GetSavedFilters(t.Context())
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, SavedFilter{ID: 4, ProjectID: -5, Title: "Urgent", Description: "High priority"}, filters[0])
+}
+
+func TestClient_GetSavedFilter(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/filters/4", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":4,"title":"Urgent","filters":{"filter":"priority >= 4"}}`) //nolint:errcheck
+	})
+
+	saved, err := client.GetSavedFilter(t.Context(), 4)
+	require.NoError(t, err)
+	assert.Equal(t, "priority >= 4", saved.Filter)
+	assert.Equal(t, int64(-5), saved.ProjectID)
+}
@@ -0,0 +1,231 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+)
+
+// Steps of ImportProject, as reported in ImportFailure.Step.
+const (
+	ImportStepView             = "view"
+	ImportStepBucket           = "bucket"
+	ImportStepTask             = "task"
+	ImportStepBucketAssignment = "bucket_assignment"
+	ImportStepViewSettings     = "view_settings"
+)
+
+// ProjectImport reports what ImportProject created: the new project and, for views, buckets and
+// tasks, the mapping from their IDs in the export to their new IDs.
+type ProjectImport struct {
+	ProjectID int64           `json:"project_id"`
+	Views     map[int64]int64 `json:"views"`
+	Buckets   map[int64]int64 `json:"buckets"`
+	Tasks     map[int64]int64 `json:"tasks"`
+	Failures  []ImportFailure `json:"failures,omitempty"`
+}
+
+// ImportFailure is one part of an export that could not be recreated. OldID is its ID in the export.
+type ImportFailure struct {
+	Step  string `json:"step"`
+	OldID int64  `json:"old_id"`
+	Error string `json:"error"`
+}
+
+// ImportProject recreates an exported project as a new project, in dependency order: the project,
+// its views, their buckets, the tasks, and finally the tasks' bucket assignments.
+//
+// Only a failure to create the project itself is returned as an error. Anything after that which
+// fails is recorded in Failures and the import carries on; parts depending on it are left out.
+// Vikunja gives new projects and kanban views default views and buckets; those whose kind and
+// title match an exported one are reused instead of creating a duplicate.
+func (c *Client) ImportProject(ctx context.Context, export *ProjectExport) (*ProjectImport, error) {
+	if export == nil || export.Project == nil {
+		return nil, fmt.Errorf("project export holds no project")
+	}
+	if export.Version != ProjectExportVersion {
+		return nil, fmt.Errorf("unsupported project export version %d, expected %d", export.Version, ProjectExportVersion)
+	}
+
+	created, err := c.CreateProject(ctx, portableProject(export.Project))
+	if err != nil {
+		return nil, err
+	}
+
+	imp := &projectImporter{client: c, export: export, result: &ProjectImport{
+		ProjectID: created.ID,
+		Views:     map[int64]int64{},
+		Buckets:   map[int64]int64{},
+		Tasks:     map[int64]int64{},
+	}}
+	imp.createViews(ctx)
+	imp.createTasks(ctx)
+	imp.assignBuckets(ctx)
+	imp.linkViewBuckets(ctx)
+	return imp.result, nil
+}
+
+// projectImporter carries the state of one ImportProject call.
+type projectImporter struct {
+	client *Client
+	export *ProjectExport
+	result *ProjectImport
+}
+
+func (imp *projectImporter) fail(step string, oldID int64, err error) {
+	imp.result.Failures = append(imp.result.Failures, ImportFailure{Step: step, OldID: oldID, Error: err.Error()})
+}
+
+func (imp *projectImporter) createViews(ctx context.Context) {
+	projectID := imp.result.ProjectID
+	available, err := imp.client.GetProjectViews(ctx, projectID)
+	if err != nil {
+		imp.fail(ImportStepView, 0, err)
+	}
+
+	for _, ve := range imp.export.Views {
+		view := takeMatchingView(&available, ve.View)
+		if view == nil {
+			if view, err = imp.client.CreateProjectView(ctx, projectID, portableView(ve.View)); err != nil {
+				imp.fail(ImportStepView, ve.View.ID, err)
+				continue
+			}
+		}
+		imp.result.Views[ve.View.ID] = view.ID
+		if len(ve.Buckets) > 0 {
+			imp.createBuckets(ctx, view.ID, ve.Buckets)
+		}
+	}
+}
+
+func (imp *projectImporter) createBuckets(ctx context.Context, viewID int64, buckets []*BucketExport) {
+	projectID := imp.result.ProjectID
+	available, err := imp.client.GetViewBuckets(ctx, projectID, viewID)
+	if err != nil {
+		imp.fail(ImportStepBucket, 0, err)
+	}
+
+	for _, be := range buckets {
+		bucket := takeMatchingBucket(&available, be.Bucket)
+		if bucket == nil {
+			if bucket, err = imp.client.CreateBucket(ctx, projectID, viewID, portableBucket(be.Bucket)); err != nil {
+				imp.fail(ImportStepBucket, be.Bucket.ID, err)
+				continue
+			}
+		}
+		imp.result.Buckets[be.Bucket.ID] = bucket.ID
+	}
+}
+
+func (imp *projectImporter) createTasks(ctx context.Context) {
+	for _, t := range imp.export.Tasks {
+		created, err := imp.client.CreateTaskInProject(ctx, imp.result.ProjectID, portableTask(t))
+		if err != nil {
+			imp.fail(ImportStepTask, t.ID, err)
+			continue
+		}
+		imp.result.Tasks[t.ID] = created.ID
+	}
+}
+
+func (imp *projectImporter) assignBuckets(ctx context.Context) {
+	for _, ve := range imp.export.Views {
+		viewID, ok := imp.result.Views[ve.View.ID]
+		if !ok {
+			continue
+		}
+		for _, be := range ve.Buckets {
+			if bucketID, ok := imp.result.Buckets[be.Bucket.ID]; ok {
+				imp.assignBucketTasks(ctx, viewID, bucketID, be.TaskIDs)
+			}
+		}
+	}
+}
+
+func (imp *projectImporter) assignBucketTasks(ctx context.Context, viewID, bucketID int64, oldTaskIDs []int64) {
+	for _, oldID := range oldTaskIDs {
+		taskID, ok := imp.result.Tasks[oldID]
+		if !ok {
+			continue
+		}
+		if _, err := imp.client.MoveTaskToBucket(ctx, imp.result.ProjectID, viewID, bucketID, taskID); err != nil {
+			imp.fail(ImportStepBucketAssignment, oldID, err)
+		}
+	}
+}
+
+// linkViewBuckets points the views' default and done buckets at the recreated buckets.
+func (imp *projectImporter) linkViewBuckets(ctx context.Context) {
+	for _, ve := range imp.export.Views {
+		viewID, ok := imp.result.Views[ve.View.ID]
+		if !ok || (ve.View.DefaultBucketID == 0 && ve.View.DoneBucketID == 0) {
+			continue
+		}
+		view := portableView(ve.View)
+		view.DefaultBucketID = imp.result.Buckets[ve.View.DefaultBucketID]
+		view.DoneBucketID = imp.result.Buckets[ve.View.DoneBucketID]
+		if _, err := imp.client.UpdateProjectView(ctx, imp.result.ProjectID, viewID, view); err != nil {
+			imp.fail(ImportStepViewSettings, ve.View.ID, err)
+		}
+	}
+}
+
+// takeMatchingView removes and returns the first view in available with the same kind and title as view.
+func takeMatchingView(available *[]*ProjectView, view *ProjectView) *ProjectView {
+	for i, candidate := range *available {
+		if candidate.ViewKind == view.ViewKind && candidate.Title == view.Title {
+			*available = append((*available)[:i], (*available)[i+1:]...)
+			return candidate
+		}
+	}
+	return nil
+}
+
+// takeMatchingBucket removes and returns the first bucket in available with the same title as bucket.
+func takeMatchingBucket(available *[]*Bucket, bucket *Bucket) *Bucket {
+	for i, candidate := range *available {
+		if candidate.Title == bucket.Title {
+			*available = append((*available)[:i], (*available)[i+1:]...)
+			return candidate
+		}
+	}
+	return nil
+}
+
+// The portable* helpers copy the fields a new object can be created with, leaving out IDs,
+// timestamps and everything else the target server assigns.
+
+func portableProject(p *Project) *Project {
+	return &Project{Title: p.Title, Description: p.Description, HexColor: p.HexColor}
+}
+
+func portableView(v *ProjectView) *ProjectView {
+	return &ProjectView{
+		Title:                   v.Title,
+		ViewKind:                v.ViewKind,
+		Position:                v.Position,
+		Filter:                  v.Filter,
+		BucketConfigurationMode: v.BucketConfigurationMode,
+		BucketConfiguration:     v.BucketConfiguration,
+	}
+}
+
+func portableBucket(b *Bucket) *Bucket {
+	return &Bucket{Title: b.Title, Limit: b.Limit, Position: b.Position}
+}
+
+func portableTask(t *Task) *Task {
+	return &Task{
+		Title:       t.Title,
+		Description: t.Description,
+		Done:        t.Done,
+		DueDate:     t.DueDate,
+		StartDate:   t.StartDate,
+		EndDate:     t.EndDate,
+		HexColor:    t.HexColor,
+		PercentDone: t.PercentDone,
+		Priority:    t.Priority,
+		RepeatAfter: t.RepeatAfter,
+		RepeatMode:  t.RepeatMode,
+		IsFavorite:  t.IsFavorite,
+	}
+}
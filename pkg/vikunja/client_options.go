@@ -0,0 +1,75 @@
+package vikunja
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultMaxIdleConns is the default bound on idle connections kept across all hosts.
+	DefaultMaxIdleConns = 100
+	// DefaultMaxIdleConnsPerHost is the default bound on idle connections kept to the Vikunja host.
+	// It covers several concurrent tool calls, each fanning out a handful of requests, so
+	// parallel requests reuse connections instead of opening new ones.
+	DefaultMaxIdleConnsPerHost = 16
+	// DefaultIdleConnTimeout is how long an idle connection is kept before it is closed.
+	DefaultIdleConnTimeout = 90 * time.Second
+
+	// requestTimeout bounds a single HTTP request to Vikunja.
+	requestTimeout = 30 * time.Second
+)
+
+// ClientOptions tunes the HTTP connection pool the client uses to talk to Vikunja.
+type ClientOptions struct {
+	// MaxIdleConns bounds the idle connections kept across all hosts; zero means no limit.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost bounds the idle connections kept per host.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept; zero means no limit.
+	IdleConnTimeout time.Duration
+	// Logger receives a debug line for every request sent to Vikunja; nil uses slog.Default().
+	Logger *slog.Logger
+	// Observer, when set, is told the latency of every request sent to Vikunja.
+	Observer RequestObserver
+	// ExtraHeaders are added to every request sent to Vikunja; reserved headers such as
+	// Authorization are never replaced.
+	ExtraHeaders http.Header
+}
+
+// RequestObserver receives the outcome of each request the client sends to Vikunja.
+type RequestObserver interface {
+	// ObserveRequest is called once per request; status is zero when no response arrived.
+	ObserveRequest(method string, status int, duration time.Duration)
+}
+
+// DefaultClientOptions returns connection pool settings suited to concurrent requests.
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
+		MaxIdleConns:        DefaultMaxIdleConns,
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
+	}
+}
+
+// newHTTPClient builds the HTTP client shared by every request the client makes.
+// The transport starts from http.DefaultTransport so proxy, dial and TLS settings are kept,
+// logs each request with the trace ID of its context, adds any extra headers, and retries failed
+// reads while the context's retry budget lasts.
+func newHTTPClient(opts ClientOptions) *http.Client {
+	var transport *http.Transport
+	if base, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = base.Clone()
+	} else {
+		transport = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+	transport.MaxIdleConns = opts.MaxIdleConns
+	transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = opts.IdleConnTimeout
+
+	var next http.RoundTripper = &instrumentedTransport{next: transport, logger: opts.Logger, observer: opts.Observer}
+	if len(opts.ExtraHeaders) > 0 {
+		next = &headerTransport{next: next, headers: opts.ExtraHeaders}
+	}
+	return &http.Client{Transport: &retryTransport{next: next}, Timeout: requestTimeout}
+}
@@ -0,0 +1,76 @@
+package vikunja
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// poolTransport returns the *http.Transport under the client's retries and request instrumentation
+func poolTransport(t *testing.T, client *Client) *http.Transport {
+	t.Helper()
+	retrying, ok := client.httpClient().Transport.(*retryTransport)
+	require.True(t, ok, "requests are retried")
+	instrumented, ok := retrying.next.(*instrumentedTransport)
+	require.True(t, ok, "requests are instrumented")
+	transport, ok := instrumented.next.(*http.Transport)
+	require.True(t, ok, "the client uses its own *http.Transport")
+	return transport
+}
+
+func TestNewClientWithOptions_ConfiguresTransport(t *testing.T) {
+	t.Parallel()
+	opts := ClientOptions{MaxIdleConns: 42, MaxIdleConnsPerHost: 7, IdleConnTimeout: 15 * time.Second}
+
+	client, err := NewClientWithOptions("vikunja.example.com", "test-token", false, opts)
+	require.NoError(t, err)
+
+	transport := poolTransport(t, client)
+	assert.Equal(t, 42, transport.MaxIdleConns)
+	assert.Equal(t, 7, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 15*time.Second, transport.IdleConnTimeout)
+	assert.NotSame(t, http.DefaultTransport, transport, "tuning must not change the process-wide default transport")
+}
+
+func TestNewClient_UsesDefaultClientOptions(t *testing.T) {
+	t.Parallel()
+	client, err := NewClient("vikunja.example.com", "test-token", false)
+	require.NoError(t, err)
+
+	transport := poolTransport(t, client)
+	assert.Equal(t, DefaultMaxIdleConns, transport.MaxIdleConns)
+	assert.Equal(t, DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, DefaultIdleConnTimeout, transport.IdleConnTimeout)
+}
+
+func TestClient_WithDryRun_SharesConnectionPool(t *testing.T) {
+	t.Parallel()
+	client, err := NewClient("vikunja.example.com", "test-token", false)
+	require.NoError(t, err)
+
+	assert.Same(t, client.httpClient(), client.WithDryRun().httpClient())
+}
+
+func TestNewClientWithOptions_ExtraHeaders(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "client-1", r.Header.Get("CF-Access-Client-Id"))
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"), "extra headers must not replace the token")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`) //nolint:errcheck
+	}))
+	t.Cleanup(ts.Close)
+
+	opts := DefaultClientOptions()
+	opts.ExtraHeaders = http.Header{"Cf-Access-Client-Id": {"client-1"}, "Authorization": {"Bearer stolen"}}
+	client, err := NewClientWithOptions(ts.URL, "test-token", true, opts)
+	require.NoError(t, err)
+
+	_, err = client.GetProjects(t.Context(), false)
+	require.NoError(t, err)
+}
@@ -0,0 +1,30 @@
+package vikunja
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetProjects_Archived(t *testing.T) {
+	t.Parallel()
+	for _, includeArchived := range []bool{false, true} {
+		t.Run(fmt.Sprint(includeArchived), func(t *testing.T) {
+			t.Parallel()
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/projects", r.URL.Path)
+				assert.Equal(t, fmt.Sprint(includeArchived), r.URL.Query().Get("is_archived"))
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `[{"id":1,"title":"Inbox"},{"id":2,"title":"Old","is_archived":true}]`) //nolint:errcheck
+			})
+
+			projects, err := client.GetProjects(t.Context(), includeArchived)
+			require.NoError(t, err)
+			require.Len(t, projects, 2)
+			assert.True(t, projects[1].IsArchived)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// rawOperation describes a JSON API call that bypasses the generated models.
+// Some generated models (e.g. link shares) cannot round-trip the wire format,
+// so these calls still use the shared transport but with hand-written types.
+type rawOperation struct {
+	id         string
+	method     string
+	path       string
+	pathParams map[string]string
+	query      map[string]string
+	body       any
+}
+
+// submitJSON sends op through the client transport and decodes a successful response into out.
+// Non-2xx responses are returned as *runtime.APIError carrying the status code.
+func submitJSON[T any](ctx context.Context, c *Client, op rawOperation, out *T) error {
+	reader := runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+		if err := op.checkStatus(resp); err != nil {
+			return nil, err
+		}
+		decoded := new(T)
+		if err := consumer.Consume(resp.Body(), decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	})
+
+	result, err := c.submit(ctx, op, reader)
+	if err != nil {
+		return err
+	}
+	decoded, ok := result.(*T)
+	if !ok {
+		return fmt.Errorf("unexpected response %T to %s %s", result, op.method, op.path)
+	}
+	*out = *decoded
+	return nil
+}
+
+// submit sends op through the client transport, leaving the response to reader.
+func (c *Client) submit(ctx context.Context, op rawOperation, reader runtime.ClientResponseReader) (any, error) {
+	return c.transport.Submit(&runtime.ClientOperation{
+		ID:                 op.id,
+		Method:             op.method,
+		PathPattern:        op.path,
+		ProducesMediaTypes: []string{runtime.JSONMime},
+		ConsumesMediaTypes: []string{runtime.JSONMime},
+		Params:             op.writer(),
+		Reader:             reader,
+		AuthInfo:           c.auth,
+		Context:            ctx,
+		Client:             c.httpClient(),
+	})
+}
+
+// writer fills a request with the operation's path and query parameters and body.
+func (op rawOperation) writer() runtime.ClientRequestWriter {
+	return runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		for k, v := range op.pathParams {
+			if err := r.SetPathParam(k, v); err != nil {
+				return err
+			}
+		}
+		for k, v := range op.query {
+			if err := r.SetQueryParam(k, v); err != nil {
+				return err
+			}
+		}
+		if op.body != nil {
+			return r.SetBodyParam(op.body)
+		}
+		return nil
+	})
+}
+
+// checkStatus turns a non-2xx response into a *runtime.APIError.
+func (op rawOperation) checkStatus(resp runtime.ClientResponse) error {
+	if resp.Code() < http.StatusOK || resp.Code() >= http.StatusMultipleChoices {
+		return runtime.NewAPIError(op.id, resp.Message(), resp.Code())
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/meschbach/vikunja-client-go/models"
+)
+
+// PermissionForRight maps a share right name to the Vikunja permission level.
+func PermissionForRight(right string) (models.ModelsPermission, error) {
+	for i, r := range ShareRights() {
+		if r == right {
+			return models.ModelsPermission(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown share right %q", right)
+}
+
+// RightForPermission names a Vikunja permission level by its share right, or returns an empty
+// string for a level it does not know.
+func RightForPermission(permission models.ModelsPermission) string {
+	rights := ShareRights()
+	if permission < 0 || int(permission) >= len(rights) {
+		return ""
+	}
+	return rights[permission]
+}
+
+// CanWrite reports whether the token may change a project: it needs at least read & write
+// permission, and pseudo projects such as Favorites are never writable.
+func CanWrite(project *Project) bool {
+	return project.ID > 0 && project.MaxPermission >= 1
+}
+
+// CreateLinkShare creates a public link share for the specified project with the given right.
+func (c *Client) CreateLinkShare(ctx context.Context, projectID int64, right string) (*LinkShare, error) {
+	permission, err := PermissionForRight(right)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create link share: %w", err)
+	}
+
+	share := &LinkShare{}
+	err = submitJSON(ctx, c, rawOperation{
+		id:         "PutProjectsProjectShares",
+		method:     http.MethodPut,
+		path:       "/projects/{project}/shares",
+		pathParams: map[string]string{"project": strconv.FormatInt(projectID, 10)},
+		body:       &LinkShare{Permission: permission},
+	}, share)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create link share: %w", err)
+	}
+
+	return share, nil
+}
+
+// LinkShareURL returns the frontend URL through which a link share hash is redeemed.
+func (c *Client) LinkShareURL(hash string) string {
+	return fmt.Sprintf("%s/share/%s/auth", c.baseURL, hash)
+}
@@ -0,0 +1,78 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateLinkShare(t *testing.T) {
+	t.Parallel()
+	var received map[string]any
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/projects/7/shares", r.URL.Path)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":4,"hash":"abc123","permission":1,"sharing_type":1}`) //nolint:errcheck
+	})
+
+	share, err := client.CreateLinkShare(t.Context(), 7, ShareRightReadWrite)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1, received["permission"], 0)
+	assert.Equal(t, int64(4), share.ID)
+	assert.Equal(t, "abc123", share.Hash)
+	assert.Equal(t, int64(1), int64(share.Permission))
+	assert.True(t, strings.HasSuffix(client.LinkShareURL(share.Hash), "/share/abc123/auth"))
+}
+
+func TestClient_CreateLinkShare_Forbidden(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Forbidden"}`) //nolint:errcheck
+	})
+
+	_, err := client.CreateLinkShare(t.Context(), 7, ShareRightRead)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create link share")
+}
+
+func TestPermissionForRight(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		right   string
+		want    int64
+		wantErr bool
+	}{
+		{ShareRightRead, 0, false},
+		{ShareRightReadWrite, 1, false},
+		{ShareRightAdmin, 2, false},
+		{"owner", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.right, func(t *testing.T) {
+			t.Parallel()
+			got, err := PermissionForRight(tt.right)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, int64(got))
+		})
+	}
+}
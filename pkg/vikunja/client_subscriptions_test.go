@@ -0,0 +1,63 @@
+package vikunja
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SubscribeToTask(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/subscriptions/task/5", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":9,"entity":2,"entity_id":5}`) //nolint:errcheck
+	})
+
+	subscription, err := client.SubscribeToTask(t.Context(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), subscription.ID)
+	assert.Equal(t, int64(5), subscription.EntityID)
+}
+
+func TestClient_UnsubscribeFromTask(t *testing.T) {
+	t.Parallel()
+	deleted := false
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/v1/subscriptions/task/5", r.URL.Path)
+		deleted = true
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":9,"entity":2,"entity_id":5}`) //nolint:errcheck
+	})
+
+	require.NoError(t, client.UnsubscribeFromTask(t.Context(), 5))
+	assert.True(t, deleted)
+}
+
+func TestClient_SubscribeToTask_Error(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Forbidden"}`) //nolint:errcheck
+	})
+
+	_, err := client.SubscribeToTask(t.Context(), 5)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to subscribe to task 5")
+}
+
+func TestIsSubscribed(t *testing.T) {
+	t.Parallel()
+	task := &Task{ID: 5}
+	assert.False(t, IsSubscribed(task))
+
+	task.Subscription.ID = 9
+	assert.True(t, IsSubscribed(task))
+}
@@ -0,0 +1,111 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/meschbach/vikunja-client-go/client/service"
+)
+
+// expandTaskBucketsSince is the first Vikunja release that honors expand=buckets on task reads
+const expandTaskBucketsSince = "0.24.0"
+
+// ServerInfo describes the Vikunja server the client talks to.
+type ServerInfo struct {
+	Version string `json:"version"`
+}
+
+// GetInfo retrieves information about the Vikunja server, such as its version.
+func (c *Client) GetInfo(ctx context.Context) (*ServerInfo, error) {
+	params := service.NewGetInfoParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+
+	result, err := c.service.GetInfo(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server info: %w", err)
+	}
+	return &ServerInfo{Version: result.Payload.Version}, nil
+}
+
+// ExpandsTaskBuckets reports whether the server fills task.Buckets when GetTask asks for them.
+// Versions that cannot be parsed, such as development builds, are assumed not to.
+func (c *Client) ExpandsTaskBuckets(ctx context.Context) (bool, error) {
+	info, err := c.GetInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+	return versionAtLeast(info.Version, expandTaskBucketsSince), nil
+}
+
+// FindTaskBuckets locates a task in the buckets of the given kanban views, for servers that do not
+// expand task buckets. The returned buckets carry the view they belong to but not their tasks.
+func (c *Client) FindTaskBuckets(ctx context.Context, projectID, taskID int64, views []*ProjectView) ([]*Bucket, error) {
+	var found []*Bucket
+	for _, view := range views {
+		if view.ViewKind != ViewKindKanban {
+			continue
+		}
+		response, err := c.GetViewTasks(ctx, projectID, view.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find task buckets in view %d: %w", view.ID, err)
+		}
+		if bucket := bucketContainingTask(response.Buckets, taskID); bucket != nil {
+			bucket.ProjectViewID = view.ID
+			found = append(found, bucket)
+		}
+	}
+	return found, nil
+}
+
+// bucketContainingTask returns a task-less copy of the bucket holding taskID, or nil
+func bucketContainingTask(buckets []*Bucket, taskID int64) *Bucket {
+	for _, b := range buckets {
+		for _, t := range b.Tasks {
+			if t.ID == taskID {
+				bucket := *b
+				bucket.Tasks = nil
+				return &bucket
+			}
+		}
+	}
+	return nil
+}
+
+// versionAtLeast reports whether a Vikunja version such as "v0.24.1" is at or after minimum.
+// Pre-release and build suffixes are ignored; unparsable versions compare as older.
+func versionAtLeast(version, minimum string) bool {
+	have, ok := parseVersion(version)
+	if !ok {
+		return false
+	}
+	want, _ := parseVersion(minimum)
+	for i := range have {
+		if have[i] != want[i] {
+			return have[i] > want[i]
+		}
+	}
+	return true
+}
+
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+	parts := strings.Split(version, ".")
+	if len(parts) != len(parsed) {
+		return parsed, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+	return parsed, true
+}
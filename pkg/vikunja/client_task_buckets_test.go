@@ -0,0 +1,31 @@
+package vikunja
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionAtLeast(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"v0.24.0", true},
+		{"0.24.1", true},
+		{"v1.0.0", true},
+		{"v0.24.0-rc1", true},
+		{"v0.22.1", false},
+		{"v0.23.9+build", false},
+		{"unstable", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, versionAtLeast(tt.version, expandTaskBucketsSince))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/vikunja-client-go/client/assignees"
+	"github.com/meschbach/vikunja-client-go/client/labels"
+	"github.com/meschbach/vikunja-client-go/models"
+)
+
+// GetTaskLabels retrieves the labels attached to a task.
+func (c *Client) GetTaskLabels(ctx context.Context, taskID int64) ([]*Label, error) {
+	params := labels.NewGetTasksTaskLabelsParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetTask(taskID)
+
+	result, err := c.labels.GetTasksTaskLabels(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task labels: %w", err)
+	}
+
+	return result.Payload, nil
+}
+
+// GetTaskAssignees retrieves the users assigned to a task.
+func (c *Client) GetTaskAssignees(ctx context.Context, taskID int64) ([]*User, error) {
+	params := assignees.NewGetTasksTaskIDAssigneesParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetTaskID(taskID)
+
+	result, err := c.assignees.GetTasksTaskIDAssignees(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task assignees: %w", err)
+	}
+
+	return result.Payload, nil
+}
+
+// ClearTaskLabels removes every label from a task. Vikunja's bulk endpoint replaces the task's
+// labels with the ones sent, so an empty list removes them all in one request.
+func (c *Client) ClearTaskLabels(ctx context.Context, taskID int64) error {
+	params := labels.NewPostTasksTaskIDLabelsBulkParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetTaskID(taskID)
+	params.SetLabel(&models.ModelsLabelTaskBulk{Labels: []*models.ModelsLabel{}})
+
+	if _, err := c.labels.PostTasksTaskIDLabelsBulk(params, c.auth); err != nil {
+		return fmt.Errorf("failed to clear task labels: %w", err)
+	}
+	return nil
+}
+
+// ClearTaskAssignees unassigns every user from a task. Vikunja's bulk endpoint replaces the
+// task's assignees with the ones sent, so an empty list removes them all in one request.
+func (c *Client) ClearTaskAssignees(ctx context.Context, taskID int64) error {
+	params := assignees.NewPostTasksTaskIDAssigneesBulkParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetTaskID(taskID)
+	params.SetAssignee(&models.ModelsBulkAssignees{Assignees: []*models.UserUser{}})
+
+	if _, err := c.assignees.PostTasksTaskIDAssigneesBulk(params, c.auth); err != nil {
+		return fmt.Errorf("failed to clear task assignees: %w", err)
+	}
+	return nil
+}
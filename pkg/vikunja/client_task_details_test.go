@@ -0,0 +1,71 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetTaskLabels(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks/5/labels", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":1,"title":"urgent","hex_color":"ff0000"}]`) //nolint:errcheck
+	})
+
+	labels, err := client.GetTaskLabels(t.Context(), 5)
+	require.NoError(t, err)
+	require.Len(t, labels, 1)
+	assert.Equal(t, "urgent", labels[0].Title)
+}
+
+func TestClient_GetTaskAssignees(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks/5/assignees", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":2,"username":"alex","name":"Alex"}]`) //nolint:errcheck
+	})
+
+	users, err := client.GetTaskAssignees(t.Context(), 5)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "alex", users[0].Username)
+}
+
+func TestClient_ClearTaskLabels(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/tasks/5/labels/bulk", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"labels":[]}`) //nolint:errcheck
+	})
+
+	require.NoError(t, client.ClearTaskLabels(t.Context(), 5))
+	assert.Equal(t, map[string]any{"labels": []any{}}, sent)
+}
+
+func TestClient_ClearTaskAssignees(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/tasks/5/assignees/bulk", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"assignees":[]}`) //nolint:errcheck
+	})
+
+	require.NoError(t, client.ClearTaskAssignees(t.Context(), 5))
+	assert.Equal(t, map[string]any{"assignees": []any{}}, sent)
+}
@@ -0,0 +1,94 @@
+package vikunja
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/meschbach/vikunja-client-go/client/task"
+)
+
+// TaskExpand names a relation Vikunja can include when reading a task.
+type TaskExpand = string
+
+// Task expand constants.
+const (
+	TaskExpandBuckets   TaskExpand = "buckets"
+	TaskExpandSubtasks  TaskExpand = "subtasks"
+	TaskExpandReactions TaskExpand = "reactions"
+	TaskExpandComments  TaskExpand = "comments"
+	// TaskExpandCommentCount reports the number of comments without the comments themselves
+	TaskExpandCommentCount TaskExpand = "comment_count"
+)
+
+// TaskExpands lists the relations a task read can expand.
+func TaskExpands() []TaskExpand {
+	return []TaskExpand{TaskExpandBuckets, TaskExpandSubtasks, TaskExpandReactions, TaskExpandComments, TaskExpandCommentCount}
+}
+
+// taskExpandQuery validates expand and joins it into the value of the expand query parameter,
+// dropping repeats. No expansions default to the task's buckets.
+func taskExpandQuery(expand []TaskExpand) (string, error) {
+	if len(expand) == 0 {
+		return TaskExpandBuckets, nil
+	}
+	var values []string
+	for _, e := range expand {
+		if !slices.Contains(TaskExpands(), e) {
+			return "", fmt.Errorf("invalid task expand: %q (must be one of %s)", e, strings.Join(TaskExpands(), ", "))
+		}
+		if !slices.Contains(values, e) {
+			values = append(values, e)
+		}
+	}
+	return strings.Join(values, ","), nil
+}
+
+// withRelatedTasks makes a task read decode the task's related tasks, which the generated model
+// cannot: it embeds the relation map in a struct, so encoding/json never fills it.
+func withRelatedTasks(op *runtime.ClientOperation) {
+	next := op.Reader
+	op.Reader = runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+		body, err := io.ReadAll(resp.Body())
+		if err != nil {
+			return nil, err
+		}
+		result, err := next.ReadResponse(&bufferedResponse{ClientResponse: resp, body: body}, consumer)
+		if read, isTask := result.(*task.GetTasksIDOK); isTask && read.Payload != nil {
+			decodeRelatedTasks(body, read.Payload)
+		}
+		return result, err
+	})
+}
+
+// decodeRelatedTasks fills t's related tasks from the task encoded in body
+func decodeRelatedTasks(body []byte, t *Task) {
+	var wire struct {
+		RelatedTasks map[string][]Task `json:"related_tasks"`
+	}
+	if json.Unmarshal(body, &wire) == nil {
+		t.RelatedTasks.ModelsRelatedTaskMap = wire.RelatedTasks
+	}
+}
+
+// bufferedResponse is a response whose body was already read, so it can be read again.
+type bufferedResponse struct {
+	runtime.ClientResponse
+	body []byte
+}
+
+func (r *bufferedResponse) Body() io.ReadCloser { return io.NopCloser(bytes.NewReader(r.body)) }
+
+// Subtasks returns the subtasks Vikunja reported among the task's related tasks.
+func Subtasks(task *Task) []*Task {
+	related := task.RelatedTasks.ModelsRelatedTaskMap["subtask"]
+	subtasks := make([]*Task, len(related))
+	for i := range related {
+		subtasks[i] = &related[i]
+	}
+	return subtasks
+}
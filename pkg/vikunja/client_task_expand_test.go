@@ -0,0 +1,65 @@
+package vikunja
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetTask_Expand(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		expand []TaskExpand
+		want   string
+	}{
+		{"defaults to buckets", nil, "buckets"},
+		{"combined", []TaskExpand{TaskExpandBuckets, TaskExpandSubtasks}, "buckets,subtasks"},
+		{"repeats dropped", []TaskExpand{TaskExpandReactions, TaskExpandReactions}, "reactions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.Query().Get("expand")
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"id":5,"title":"Ship it"}`) //nolint:errcheck
+			})
+
+			_, err := client.GetTask(t.Context(), 5, tt.expand...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetTask_UnknownExpand(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	_, err := client.GetTask(t.Context(), 5, TaskExpandBuckets, "attachments")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid task expand: "attachments"`)
+}
+
+func TestSubtasks(t *testing.T) {
+	t.Parallel()
+	task := &Task{ID: 5}
+	task.RelatedTasks.ModelsRelatedTaskMap = map[string][]Task{
+		"subtask":    {{ID: 6, Title: "Draft"}, {ID: 7, Title: "Review"}},
+		"parenttask": {{ID: 1, Title: "Launch"}},
+	}
+
+	subtasks := Subtasks(task)
+	require.Len(t, subtasks, 2)
+	assert.Equal(t, int64(6), subtasks[0].ID)
+	assert.Equal(t, int64(7), subtasks[1].ID)
+	assert.Empty(t, Subtasks(&Task{ID: 8}))
+}
@@ -0,0 +1,66 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+)
+
+// TaskPage is one page of the cross-project task listing.
+type TaskPage struct {
+	Tasks []*Task
+	// Page is the 1-based number of this page
+	Page int64
+	// TotalPages is the page count the server reported, or 0 when it sent none
+	TotalPages int64
+}
+
+// NextPage returns the number of the page after this one, or 0 when this is the last page.
+// Without a reported page count a full page is taken to mean more tasks follow.
+func (p *TaskPage) NextPage() int64 {
+	if p.TotalPages > 0 {
+		if p.Page < p.TotalPages {
+			return p.Page + 1
+		}
+		return 0
+	}
+	if len(p.Tasks) == viewTasksPageSize {
+		return p.Page + 1
+	}
+	return 0
+}
+
+// GetTasksPage retrieves one page of the tasks matching a Vikunja filter query, together with
+// the page count the server reports in the x-pagination-total-pages header.
+func (c *Client) GetTasksPage(ctx context.Context, filter string, page int64) (*TaskPage, error) {
+	op := tasksPageOperation(filter, page, viewTasksPageSize)
+	reader := runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+		if err := op.checkStatus(resp); err != nil {
+			return nil, err
+		}
+		result := &TaskPage{Page: page}
+		if err := consumer.Consume(resp.Body(), &result.Tasks); err != nil {
+			return nil, err
+		}
+		if header := resp.GetHeader(paginationTotalPagesHeader); header != "" {
+			total, err := strconv.ParseInt(header, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s header %q: %w", paginationTotalPagesHeader, header, err)
+			}
+			result.TotalPages = total
+		}
+		return result, nil
+	})
+
+	result, err := c.submit(ctx, op, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks (page %d): %w", page, err)
+	}
+	taskPage, ok := result.(*TaskPage)
+	if !ok {
+		return nil, fmt.Errorf("failed to get tasks (page %d): unexpected response %T", page, result)
+	}
+	return taskPage, nil
+}
@@ -0,0 +1,29 @@
+package vikunja
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskPage_NextPage(t *testing.T) {
+	t.Parallel()
+	full := make([]*Task, viewTasksPageSize)
+	tests := []struct {
+		name string
+		page TaskPage
+		want int64
+	}{
+		{"more pages reported", TaskPage{Page: 1, TotalPages: 3}, 2},
+		{"last reported page", TaskPage{Page: 3, TotalPages: 3}, 0},
+		{"full page without header", TaskPage{Tasks: full, Page: 2}, 3},
+		{"short page without header", TaskPage{Tasks: full[:1], Page: 2}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.page.NextPage())
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package vikunja
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient starts a mock Vikunja server backed by handler and returns a client pointed at it.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(ts.URL, "test-token", true)
+	require.NoError(t, err)
+	return client
+}
@@ -0,0 +1,170 @@
+package vikunja
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+
+	"github.com/meschbach/vikunja-client-go/client/project"
+)
+
+// UpdateTask saves t, replacing the task's stored fields with its values. Start from the task
+// returned by GetTask so fields that are not being changed keep their values.
+//
+// Task does not model every field Vikunja stores, and some it does, like reactions and related
+// tasks, do not survive being decoded and encoded again; a field sent wrong or left out of an
+// update is wiped. So the stored task is read first and sent back with only the fields t changed.
+func (c *Client) UpdateTask(ctx context.Context, t *Task) (*Task, error) {
+	var stored map[string]json.RawMessage
+	if err := submitJSON(ctx, c, taskOperation(http.MethodGet, t.ID, nil), &stored); err != nil {
+		return nil, fmt.Errorf("failed to read task before updating it: %w", err)
+	}
+	body, err := withChangedFields(stored, t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+
+	var updated Task
+	if err := submitJSON(ctx, c, taskOperation(http.MethodPost, t.ID, body), &updated); err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+	return &updated, nil
+}
+
+// taskOperation builds a request for the task with the given ID
+func taskOperation(method string, id int64, body any) rawOperation {
+	opID := "GetTasksID"
+	if method == http.MethodPost {
+		opID = "PostTasksID"
+	}
+	return rawOperation{
+		id:         opID,
+		method:     method,
+		path:       "/tasks/{id}",
+		pathParams: map[string]string{"id": strconv.FormatInt(id, 10)},
+		body:       body,
+	}
+}
+
+// withChangedFields returns the stored task with the fields t changes replaced. A field counts as
+// changed when t encodes it differently from the stored task decoded into a Task, so fields t
+// leaves alone are sent back exactly as stored, and fields t clears are left out. Stored fields a
+// Task cannot decode are always sent back as stored.
+func withChangedFields(stored map[string]json.RawMessage, t *Task) (map[string]json.RawMessage, error) {
+	before, opaque, err := storedFields(stored)
+	if err != nil {
+		return nil, err
+	}
+	after, err := encodeFields(t)
+	if err != nil {
+		return nil, err
+	}
+
+	body := maps.Clone(stored)
+	if body == nil {
+		body = map[string]json.RawMessage{}
+	}
+	for name, value := range after {
+		if !opaque[name] && !bytes.Equal(before[name], value) {
+			body[name] = value
+		}
+	}
+	for name := range before {
+		if _, kept := after[name]; !kept {
+			delete(body, name)
+		}
+	}
+	return body, nil
+}
+
+// storedFields returns the fields of the stored task as a Task read by GetTask encodes them, along
+// with the names of the stored fields a Task cannot decode
+func storedFields(stored map[string]json.RawMessage) (map[string]json.RawMessage, map[string]bool, error) {
+	decodable := make(map[string]json.RawMessage, len(stored))
+	opaque := map[string]bool{}
+	for name, value := range stored {
+		if decodesAsTask(name, value) {
+			decodable[name] = value
+		} else {
+			opaque[name] = true
+		}
+	}
+
+	storedJSON, err := json.Marshal(decodable)
+	if err != nil {
+		return nil, nil, err
+	}
+	var base Task
+	if err := json.Unmarshal(storedJSON, &base); err != nil {
+		return nil, nil, err
+	}
+	decodeRelatedTasks(storedJSON, &base)
+	fields, err := encodeFields(&base)
+	return fields, opaque, err
+}
+
+// decodesAsTask reports whether a Task can decode the stored field name holding value
+func decodesAsTask(name string, value json.RawMessage) bool {
+	field, err := json.Marshal(map[string]json.RawMessage{name: value})
+	if err != nil {
+		return false
+	}
+	var t Task
+	return json.Unmarshal(field, &t) == nil
+}
+
+// encodeFields encodes t as a map from JSON field name to encoded value
+func encodeFields(t *Task) (map[string]json.RawMessage, error) {
+	encoded, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// MoveTaskToProject moves a task to another project by updating its project_id, and returns
+// the task as stored afterwards. Bucket placements belong to the old project's views and do not
+// carry over: Vikunja puts the task into the new project's default buckets, which the returned
+// task reflects. A dry-run client returns the task as it would be sent.
+func (c *Client) MoveTaskToProject(ctx context.Context, taskID, newProjectID int64) (*Task, error) {
+	t, err := c.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	t.ProjectID = newProjectID
+	t.BucketID = 0
+	t.Buckets = nil
+
+	if _, err := c.UpdateTask(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to move task to project %d: %w", newProjectID, err)
+	}
+	if c.IsDryRun() {
+		return t, nil
+	}
+	return c.GetTask(ctx, taskID)
+}
+
+// UpdateProject saves p, replacing the project's stored fields with its values. Start from the
+// project returned by GetProject so fields that are not being changed keep their values.
+func (c *Client) UpdateProject(ctx context.Context, p *Project) (*Project, error) {
+	params := project.NewPostProjectsIDParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetID(p.ID)
+	params.SetProject(p)
+
+	result, err := c.projects.PostProjectsID(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+
+	return result.Payload, nil
+}
@@ -0,0 +1,183 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoServer answers a POST to path with the JSON body it received, as Vikunja does for updates,
+// and a GET of path with an empty object
+func echoServer(t *testing.T, path string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, path, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{}`) //nolint:errcheck
+			return
+		}
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			t.Errorf("encode response: %v", err)
+		}
+	}
+}
+
+func TestClient_UpdateTask_HexColor(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, echoServer(t, "/api/v1/tasks/5"))
+
+	updated, err := client.UpdateTask(t.Context(), &Task{ID: 5, Title: "Write report", ProjectID: 7, HexColor: "1973ff"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(5), updated.ID)
+	assert.Equal(t, "Write report", updated.Title)
+	assert.Equal(t, "1973ff", updated.HexColor)
+}
+
+func TestClient_UpdateTask_KeepsUnmodeledFields(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks/5", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"id":5,"title":"Draft","description":"Old notes","custom_field":{"mood":"🎉"}}`) //nolint:errcheck
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		fmt.Fprint(w, `{"id":5,"title":"Write report"}`) //nolint:errcheck
+	})
+
+	updated, err := client.UpdateTask(t.Context(), &Task{ID: 5, Title: "Write report"})
+	require.NoError(t, err)
+	assert.Equal(t, "Write report", updated.Title)
+
+	assert.Equal(t, "Write report", sent["title"])
+	assert.Equal(t, map[string]any{"mood": "🎉"}, sent["custom_field"], "fields Task does not model survive the update")
+	assert.NotContains(t, sent, "description", "modeled fields come from the updated task")
+}
+
+func TestClient_UpdateTask_KeepsReactions(t *testing.T) {
+	t.Parallel()
+	const reactions = `{"👍":[{"id":1,"username":"ana"}]}`
+	const related = `{"blocking":[{"id":9,"title":"Deploy"}]}`
+	var sent map[string]json.RawMessage
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks/5", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprintf(w, `{"id":5,"title":"Draft","reactions":%s,"related_tasks":%s}`, reactions, related) //nolint:errcheck
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		fmt.Fprint(w, `{"id":5,"title":"Write report"}`) //nolint:errcheck
+	})
+
+	task, err := client.GetTask(t.Context(), 5)
+	require.NoError(t, err)
+	task.Title = "Write report"
+	_, err = client.UpdateTask(t.Context(), task)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `"Write report"`, string(sent["title"]))
+	assert.JSONEq(t, reactions, string(sent["reactions"]), "reactions are sent back as stored")
+	assert.JSONEq(t, related, string(sent["related_tasks"]), "related tasks are sent back as stored")
+}
+
+func TestClient_UpdateTask_KeepsFieldsTaskCannotDecode(t *testing.T) {
+	t.Parallel()
+	var sent map[string]json.RawMessage
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"id":5,"title":"Draft","repeat_after":86400,"repeat_mode":1}`) //nolint:errcheck
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		fmt.Fprint(w, `{"id":5,"title":"Write report"}`) //nolint:errcheck
+	})
+
+	_, err := client.UpdateTask(t.Context(), &Task{ID: 5, Title: "Write report", RepeatAfter: 86400})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `"Write report"`, string(sent["title"]))
+	assert.JSONEq(t, `1`, string(sent["repeat_mode"]), "a field Task cannot decode is sent back as stored")
+}
+
+func TestClient_UpdateProject_HexColor(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, echoServer(t, "/api/v1/projects/7"))
+
+	updated, err := client.UpdateProject(t.Context(), &Project{ID: 7, Title: "Board", HexColor: "e8e8e8"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(7), updated.ID)
+	assert.Equal(t, "Board", updated.Title)
+	assert.Equal(t, "e8e8e8", updated.HexColor)
+}
+
+func TestClient_UpdateTask_Dates(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, echoServer(t, "/api/v1/tasks/5"))
+
+	updated, err := client.UpdateTask(t.Context(), &Task{
+		ID:        5,
+		Title:     "Write report",
+		StartDate: "2026-03-10T09:00:00Z",
+		EndDate:   "2026-03-12T17:00:00Z",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "2026-03-10T09:00:00Z", updated.StartDate)
+	assert.Equal(t, "2026-03-12T17:00:00Z", updated.EndDate)
+}
+
+func TestClient_MoveTaskToProject(t *testing.T) {
+	t.Parallel()
+	var sent map[string]any
+	moved := false
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tasks/5", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			moved = true
+			fmt.Fprint(w, `{"id":5,"title":"Write report","project_id":9}`) //nolint:errcheck
+		case moved:
+			fmt.Fprint(w, `{"id":5,"title":"Write report","project_id":9,"buckets":[{"id":30,"title":"Backlog","project_view_id":40}]}`) //nolint:errcheck
+		default:
+			fmt.Fprint(w, `{"id":5,"title":"Write report","project_id":7,"bucket_id":12,"buckets":[{"id":12,"title":"Doing","project_view_id":3}]}`) //nolint:errcheck
+		}
+	})
+
+	task, err := client.MoveTaskToProject(t.Context(), 5, 9)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 9, sent["project_id"], 0, "the update moves the task")
+	assert.Equal(t, "Write report", sent["title"], "other fields are kept")
+	assert.NotContains(t, sent, "bucket_id", "the old project's bucket is not sent")
+	assert.Empty(t, sent["buckets"])
+
+	assert.Equal(t, int64(9), task.ProjectID)
+	require.Len(t, task.Buckets, 1, "the task is re-fetched to show its new buckets")
+	assert.Equal(t, "Backlog", task.Buckets[0].Title)
+}
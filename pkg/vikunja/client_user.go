@@ -0,0 +1,36 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/vikunja-client-go/client/user"
+	"github.com/meschbach/vikunja-client-go/models"
+)
+
+// GetCurrentUser returns the user the token authenticates as, along with their settings.
+func (c *Client) GetCurrentUser(ctx context.Context) (*models.V1UserWithSettings, error) {
+	params := user.NewGetUserParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+
+	result, err := c.users.GetUser(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+	return result.Payload, nil
+}
+
+// GetDefaultProjectID returns the project the authenticated user's settings name as the default
+// for new tasks, or 0 when the user has not chosen one.
+func (c *Client) GetDefaultProjectID(ctx context.Context) (int64, error) {
+	current, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if current == nil || current.Settings == nil {
+		return 0, nil
+	}
+	return current.Settings.DefaultProjectID, nil
+}
@@ -0,0 +1,109 @@
+package vikunja
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// viewTasksPageSize is the number of tasks requested per page. On kanban views Vikunja
+// applies it to every bucket separately.
+const viewTasksPageSize = 50
+
+// GetViewTasks retrieves all tasks for the specified project and view.
+//
+// Kanban views answer with buckets that each carry only the first page of their tasks,
+// so the remaining pages are requested per bucket and merged in. Other views answer with
+// a flat task list, returned in Tasks.
+func (c *Client) GetViewTasks(ctx context.Context, projectID, viewID int64) (*ViewTasksResponse, error) {
+	var raw []json.RawMessage
+	if err := submitJSON(ctx, c, viewTasksOperation(projectID, viewID, 1, 0), &raw); err != nil {
+		return nil, fmt.Errorf("failed to get view tasks: %w", err)
+	}
+
+	if !isBucketList(raw) {
+		var tasks []*Task
+		if err := decodeRawList(raw, &tasks); err != nil {
+			return nil, fmt.Errorf("failed to decode view tasks: %w", err)
+		}
+		return &ViewTasksResponse{Tasks: tasks}, nil
+	}
+
+	var buckets []*Bucket
+	if err := decodeRawList(raw, &buckets); err != nil {
+		return nil, fmt.Errorf("failed to decode view buckets: %w", err)
+	}
+	for _, b := range buckets {
+		if err := c.fetchRemainingBucketTasks(ctx, projectID, viewID, b); err != nil {
+			return nil, err
+		}
+	}
+	return &ViewTasksResponse{Buckets: buckets}, nil
+}
+
+// fetchRemainingBucketTasks appends the bucket's tasks beyond the first page.
+// Count holds the bucket's total when the server reports it; otherwise paging stops at the first short page.
+func (c *Client) fetchRemainingBucketTasks(ctx context.Context, projectID, viewID int64, bucket *Bucket) error {
+	lastPage := len(bucket.Tasks)
+	for page := int64(2); bucketHasMoreTasks(bucket, lastPage); page++ {
+		var tasks []*Task
+		if err := submitJSON(ctx, c, viewTasksOperation(projectID, viewID, page, bucket.ID), &tasks); err != nil {
+			return fmt.Errorf("failed to get tasks of bucket %d (page %d): %w", bucket.ID, page, err)
+		}
+		bucket.Tasks = append(bucket.Tasks, tasks...)
+		lastPage = len(tasks)
+	}
+	return nil
+}
+
+func bucketHasMoreTasks(bucket *Bucket, lastPage int) bool {
+	if lastPage < viewTasksPageSize {
+		return false
+	}
+	return bucket.Count == 0 || int64(len(bucket.Tasks)) < bucket.Count
+}
+
+// viewTasksOperation builds a view task listing request; a non-zero bucketID limits it to that bucket.
+func viewTasksOperation(projectID, viewID, page, bucketID int64) rawOperation {
+	query := map[string]string{
+		"page":     strconv.FormatInt(page, 10),
+		"per_page": strconv.Itoa(viewTasksPageSize),
+	}
+	if bucketID != 0 {
+		query["filter"] = fmt.Sprintf("bucket_id = %d", bucketID)
+	}
+	return rawOperation{
+		id:     "GetProjectsIDViewsViewTasks",
+		method: http.MethodGet,
+		path:   "/projects/{id}/views/{view}/tasks",
+		pathParams: map[string]string{
+			"id":   strconv.FormatInt(projectID, 10),
+			"view": strconv.FormatInt(viewID, 10),
+		},
+		query: query,
+	}
+}
+
+// isBucketList reports whether a view task listing holds buckets rather than tasks.
+// Buckets always carry a tasks key, which tasks never do.
+func isBucketList(raw []json.RawMessage) bool {
+	if len(raw) == 0 {
+		return false
+	}
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(raw[0], &probe); err != nil {
+		return false
+	}
+	_, ok := probe["tasks"]
+	return ok
+}
+
+func decodeRawList(raw []json.RawMessage, out any) error {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
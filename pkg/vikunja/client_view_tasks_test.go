@@ -0,0 +1,84 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func taskRange(from, to int64) []map[string]any {
+	tasks := make([]map[string]any, 0, to-from+1)
+	for id := from; id <= to; id++ {
+		tasks = append(tasks, map[string]any{"id": id, "title": fmt.Sprintf("Task %d", id)})
+	}
+	return tasks
+}
+
+func TestClient_GetViewTasks_MergesBucketPages(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/projects/7/views/3/tasks" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		query := r.URL.Query()
+		assert.Equal(t, "50", query.Get("per_page"))
+		var body any
+		switch query.Get("page") {
+		case "1":
+			body = []map[string]any{
+				{"id": 10, "title": "Todo", "count": 60, "tasks": taskRange(1, 50)},
+				{"id": 11, "title": "Done", "count": 1, "tasks": taskRange(100, 100)},
+			}
+		case "2":
+			assert.Equal(t, "bucket_id = 10", query.Get("filter"))
+			body = taskRange(51, 60)
+		default:
+			t.Errorf("unexpected page %q", query.Get("page"))
+		}
+		json.NewEncoder(w).Encode(body) //nolint:errcheck
+	})
+
+	response, err := client.GetViewTasks(t.Context(), 7, 3)
+	require.NoError(t, err)
+
+	require.Len(t, response.Buckets, 2)
+	assert.Empty(t, response.Tasks)
+	todo := response.Buckets[0]
+	require.Len(t, todo.Tasks, 60)
+	assert.Equal(t, int64(1), todo.Tasks[0].ID)
+	assert.Equal(t, int64(60), todo.Tasks[59].ID)
+	assert.Len(t, response.Buckets[1].Tasks, 1)
+}
+
+func TestClient_GetViewTasks_FlatList(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		fmt.Fprint(w, `[{"id":1,"title":"First","project_id":7},{"id":2,"title":"Second","project_id":7}]`) //nolint:errcheck
+	})
+
+	response, err := client.GetViewTasks(t.Context(), 7, 1)
+	require.NoError(t, err)
+
+	assert.Empty(t, response.Buckets)
+	require.Len(t, response.Tasks, 2)
+	assert.Equal(t, "Second", response.Tasks[1].Title)
+}
+
+func TestClient_GetViewTasks_Error(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetViewTasks(t.Context(), 7, 3)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get view tasks")
+}
@@ -0,0 +1,41 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/vikunja-client-go/client/project"
+)
+
+// CreateProjectView creates a new view in the specified project.
+func (c *Client) CreateProjectView(ctx context.Context, projectID int64, view *ProjectView) (*ProjectView, error) {
+	params := project.NewPutProjectsProjectViewsParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetProject(projectID)
+	params.SetView(view)
+
+	result, err := c.projects.PutProjectsProjectViews(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project view: %w", err)
+	}
+
+	return result.Payload, nil
+}
+
+// UpdateProjectView replaces the stored settings of an existing view with view.
+func (c *Client) UpdateProjectView(ctx context.Context, projectID, viewID int64, view *ProjectView) (*ProjectView, error) {
+	params := project.NewPostProjectsProjectViewsIDParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetProject(projectID)
+	params.SetID(viewID)
+	params.SetView(view)
+
+	result, err := c.projects.PostProjectsProjectViewsID(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project view: %w", err)
+	}
+
+	return result.Payload, nil
+}
@@ -0,0 +1,57 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateProjectView(t *testing.T) {
+	t.Parallel()
+	var received ProjectView
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/projects/7/views", r.URL.Path)
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":12,"project_id":7,"title":"Board","view_kind":"kanban","bucket_configuration_mode":"manual"}`) //nolint:errcheck
+	})
+
+	view, err := client.CreateProjectView(t.Context(), 7, &ProjectView{
+		Title:                   "Board",
+		ViewKind:                ViewKindKanban,
+		BucketConfigurationMode: BucketConfigurationModeManual,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Board", received.Title)
+	assert.Equal(t, ViewKindKanban, received.ViewKind)
+	assert.Equal(t, int64(12), view.ID)
+	assert.Equal(t, BucketConfigurationModeManual, view.BucketConfigurationMode)
+}
+
+func TestClient_UpdateProjectView(t *testing.T) {
+	t.Parallel()
+	var received ProjectView
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/projects/7/views/12", r.URL.Path)
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":12,"project_id":7,"title":"Board","view_kind":"kanban","done_bucket_id":5}`) //nolint:errcheck
+	})
+
+	view, err := client.UpdateProjectView(t.Context(), 7, 12, &ProjectView{ID: 12, Title: "Board", ViewKind: ViewKindKanban, DoneBucketID: 5})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(5), received.DoneBucketID)
+	assert.Equal(t, int64(5), view.DoneBucketID)
+}
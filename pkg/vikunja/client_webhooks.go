@@ -0,0 +1,60 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meschbach/vikunja-client-go/client/webhooks"
+	"github.com/meschbach/vikunja-client-go/models"
+)
+
+// ListWebhooks retrieves all webhooks configured for the specified project.
+func (c *Client) ListWebhooks(ctx context.Context, projectID int64) ([]*Webhook, error) {
+	params := webhooks.NewGetProjectsIDWebhooksParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetID(projectID)
+
+	result, err := c.webhooks.GetProjectsIDWebhooks(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return result.Payload, nil
+}
+
+// CreateWebhook registers a webhook on the specified project which is notified for the given events.
+// The returned webhook carries the ID and the secret Vikunja uses to sign deliveries.
+func (c *Client) CreateWebhook(ctx context.Context, projectID int64, targetURL string, events []string) (*Webhook, error) {
+	params := webhooks.NewPutProjectsIDWebhooksParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetID(projectID)
+	params.SetWebhook(&models.ModelsWebhook{
+		ProjectID: projectID,
+		TargetURL: targetURL,
+		Events:    events,
+	})
+
+	result, err := c.webhooks.PutProjectsIDWebhooks(params, c.auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return result.Payload, nil
+}
+
+// DeleteWebhook removes a webhook from the specified project.
+func (c *Client) DeleteWebhook(ctx context.Context, projectID, webhookID int64) error {
+	params := webhooks.NewDeleteProjectsIDWebhooksWebhookIDParams()
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient())
+	params.SetID(projectID)
+	params.SetWebhookID(webhookID)
+
+	if _, err := c.webhooks.DeleteProjectsIDWebhooksWebhookID(params, c.auth); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
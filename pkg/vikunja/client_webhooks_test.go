@@ -0,0 +1,79 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateWebhook(t *testing.T) {
+	t.Parallel()
+	var received Webhook
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/projects/7/webhooks", r.URL.Path)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":3,"project_id":7,"target_url":"https://example.com/hook","events":["task.created"],"secret":"s3cr3t"}`) //nolint:errcheck
+	})
+
+	hook, err := client.CreateWebhook(t.Context(), 7, "https://example.com/hook", []string{WebhookEventTaskCreated})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://example.com/hook", received.TargetURL)
+	assert.Equal(t, []string{"task.created"}, received.Events)
+	assert.Equal(t, int64(3), hook.ID)
+	assert.Equal(t, "s3cr3t", hook.Secret)
+}
+
+func TestClient_ListWebhooks(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v1/projects/7/webhooks", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":1,"project_id":7,"target_url":"https://a.example.com","events":["task.created"]},{"id":2,"project_id":7,"target_url":"https://b.example.com","events":["project.updated"]}]`) //nolint:errcheck
+	})
+
+	hooks, err := client.ListWebhooks(t.Context(), 7)
+	require.NoError(t, err)
+	require.Len(t, hooks, 2)
+	assert.Equal(t, int64(1), hooks[0].ID)
+	assert.Equal(t, "https://b.example.com", hooks[1].TargetURL)
+}
+
+func TestClient_DeleteWebhook(t *testing.T) {
+	t.Parallel()
+	deleted := false
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/v1/projects/7/webhooks/3", r.URL.Path)
+		deleted = true
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"message":"Successfully deleted."}`) //nolint:errcheck
+	})
+
+	err := client.DeleteWebhook(t.Context(), 7, 3)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestClient_DeleteWebhook_NotFound(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"not found"}`) //nolint:errcheck
+	})
+
+	err := client.DeleteWebhook(t.Context(), 7, 99)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to delete webhook")
+}
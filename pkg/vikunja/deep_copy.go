@@ -0,0 +1,75 @@
+package vikunja
+
+import "reflect"
+
+// deepCopy returns a copy of v that shares no pointers, slices or maps with it, so changing one
+// never shows in the other. Unexported struct fields are copied as they are.
+func deepCopy(v any) any {
+	if v == nil {
+		return nil
+	}
+	return copyValue(reflect.ValueOf(v)).Interface()
+}
+
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return copyReference(v)
+	case reflect.Struct:
+		return copyStruct(v)
+	case reflect.Slice:
+		return copySlice(v)
+	case reflect.Map:
+		return copyMap(v)
+	default:
+		return v
+	}
+}
+
+// copyReference copies the value a pointer or interface refers to
+func copyReference(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return v
+	}
+	if v.Kind() == reflect.Interface {
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(copyValue(v.Elem()))
+		return copied
+	}
+	copied := reflect.New(v.Type().Elem())
+	copied.Elem().Set(copyValue(v.Elem()))
+	return copied
+}
+
+func copyStruct(v reflect.Value) reflect.Value {
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	for i := range v.NumField() {
+		if field := copied.Field(i); field.CanSet() {
+			field.Set(copyValue(v.Field(i)))
+		}
+	}
+	return copied
+}
+
+func copySlice(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return v
+	}
+	copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	for i := range v.Len() {
+		copied.Index(i).Set(copyValue(v.Index(i)))
+	}
+	return copied
+}
+
+func copyMap(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return v
+	}
+	copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		copied.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+	}
+	return copied
+}
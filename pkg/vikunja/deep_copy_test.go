@@ -0,0 +1,36 @@
+package vikunja
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeepCopy(t *testing.T) {
+	t.Parallel()
+	original := &Task{
+		ID:     5,
+		Title:  "Ship it",
+		Labels: []*Label{{ID: 1, Title: "urgent"}},
+	}
+	original.RelatedTasks.ModelsRelatedTaskMap = map[string][]Task{"subtask": {{ID: 6, Title: "Test"}}}
+
+	copied, ok := deepCopy(original).(*Task)
+	require.True(t, ok)
+	assert.Equal(t, original, copied)
+	assert.NotSame(t, original, copied)
+
+	copied.Title = "Changed"
+	copied.Labels[0].Title = "changed"
+	copied.RelatedTasks.ModelsRelatedTaskMap["subtask"][0].Title = "changed"
+	assert.Equal(t, "Ship it", original.Title)
+	assert.Equal(t, "urgent", original.Labels[0].Title)
+	assert.Equal(t, "Test", original.RelatedTasks.ModelsRelatedTaskMap["subtask"][0].Title)
+}
+
+func TestDeepCopy_Nil(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, deepCopy(nil))
+	assert.Nil(t, deepCopy((*Task)(nil)))
+}
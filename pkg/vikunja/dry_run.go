@@ -0,0 +1,178 @@
+package vikunja
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// PlannedRequest describes a write the client would have sent had dry-run mode been off.
+type PlannedRequest struct {
+	Method   string `json:"method"`
+	Endpoint string `json:"endpoint"`
+	Body     any    `json:"body,omitempty"`
+}
+
+// WithDryRun returns a copy of the client that performs reads normally but records writes
+// instead of sending them. Each recorded write is answered by echoing its own body back,
+// so multi-step operations run to completion. Use PlannedRequests to collect the writes.
+func (c *Client) WithDryRun() *Client {
+	dry := *c
+	dry.dryRun = &dryRunTransport{next: c.transport}
+	dry.setTransport(dry.dryRun)
+	return &dry
+}
+
+// IsDryRun reports whether the client records writes instead of sending them.
+func (c *Client) IsDryRun() bool {
+	return c.dryRun != nil
+}
+
+// PlannedRequests returns the writes recorded so far by a dry-run client, in submission order.
+func (c *Client) PlannedRequests() []PlannedRequest {
+	if c.dryRun == nil {
+		return nil
+	}
+	return c.dryRun.recorded()
+}
+
+// dryRunTransport passes reads through and records every other operation.
+type dryRunTransport struct {
+	next    runtime.ClientTransport
+	mu      sync.Mutex
+	planned []PlannedRequest
+}
+
+func (t *dryRunTransport) Submit(op *runtime.ClientOperation) (any, error) {
+	if op.Method == http.MethodGet {
+		return t.next.Submit(op)
+	}
+
+	req := &plannedClientRequest{method: op.Method, pathParams: map[string]string{}, query: url.Values{}}
+	if err := op.Params.WriteToRequest(req, strfmt.Default); err != nil {
+		return nil, fmt.Errorf("failed to plan %s %s: %w", op.Method, op.PathPattern, err)
+	}
+	body, err := json.Marshal(req.body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan %s %s: %w", op.Method, op.PathPattern, err)
+	}
+
+	t.mu.Lock()
+	t.planned = append(t.planned, PlannedRequest{
+		Method:   op.Method,
+		Endpoint: req.endpoint(op.PathPattern),
+		Body:     req.body,
+	})
+	t.mu.Unlock()
+
+	return echoResponse(op, body)
+}
+
+func (t *dryRunTransport) recorded() []PlannedRequest {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]PlannedRequest(nil), t.planned...)
+}
+
+// echoResponse feeds body to the operation's reader as if the server had accepted it unchanged.
+// Vikunja answers writes with either 200 or 201, so both are tried.
+func echoResponse(op *runtime.ClientOperation, body []byte) (any, error) {
+	if string(body) == "null" {
+		body = nil
+	}
+	var result any
+	var err error
+	for _, code := range []int{http.StatusOK, http.StatusCreated} {
+		result, err = op.Reader.ReadResponse(&echoedResponse{code: code, body: body}, runtime.JSONConsumer())
+		var apiErr *runtime.APIError
+		if !errors.As(err, &apiErr) {
+			return result, err
+		}
+	}
+	return result, err
+}
+
+// plannedClientRequest captures what an operation writes into its request.
+type plannedClientRequest struct {
+	method     string
+	pathParams map[string]string
+	query      url.Values
+	headers    http.Header
+	body       any
+}
+
+func (r *plannedClientRequest) endpoint(pattern string) string {
+	path := pattern
+	for k, v := range r.pathParams {
+		path = strings.ReplaceAll(path, "{"+k+"}", url.PathEscape(v))
+	}
+	if len(r.query) > 0 {
+		path += "?" + r.query.Encode()
+	}
+	return apiBasePath + path
+}
+
+func (r *plannedClientRequest) SetHeaderParam(name string, values ...string) error {
+	if r.headers == nil {
+		r.headers = http.Header{}
+	}
+	r.headers[http.CanonicalHeaderKey(name)] = values
+	return nil
+}
+
+func (r *plannedClientRequest) GetHeaderParams() http.Header { return r.headers }
+
+func (r *plannedClientRequest) SetQueryParam(name string, values ...string) error {
+	r.query[name] = values
+	return nil
+}
+
+func (r *plannedClientRequest) SetFormParam(string, ...string) error { return nil }
+
+func (r *plannedClientRequest) SetPathParam(name, value string) error {
+	r.pathParams[name] = value
+	return nil
+}
+
+func (r *plannedClientRequest) GetQueryParams() url.Values { return r.query }
+
+func (r *plannedClientRequest) SetFileParam(string, ...runtime.NamedReadCloser) error { return nil }
+
+func (r *plannedClientRequest) SetBodyParam(body any) error {
+	r.body = body
+	return nil
+}
+
+func (r *plannedClientRequest) SetTimeout(time.Duration) error { return nil }
+
+func (r *plannedClientRequest) GetMethod() string { return r.method }
+
+func (r *plannedClientRequest) GetPath() string { return "" }
+
+func (r *plannedClientRequest) GetBody() []byte { return nil }
+
+func (r *plannedClientRequest) GetBodyParam() any { return r.body }
+
+func (r *plannedClientRequest) GetFileParam() map[string][]runtime.NamedReadCloser { return nil }
+
+// echoedResponse is a synthetic response carrying a planned request's own body.
+type echoedResponse struct {
+	code int
+	body []byte
+}
+
+func (r *echoedResponse) Code() int                  { return r.code }
+func (r *echoedResponse) Message() string            { return http.StatusText(r.code) }
+func (r *echoedResponse) GetHeader(string) string    { return "" }
+func (r *echoedResponse) GetHeaders(string) []string { return nil }
+func (r *echoedResponse) Body() io.ReadCloser        { return io.NopCloser(bytes.NewReader(r.body)) }
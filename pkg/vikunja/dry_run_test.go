@@ -0,0 +1,63 @@
+package vikunja
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithDryRun_RecordsWrites(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("dry-run write reached the server: %s %s", r.Method, r.URL.Path)
+	})
+	dry := client.WithDryRun()
+
+	taskBucket, err := dry.MoveTaskToBucket(t.Context(), 7, 3, 10, 42)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), taskBucket.TaskID, "the planned body is echoed back as the response")
+
+	planned := dry.PlannedRequests()
+	require.Len(t, planned, 1)
+	assert.Equal(t, http.MethodPost, planned[0].Method)
+	assert.Equal(t, "/api/v1/projects/7/views/3/buckets/10/tasks", planned[0].Endpoint)
+	assert.Equal(t, &TaskBucket{TaskID: 42}, planned[0].Body)
+
+	assert.True(t, dry.IsDryRun())
+	assert.False(t, client.IsDryRun())
+	assert.Nil(t, client.PlannedRequests())
+}
+
+func TestClient_WithDryRun_PassesReadsThrough(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":5,"title":"Read me"}`) //nolint:errcheck
+	})
+
+	dry := client.WithDryRun()
+	task, err := dry.GetTask(t.Context(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, "Read me", task.Title)
+	assert.Empty(t, dry.PlannedRequests())
+}
+
+func TestClient_WithDryRun_Delete(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("dry-run write reached the server: %s %s", r.Method, r.URL.Path)
+	})
+	dry := client.WithDryRun()
+
+	require.NoError(t, dry.DeleteWebhook(t.Context(), 7, 3))
+
+	planned := dry.PlannedRequests()
+	require.Len(t, planned, 1)
+	assert.Equal(t, http.MethodDelete, planned[0].Method)
+	assert.Equal(t, "/api/v1/projects/7/webhooks/3", planned[0].Endpoint)
+	assert.Nil(t, planned[0].Body)
+}
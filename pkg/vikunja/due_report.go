@@ -0,0 +1,59 @@
+package vikunja
+
+import (
+	"sort"
+	"time"
+)
+
+// DueReport holds incomplete tasks grouped by when they are due, relative to a point in time.
+// Within a group tasks are ordered soonest due first.
+type DueReport struct {
+	Overdue []*Task `json:"overdue"`
+	// Today holds the tasks due later on the current day
+	Today []*Task `json:"today"`
+	// ThisWeek holds the tasks due after today and before the week ends on Sunday night
+	ThisWeek  []*Task `json:"this_week"`
+	Later     []*Task `json:"later"`
+	NoDueDate []*Task `json:"no_due_date"`
+}
+
+// NewDueReport groups the incomplete tasks by their due date relative to now, judging days and
+// weeks in now's time zone. Completed tasks are left out.
+func NewDueReport(tasks []*Task, now time.Time) DueReport {
+	report := DueReport{}
+	tomorrow := startOfDay(now).AddDate(0, 0, 1)
+	// Weeks run Monday to Sunday; on a Sunday the week ends with tomorrow
+	nextWeek := startOfDay(now).AddDate(0, 0, 7-(int(now.Weekday())+6)%7)
+
+	for _, task := range tasks {
+		if task.Done {
+			continue
+		}
+		due := parseDate(task.DueDate)
+		switch {
+		case due.IsZero():
+			report.NoDueDate = append(report.NoDueDate, task)
+		case due.Before(now):
+			report.Overdue = append(report.Overdue, task)
+		case due.Before(tomorrow):
+			report.Today = append(report.Today, task)
+		case due.Before(nextWeek):
+			report.ThisWeek = append(report.ThisWeek, task)
+		default:
+			report.Later = append(report.Later, task)
+		}
+	}
+
+	for _, group := range [][]*Task{report.Overdue, report.Today, report.ThisWeek, report.Later} {
+		sort.SliceStable(group, func(i, j int) bool {
+			return parseDate(group[i].DueDate).Before(parseDate(group[j].DueDate))
+		})
+	}
+	return report
+}
+
+// startOfDay returns midnight at the start of t's day in t's time zone
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,82 @@
+package vikunja
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+)
+
+// Sentinel errors for requests Vikunja answered with a failure status. Every such error matches
+// ErrAPI with errors.Is; the common statuses also match a more specific sentinel. errors.As
+// with a *StatusError yields the status code itself.
+var (
+	ErrAPI          = errors.New("vikunja API error")
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+)
+
+// StatusError is the error for a request Vikunja answered with a failure status. It keeps the
+// generated client's error, and its message, as the wrapped error.
+type StatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches ErrAPI for every status and the specific sentinels for their status.
+func (e *StatusError) Is(target error) bool {
+	switch target {
+	case ErrAPI:
+		return true
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	default:
+		return false
+	}
+}
+
+// statusCoder is implemented by the generated clients' responses, failures included.
+type statusCoder interface {
+	Code() int
+}
+
+// asStatusError attaches the status code of a failed response to err, leaving errors which do
+// not stem from a response, such as transport failures, unchanged.
+func asStatusError(err error) error {
+	var existing *StatusError
+	if err == nil || errors.As(err, &existing) {
+		return err
+	}
+	var apiErr *runtime.APIError
+	if errors.As(err, &apiErr) {
+		return &StatusError{StatusCode: apiErr.Code, Err: err}
+	}
+	var coder statusCoder
+	if errors.As(err, &coder) && coder.Code() >= http.StatusBadRequest {
+		return &StatusError{StatusCode: coder.Code(), Err: err}
+	}
+	return err
+}
+
+// statusErrorTransport turns the errors for failed responses into *StatusError.
+type statusErrorTransport struct {
+	next runtime.ClientTransport
+}
+
+func (t *statusErrorTransport) Submit(op *runtime.ClientOperation) (any, error) {
+	result, err := t.next.Submit(op)
+	return result, asStatusError(err)
+}
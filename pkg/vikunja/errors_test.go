@@ -0,0 +1,63 @@
+package vikunja
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_StatusErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{"not found", http.StatusNotFound, ErrNotFound},
+		{"unauthorized", http.StatusUnauthorized, ErrUnauthorized},
+		{"forbidden", http.StatusForbidden, ErrForbidden},
+		{"server error", http.StatusInternalServerError, ErrAPI},
+		{"undocumented status", http.StatusTeapot, ErrAPI},
+	}
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrForbidden}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"message":"nope"}`) //nolint:errcheck
+			})
+
+			// GetTask goes through a generated operation, CreateLinkShare through a raw one
+			_, getErr := client.GetTask(t.Context(), 7)
+			_, shareErr := client.CreateLinkShare(t.Context(), 7, ShareRightRead)
+			for _, err := range []error{getErr, shareErr} {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrAPI)
+				assert.ErrorIs(t, err, tt.want)
+				for _, other := range sentinels {
+					if other != tt.want {
+						assert.NotErrorIs(t, err, other)
+					}
+				}
+
+				var statusErr *StatusError
+				require.ErrorAs(t, err, &statusErr)
+				assert.Equal(t, tt.status, statusErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestAsStatusError_LeavesOtherErrors(t *testing.T) {
+	t.Parallel()
+	err := errors.New("connection refused")
+	assert.Equal(t, err, asStatusError(err))
+	assert.NoError(t, asStatusError(nil))
+}
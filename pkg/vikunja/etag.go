@@ -0,0 +1,103 @@
+package vikunja
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// maxETagEntries bounds the number of responses kept for conditional requests.
+const maxETagEntries = 256
+
+// etagEntry is a decoded response together with the entity tag the server sent for it.
+type etagEntry struct {
+	etag  string
+	value any
+}
+
+// etagTransport makes GET requests conditional. Responses carrying an ETag are remembered by
+// endpoint; later requests for the same endpoint send If-None-Match, and a 304 Not Modified
+// answer returns the remembered value instead.
+//
+// The cache keeps a deep copy of the decoded value and hands out deep copies of it, so every caller
+// gets a value of its own and may change it without the change showing in later results.
+type etagTransport struct {
+	next    runtime.ClientTransport
+	mu      sync.Mutex
+	entries map[string]etagEntry
+}
+
+func newETagTransport(next runtime.ClientTransport) *etagTransport {
+	return &etagTransport{next: next, entries: make(map[string]etagEntry)}
+}
+
+func (t *etagTransport) Submit(op *runtime.ClientOperation) (any, error) {
+	if op.Method != http.MethodGet {
+		return t.next.Submit(op)
+	}
+
+	endpoint, err := operationEndpoint(op)
+	if err != nil {
+		return t.next.Submit(op)
+	}
+	// Operations sharing an endpoint may decode into different types, so both form the key; an
+	// operation must always decode its endpoint into the same type
+	key := op.ID + " " + endpoint
+	cached, hasCached := t.lookup(key)
+
+	conditional := *op
+	conditional.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		if err := op.Params.WriteToRequest(r, reg); err != nil {
+			return err
+		}
+		if hasCached {
+			return r.SetHeaderParam("If-None-Match", cached.etag)
+		}
+		return nil
+	})
+	conditional.Reader = runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+		if hasCached && resp.Code() == http.StatusNotModified {
+			return deepCopy(cached.value), nil
+		}
+		value, err := op.Reader.ReadResponse(resp, consumer)
+		if err == nil {
+			if etag := resp.GetHeader("ETag"); etag != "" {
+				t.store(key, etagEntry{etag: etag, value: deepCopy(value)})
+			}
+		}
+		return value, err
+	})
+
+	return t.next.Submit(&conditional)
+}
+
+func (t *etagTransport) lookup(key string) (etagEntry, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entry, ok := t.entries[key]
+	return entry, ok
+}
+
+func (t *etagTransport) store(key string, entry etagEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.entries[key]; !ok && len(t.entries) >= maxETagEntries {
+		for k := range t.entries {
+			delete(t.entries, k)
+			break
+		}
+	}
+	t.entries[key] = entry
+}
+
+// operationEndpoint returns the path and query an operation would request.
+func operationEndpoint(op *runtime.ClientOperation) (string, error) {
+	req := &plannedClientRequest{method: op.Method, pathParams: map[string]string{}, query: url.Values{}}
+	if err := op.Params.WriteToRequest(req, strfmt.Default); err != nil {
+		return "", err
+	}
+	return req.endpoint(op.PathPattern), nil
+}
@@ -0,0 +1,104 @@
+package vikunja
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ETag_NotModifiedReturnsCachedValue(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/api/v1/tasks/5", r.URL.Path)
+		if requests == 1 {
+			assert.Empty(t, r.Header.Get("If-None-Match"))
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("ETag", `"v1"`)
+			fmt.Fprint(w, `{"id":5,"title":"Ship it"}`) //nolint:errcheck
+			return
+		}
+		assert.Equal(t, `"v1"`, r.Header.Get("If-None-Match"))
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	first, err := client.GetTask(t.Context(), 5)
+	require.NoError(t, err)
+	second, err := client.GetTask(t.Context(), 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, requests)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, "Ship it", second.Title)
+}
+
+func TestClient_ETag_ChangedResultDoesNotLeakIntoCache(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"id":5,"title":"Ship it","labels":[{"id":1,"title":"urgent"}]}`) //nolint:errcheck
+	})
+
+	first, err := client.GetTask(t.Context(), 5)
+	require.NoError(t, err)
+	first.Title = "Unsaved edit"
+	first.Done = true
+	first.Labels[0].Title = "changed"
+
+	for range 2 {
+		again, err := client.GetTask(t.Context(), 5)
+		require.NoError(t, err)
+		assert.Equal(t, "Ship it", again.Title)
+		assert.False(t, again.Done)
+		require.Len(t, again.Labels, 1)
+		assert.Equal(t, "urgent", again.Labels[0].Title)
+		again.Title = "Another unsaved edit"
+	}
+}
+
+func TestClient_ETag_RawOperation(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id":1,"title":"First"}]`) //nolint:errcheck
+	})
+
+	_, err := client.GetViewTasks(t.Context(), 7, 1)
+	require.NoError(t, err)
+	response, err := client.GetViewTasks(t.Context(), 7, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, requests)
+	require.Len(t, response.Tasks, 1)
+	assert.Equal(t, "First", response.Tasks[0].Title)
+}
+
+func TestClient_ETag_WithoutHeaderIsNotCached(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":5,"title":"Ship it"}`) //nolint:errcheck
+	})
+
+	for range 2 {
+		_, err := client.GetTask(t.Context(), 5)
+		require.NoError(t, err)
+	}
+}
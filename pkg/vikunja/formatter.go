@@ -3,12 +3,23 @@ package vikunja
 import (
 	"bytes"
 	"io"
+	"os"
+	"time"
+
+	"github.com/mattn/go-isatty"
 )
 
 // Formatter handles output formatting for CLI
 type Formatter struct {
-	useColor bool
-	output   io.Writer
+	useColor        bool
+	output          io.Writer
+	descriptionMode DescriptionMode
+	// descriptionPreview trims descriptions in listings to this many characters; zero keeps them whole
+	descriptionPreview int
+	// humanizeTimes renders markdown timestamps relative to the clock
+	humanizeTimes bool
+	// clock returns the current time; nil means time.Now
+	clock func() time.Time
 }
 
 // NewFormatter creates a new formatter
@@ -31,3 +42,16 @@ func (f *Formatter) CaptureOutput(fn func() error) (string, error) {
 	}
 	return buf.String(), nil
 }
+
+// ShouldUseColor reports whether output written to w should be colorized: w must be a terminal
+// and the NO_COLOR environment variable (https://no-color.org) must be unset or empty.
+func ShouldUseColor(w io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
@@ -0,0 +1,37 @@
+package vikunja
+
+// fallbackOutput is what FallbackFormatter returns when the primary formatter fails: the data as
+// JSON, annotated with why it is not in the configured format.
+type fallbackOutput struct {
+	FormatError string      `json:"format_error"`
+	Result      interface{} `json:"result"`
+}
+
+// FallbackFormatter formats data with a primary formatter, retrying with JSON when it fails so a
+// formatting bug never costs the caller the data itself.
+type FallbackFormatter struct {
+	primary  OutputFormatter
+	fallback *JSONFormatter
+}
+
+// WithJSONFallback makes formatter fall back to annotated JSON when it fails. A JSON formatter has
+// nothing to fall back to and is returned unchanged.
+func WithJSONFallback(formatter OutputFormatter) OutputFormatter {
+	if _, ok := formatter.(*JSONFormatter); ok {
+		return formatter
+	}
+	return &FallbackFormatter{primary: formatter, fallback: NewJSONFormatter()}
+}
+
+// Format formats data with the primary formatter, or as JSON wrapped with the primary's error
+func (f *FallbackFormatter) Format(data interface{}) (string, error) {
+	output, err := f.primary.Format(data)
+	if err == nil {
+		return output, nil
+	}
+	output, fallbackErr := f.fallback.Format(fallbackOutput{FormatError: err.Error(), Result: data})
+	if fallbackErr != nil {
+		return "", err
+	}
+	return output, nil
+}
@@ -0,0 +1,48 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingFormatter fails to format anything
+type failingFormatter struct{}
+
+func (failingFormatter) Format(interface{}) (string, error) {
+	return "", errors.New("template exploded")
+}
+
+func TestWithJSONFallback_AnnotatesJSONWhenPrimaryFails(t *testing.T) {
+	t.Parallel()
+	out, err := WithJSONFallback(failingFormatter{}).Format(&Task{ID: 42, Title: "Write docs"})
+	require.NoError(t, err)
+
+	var fallback struct {
+		FormatError string `json:"format_error"`
+		Result      Task   `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &fallback))
+	assert.Equal(t, "template exploded", fallback.FormatError)
+	assert.Equal(t, int64(42), fallback.Result.ID)
+	assert.Equal(t, "Write docs", fallback.Result.Title)
+}
+
+func TestWithJSONFallback_KeepsPrimaryOutput(t *testing.T) {
+	t.Parallel()
+	out, err := WithJSONFallback(NewMarkdownFormatter()).Format(&Task{ID: 42, Title: "Write docs"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "# Write docs")
+
+	jsonFormatter := NewJSONFormatter()
+	assert.Same(t, jsonFormatter, WithJSONFallback(jsonFormatter))
+}
+
+func TestWithJSONFallback_ReportsPrimaryErrorWhenJSONFails(t *testing.T) {
+	t.Parallel()
+	_, err := WithJSONFallback(failingFormatter{}).Format(func() {})
+	assert.EqualError(t, err, "template exploded")
+}
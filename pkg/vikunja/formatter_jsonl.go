@@ -0,0 +1,39 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// JSONLFormatter formats list-shaped data as JSON Lines: one compact JSON value per element and
+// line, so output can be streamed into line-oriented tools. Anything else becomes one line of JSON.
+type JSONLFormatter struct{}
+
+// NewJSONLFormatter creates a new JSON Lines formatter
+func NewJSONLFormatter() *JSONLFormatter {
+	return &JSONLFormatter{}
+}
+
+// Format formats data as JSON Lines
+func (f *JSONLFormatter) Format(data interface{}) (string, error) {
+	v := reflect.ValueOf(data)
+	if !v.IsValid() || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) {
+		line, err := json.Marshal(data)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		return string(line), nil
+	}
+
+	lines := make([]string, v.Len())
+	for i := range lines {
+		line, err := json.Marshal(v.Index(i).Interface())
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal JSON line %d: %w", i+1, err)
+		}
+		lines[i] = string(line)
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,54 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLFormatter_List(t *testing.T) {
+	t.Parallel()
+	tasks := []*Task{
+		{ID: 1, Title: "Write report", Description: "Line one\nline two"},
+		{ID: 2, Title: "Review"},
+		{ID: 3, Title: "Ship"},
+	}
+
+	output, err := GetFormatter(OutputFormatJSONL).Format(tasks)
+	require.NoError(t, err)
+
+	lines := strings.Split(output, "\n")
+	require.Len(t, lines, len(tasks))
+	for i, line := range lines {
+		var task Task
+		require.NoError(t, json.Unmarshal([]byte(line), &task), "line %d must be a JSON value of its own", i+1)
+		assert.Equal(t, tasks[i].ID, task.ID)
+		assert.Equal(t, tasks[i].Title, task.Title)
+	}
+}
+
+func TestJSONLFormatter_NonList(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		data any
+		want string
+	}{
+		{"single value", &Project{ID: 4, Title: "Home"}, `"title":"Home"`},
+		{"empty list", []*Task{}, ""},
+		{"nil", nil, "null"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			output, err := NewJSONLFormatter().Format(tt.data)
+			require.NoError(t, err)
+			assert.NotContains(t, output, "\n")
+			assert.Contains(t, output, tt.want)
+		})
+	}
+}
@@ -45,13 +45,13 @@ func (f *Formatter) FormatTasksAsMarkdown(tasks []*Task) string {
 		dueDate := "-"
 		if task.DueDate != "" {
 			if t := parseDate(task.DueDate); !t.IsZero() {
-				dueDate = t.Format("2006-01-02")
+				dueDate = f.formatTime(t, "2006-01-02") + f.overdueSuffix(task)
 			}
 		}
 
 		project := "-"
 		if task.ProjectID > 0 {
-			project = fmt.Sprintf("[%d](vikunja://projects/%d)", task.ProjectID, task.ProjectID)
+			project = fmt.Sprintf("[%d](%s)", task.ProjectID, ProjectURI(task.ProjectID))
 		}
 
 		title := strings.ReplaceAll(task.Title, "|", "\\|")
@@ -61,7 +61,7 @@ func (f *Formatter) FormatTasksAsMarkdown(tasks []*Task) string {
 
 	buf.WriteString("\n<details>\n<summary>Task Details</summary>\n\n")
 	for _, task := range tasks {
-		buf.WriteString(f.formatTaskDetailsMarkdown(task))
+		buf.WriteString(f.formatTaskDetailsMarkdown(task, true))
 	}
 	buf.WriteString("</details>\n")
 
@@ -73,12 +73,12 @@ func (f *Formatter) FormatTaskAsMarkdown(task *Task) string {
 	var buf strings.Builder
 
 	fmt.Fprintf(&buf, "# %s\n\n", task.Title)
-	buf.WriteString(f.formatTaskDetailsMarkdown(task))
+	buf.WriteString(f.formatTaskDetailsMarkdown(task, false))
 
 	return buf.String()
 }
 
-func formatDateField(dateStr, layout, label string, buf *strings.Builder) {
+func (f *Formatter) formatDateField(dateStr, layout, label string, buf *strings.Builder) {
 	if dateStr == "" {
 		return
 	}
@@ -86,24 +86,25 @@ func formatDateField(dateStr, layout, label string, buf *strings.Builder) {
 	if t.IsZero() {
 		return
 	}
-	fmt.Fprintf(buf, "- **%s**: %s\n", label, t.Format(layout))
+	fmt.Fprintf(buf, "- **%s**: %s\n", label, f.formatTime(t, layout))
 }
 
-// formatTaskDetailsMarkdown formats detailed task information
-func (f *Formatter) formatTaskDetailsMarkdown(task *Task) string {
+// formatTaskDetailsMarkdown formats detailed task information, with only a preview of the
+// description when the task is part of a listing
+func (f *Formatter) formatTaskDetailsMarkdown(task *Task, listed bool) string {
 	var buf strings.Builder
 
 	fmt.Fprintf(&buf, "### %s\n\n", task.Title)
 	fmt.Fprintf(&buf, "- **ID**: %d\n", task.ID)
-	fmt.Fprintf(&buf, "- **URI**: [vikunja://tasks/%d](vikunja://tasks/%d)\n", task.ID, task.ID)
+	fmt.Fprintf(&buf, "- **URI**: [%[1]s](%[1]s)\n", TaskURI(task.ID))
 
 	if task.ProjectID > 0 {
-		fmt.Fprintf(&buf, "- **Project**: [%d](vikunja://projects/%d)\n", task.ProjectID, task.ProjectID)
+		fmt.Fprintf(&buf, "- **Project**: [%d](%s)\n", task.ProjectID, ProjectURI(task.ProjectID))
 	}
 
-	formatDateField(task.Created, time.RFC3339, "Created", &buf)
-	formatDateField(task.Updated, time.RFC3339, "Updated", &buf)
-	formatDateField(task.DueDate, "2006-01-02", "Due Date", &buf)
+	f.formatDateField(task.Created, time.RFC3339, "Created", &buf)
+	f.formatDateField(task.Updated, time.RFC3339, "Updated", &buf)
+	f.formatDueDate(task, &buf)
 
 	if task.Done {
 		buf.WriteString("- **Status**: ✅ Completed\n")
@@ -111,15 +112,18 @@ func (f *Formatter) formatTaskDetailsMarkdown(task *Task) string {
 		buf.WriteString("- **Status**: ❌ Pending\n")
 	}
 
-	if task.Description != "" {
-		fmt.Fprintf(&buf, "\n**Description**:\n%s\n", task.Description)
+	if description := task.Description; description != "" {
+		if listed {
+			description = f.previewDescription(description)
+		}
+		f.writeDescription(&buf, description)
 	}
 
 	buf.WriteString("\n---\n\n")
 	return buf.String()
 }
 
-func formatProjectField(project *Project, buf *strings.Builder) {
+func (f *Formatter) formatProjectField(project *Project, buf *strings.Builder) {
 	if project.Identifier != nil && strings.TrimSpace(*project.Identifier) != "" {
 		fmt.Fprintf(buf, "- **Identifier**: `%s`\n", *project.Identifier)
 	}
@@ -127,12 +131,12 @@ func formatProjectField(project *Project, buf *strings.Builder) {
 	if project.Created != "" {
 		t := parseDate(project.Created)
 		if !t.IsZero() && t.Year() > 1970 {
-			fmt.Fprintf(buf, "- **Created**: %s\n", t.Format("2006-01-02"))
+			fmt.Fprintf(buf, "- **Created**: %s\n", f.formatTime(t, "2006-01-02"))
 		}
 	}
 
 	if project.Description != "" && strings.TrimSpace(project.Description) != "" {
-		fmt.Fprintf(buf, "\n**Description**:\n%s\n", project.Description)
+		f.writeDescription(buf, f.previewDescription(project.Description))
 	}
 }
 
@@ -146,11 +150,11 @@ func (f *Formatter) FormatProjectsAsMarkdown(projects []*Project) string {
 	fmt.Fprintf(&buf, "# Projects (%d)\n\n", len(projects))
 
 	for _, project := range projects {
-		fmt.Fprintf(&buf, "## 📁 %s\n\n", project.Title)
+		fmt.Fprintf(&buf, "## 📁 %s\n\n", projectHeading(project))
 		fmt.Fprintf(&buf, "- **ID**: %d\n", project.ID)
-		fmt.Fprintf(&buf, "- **URI**: [vikunja://projects/%d](vikunja://projects/%d)\n", project.ID, project.ID)
+		fmt.Fprintf(&buf, "- **URI**: [%[1]s](%[1]s)\n", ProjectURI(project.ID))
 
-		formatProjectField(project, &buf)
+		f.formatProjectField(project, &buf)
 
 		buf.WriteString("\n---\n\n")
 	}
@@ -162,28 +166,21 @@ func (f *Formatter) FormatProjectsAsMarkdown(projects []*Project) string {
 func (f *Formatter) FormatProjectAsMarkdown(project *Project) string {
 	var buf strings.Builder
 
-	fmt.Fprintf(&buf, "# %s\n\n", project.Title)
+	fmt.Fprintf(&buf, "# %s\n\n", projectHeading(project))
 	fmt.Fprintf(&buf, "- **ID**: %d\n", project.ID)
-	fmt.Fprintf(&buf, "- **URI**: [vikunja://projects/%d](vikunja://projects/%d)\n", project.ID, project.ID)
+	fmt.Fprintf(&buf, "- **URI**: [%[1]s](%[1]s)\n", ProjectURI(project.ID))
 
 	if project.Identifier != nil && *project.Identifier != "" {
 		fmt.Fprintf(&buf, "- **Identifier**: `%s`\n", *project.Identifier)
 	}
+	formatHexColor(project.HexColor, &buf)
 
-	if project.Created != "" {
-		if t := parseDate(project.Created); !t.IsZero() {
-			fmt.Fprintf(&buf, "- **Created**: %s\n", t.Format(time.RFC3339))
-		}
-	}
+	f.formatDateField(project.Created, time.RFC3339, "Created", &buf)
 
-	if project.Updated != "" {
-		if t := parseDate(project.Updated); !t.IsZero() {
-			fmt.Fprintf(&buf, "- **Updated**: %s\n", t.Format(time.RFC3339))
-		}
-	}
+	f.formatDateField(project.Updated, time.RFC3339, "Updated", &buf)
 
 	if project.Description != "" {
-		fmt.Fprintf(&buf, "\n**Description**:\n%s\n", project.Description)
+		f.writeDescription(&buf, project.Description)
 	}
 
 	return buf.String()
@@ -224,9 +221,13 @@ func (f *Formatter) FormatViewAsMarkdown(view *ProjectView) string {
 	fmt.Fprintf(&buf, "- **ID**: %d\n", view.ID)
 	fmt.Fprintf(&buf, "- **Project ID**: %d\n", view.ProjectID)
 	fmt.Fprintf(&buf, "- **Type**: %s\n", view.ViewKind)
-	fmt.Fprintf(&buf, "- **URI**: [vikunja://views/%d](vikunja://views/%d)\n", view.ID, view.ID)
+	fmt.Fprintf(&buf, "- **URI**: [%[1]s](%[1]s)\n", ViewURI(view.ProjectID, view.ID))
 	fmt.Fprintf(&buf, "- **Position**: %.2f\n", view.Position)
 
+	if view.BucketConfigurationMode != "" {
+		fmt.Fprintf(&buf, "- **Bucket Configuration**: %s\n", view.BucketConfigurationMode)
+	}
+
 	if view.DefaultBucketID > 0 {
 		fmt.Fprintf(&buf, "- **Default Bucket**: %d\n", view.DefaultBucketID)
 	}
@@ -238,35 +239,6 @@ func (f *Formatter) FormatViewAsMarkdown(view *ProjectView) string {
 	return buf.String()
 }
 
-// FormatViewTasksAsMarkdown formats a view with buckets and tasks as markdown
-func (f *Formatter) FormatViewTasksAsMarkdown(vt *ViewTasks) string {
-	var buf strings.Builder
-
-	fmt.Fprintf(&buf, "# %s (ID: %d)\n\n", vt.ViewTitle, vt.ViewID)
-
-	for i := range vt.Buckets {
-		bt := vt.Buckets[i]
-		fmt.Fprintf(&buf, "## %s (ID: %d)\n\n", bt.Bucket.Title, bt.Bucket.ID)
-
-		if len(bt.Tasks) == 0 {
-			buf.WriteString("(no tasks)\n\n")
-		} else {
-			for _, task := range bt.Tasks {
-				status := "❌"
-				if task.Done {
-					status = "✅"
-				}
-
-				title := strings.ReplaceAll(task.Title, "|", "\\|")
-				fmt.Fprintf(&buf, "- %s [Task %d] %s\n", status, task.ID, title)
-			}
-			buf.WriteString("\n")
-		}
-	}
-
-	return buf.String()
-}
-
 // FormatViewTasksSummaryAsMarkdown formats a view with buckets and tasks summary as markdown
 func (f *Formatter) FormatViewTasksSummaryAsMarkdown(vt *ViewTasksSummary) string {
 	var buf strings.Builder
@@ -283,10 +255,7 @@ func (f *Formatter) FormatViewTasksSummaryAsMarkdown(vt *ViewTasksSummary) strin
 			buf.WriteString("(no tasks)\n\n")
 		} else {
 			for _, task := range bt.Tasks {
-				// Note: TaskSummary doesn't have Done field, so we can't check completion status
-
-				title := strings.ReplaceAll(task.Title, "|", "\\|") // Escape pipe characters
-				fmt.Fprintf(&buf, "- [Task %d] %s\n", task.ID, title)
+				buf.WriteString(f.formatTaskSummaryLine(task))
 			}
 			buf.WriteString("\n")
 		}
@@ -327,20 +296,25 @@ func (f *Formatter) FormatTaskWithBucketsMarkdown(task *Task, bucketInfo *TaskBu
 
 	fmt.Fprintf(&buf, "# %s\n\n", task.Title)
 	fmt.Fprintf(&buf, "- **ID**: %d\n", task.ID)
-	fmt.Fprintf(&buf, "- **URI**: [vikunja://tasks/%d](vikunja://tasks/%d)\n", task.ID, task.ID)
+	fmt.Fprintf(&buf, "- **URI**: [%[1]s](%[1]s)\n", TaskURI(task.ID))
 
 	if task.ProjectID > 0 {
-		fmt.Fprintf(&buf, "- **Project**: [%d](vikunja://projects/%d)\n", task.ProjectID, task.ProjectID)
+		fmt.Fprintf(&buf, "- **Project**: [%d](%s)\n", task.ProjectID, ProjectURI(task.ProjectID))
 	}
 
-	formatDateField(task.Created, time.RFC3339, "Created", &buf)
-	formatDateField(task.Updated, time.RFC3339, "Updated", &buf)
-	formatDateField(task.DueDate, "2006-01-02", "Due Date", &buf)
+	f.formatDateField(task.Created, time.RFC3339, "Created", &buf)
+	f.formatDateField(task.Updated, time.RFC3339, "Updated", &buf)
+	f.formatDueDate(task, &buf)
+	f.formatDateField(task.StartDate, "2006-01-02", "Start Date", &buf)
+	f.formatDateField(task.EndDate, "2006-01-02", "End Date", &buf)
 
 	formatTaskStatus(task, &buf)
+	formatTaskPriority(task, &buf)
+	formatTaskProgress(task, &buf)
+	formatHexColor(task.HexColor, &buf)
 
 	if task.Description != "" {
-		fmt.Fprintf(&buf, "\n**Description**:\n%s\n", task.Description)
+		f.writeDescription(&buf, task.Description)
 	}
 
 	formatBucketInfo(bucketInfo, &buf)
@@ -354,26 +328,18 @@ func (f *Formatter) FormatProjectAndViewMarkdown(project *Project, view *Project
 
 	fmt.Fprintf(&buf, "# 📁 %s\n\n", project.Title)
 	fmt.Fprintf(&buf, "- **ID**: %d\n", project.ID)
-	fmt.Fprintf(&buf, "- **URI**: [vikunja://projects/%d](vikunja://projects/%d)\n", project.ID, project.ID)
+	fmt.Fprintf(&buf, "- **URI**: [%[1]s](%[1]s)\n", ProjectURI(project.ID))
 
 	if project.Identifier != nil && *project.Identifier != "" {
 		fmt.Fprintf(&buf, "- **Identifier**: `%s`\n", *project.Identifier)
 	}
 
-	if project.Created != "" {
-		if t := parseDate(project.Created); !t.IsZero() {
-			fmt.Fprintf(&buf, "- **Created**: %s\n", t.Format(time.RFC3339))
-		}
-	}
+	f.formatDateField(project.Created, time.RFC3339, "Created", &buf)
 
-	if project.Updated != "" {
-		if t := parseDate(project.Updated); !t.IsZero() {
-			fmt.Fprintf(&buf, "- **Updated**: %s\n", t.Format(time.RFC3339))
-		}
-	}
+	f.formatDateField(project.Updated, time.RFC3339, "Updated", &buf)
 
 	if project.Description != "" {
-		fmt.Fprintf(&buf, "\n**Description**:\n%s\n", project.Description)
+		f.writeDescription(&buf, project.Description)
 	}
 
 	buf.WriteString("\n---\n\n")
@@ -395,51 +361,3 @@ func getViewEmoji(viewKind string) string {
 	}
 	return ""
 }
-
-func formatProjectDetails(project *Project, buf *strings.Builder) {
-	if project.Identifier != nil && strings.TrimSpace(*project.Identifier) != "" {
-		fmt.Fprintf(buf, "- **Identifier**: `%s`\n", *project.Identifier)
-	}
-
-	if project.Created != "" {
-		t := parseDate(project.Created)
-		if !t.IsZero() {
-			fmt.Fprintf(buf, "- **Created**: %s\n", t.Format(time.RFC3339))
-		}
-	}
-
-	if project.Updated != "" {
-		t := parseDate(project.Updated)
-		if !t.IsZero() {
-			fmt.Fprintf(buf, "- **Updated**: %s\n", t.Format(time.RFC3339))
-		}
-	}
-
-	if project.Description != "" {
-		fmt.Fprintf(buf, "\n**Description**:\n%s\n", project.Description)
-	}
-}
-
-// FormatProjectAndViewListMarkdown formats a project and multiple views as markdown
-func (f *Formatter) FormatProjectAndViewListMarkdown(project *Project, views []*ProjectView) string {
-	var buf strings.Builder
-
-	fmt.Fprintf(&buf, "# 📁 %s\n\n", project.Title)
-	fmt.Fprintf(&buf, "- **ID**: %d\n", project.ID)
-	fmt.Fprintf(&buf, "- **URI**: [vikunja://projects/%d](vikunja://projects/%d)\n", project.ID, project.ID)
-
-	formatProjectDetails(project, &buf)
-
-	fmt.Fprintf(&buf, "\n## Views (%d)\n\n", len(views))
-	buf.WriteString("| 📋 View | ID | Type | Position |\n")
-	buf.WriteString("|---|---|---|---|\n")
-
-	for _, view := range views {
-		title := strings.ReplaceAll(view.Title, "|", "\\|")
-		viewEmoji := getViewEmoji(string(view.ViewKind))
-
-		fmt.Fprintf(&buf, "| %s%s | %d | %s | %.2f |\n", viewEmoji, title, view.ID, view.ViewKind, view.Position)
-	}
-
-	return buf.String()
-}
@@ -0,0 +1,120 @@
+package vikunja
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatViewTasksAsMarkdown formats a view with buckets and tasks as markdown
+func (f *Formatter) FormatViewTasksAsMarkdown(vt *ViewTasks) string {
+	var buf strings.Builder
+
+	fmt.Fprintf(&buf, "# %s (ID: %d)\n\n", vt.ViewTitle, vt.ViewID)
+
+	for i := range vt.Buckets {
+		bt := &vt.Buckets[i]
+		fmt.Fprintf(&buf, "## %s (ID: %d)%s\n\n", bt.Bucket.Title, bt.Bucket.ID, doneBucketMark(bt))
+		f.writeBucketTasks(&buf, bt.Tasks)
+	}
+
+	return buf.String()
+}
+
+// FormatBucketTasksAsMarkdown formats a single bucket with its tasks as markdown
+func (f *Formatter) FormatBucketTasksAsMarkdown(bt *BucketTasks) string {
+	var buf strings.Builder
+
+	fmt.Fprintf(&buf, "# 📁 %s (ID: %d)%s\n\n", bt.Bucket.Title, bt.Bucket.ID, doneBucketMark(bt))
+	fmt.Fprintf(&buf, "- **View ID**: %d\n", bt.Bucket.ProjectViewID)
+	fmt.Fprintf(&buf, "- **Tasks**: %d\n", len(bt.Tasks))
+	if bt.Bucket.Limit != nil && *bt.Bucket.Limit > 0 {
+		fmt.Fprintf(&buf, "- **Limit**: %d\n", *bt.Bucket.Limit)
+	}
+	buf.WriteString("\n")
+	f.writeBucketTasks(&buf, bt.Tasks)
+
+	return buf.String()
+}
+
+func doneBucketMark(bt *BucketTasks) string {
+	if bt.IsDoneBucket {
+		return " ✅ Done bucket"
+	}
+	return ""
+}
+
+// writeBucketTasks writes a bucket's tasks as a list, or a placeholder when it has none
+func (f *Formatter) writeBucketTasks(buf *strings.Builder, tasks []*Task) {
+	if len(tasks) == 0 {
+		buf.WriteString("(no tasks)\n\n")
+		return
+	}
+	for _, task := range tasks {
+		f.writeBoardTask(buf, task)
+	}
+	buf.WriteString("\n")
+}
+
+// writeBoardTask writes one task as a list item, with its description indented beneath it
+func (f *Formatter) writeBoardTask(buf *strings.Builder, task *Task) {
+	status := "❌"
+	if task.Done {
+		status = "✅"
+	}
+
+	title := strings.ReplaceAll(task.Title, "|", "\\|")
+	fmt.Fprintf(buf, "- %s [Task %d] %s%s\n", status, task.ID, title, f.boardTaskDetails(task))
+
+	if task.Description == "" {
+		return
+	}
+	for _, line := range strings.Split(f.formatDescription(f.previewDescription(task.Description)), "\n") {
+		if line == "" {
+			buf.WriteString("\n")
+			continue
+		}
+		fmt.Fprintf(buf, "  %s\n", line)
+	}
+}
+
+// boardTaskDetails renders the due date and priority of a task, if any, as a parenthesized suffix
+func (f *Formatter) boardTaskDetails(task *Task) string {
+	var details []string
+	if task.DueDate != "" {
+		if t := parseDate(task.DueDate); !t.IsZero() {
+			details = append(details, "due "+f.formatTime(t, "2006-01-02")+f.overdueSuffix(task))
+		}
+	}
+	if task.Priority > 0 {
+		details = append(details, fmt.Sprintf("priority %d", task.Priority))
+	}
+	if len(details) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(details, ", ") + ")"
+}
+
+// FormatBoardSummaryAsMarkdown formats the task counts of a project's kanban views as one table per view
+func (f *Formatter) FormatBoardSummaryAsMarkdown(summary *BoardSummary) string {
+	var buf strings.Builder
+
+	fmt.Fprintf(&buf, "# Board summary: %s (ID: %d)\n\n", summary.ProjectTitle, summary.ProjectID)
+	if len(summary.Views) == 0 {
+		buf.WriteString("No kanban views\n")
+		return buf.String()
+	}
+
+	for _, view := range summary.Views {
+		fmt.Fprintf(&buf, "## %s (ID: %d)\n\n", view.ViewTitle, view.ViewID)
+		buf.WriteString("| Bucket | Tasks |\n|--------|------:|\n")
+		for _, b := range view.Buckets {
+			mark := ""
+			if b.IsDoneBucket {
+				mark = " ✅"
+			}
+			fmt.Fprintf(&buf, "| %s%s | %d |\n", strings.ReplaceAll(b.Title, "|", "\\|"), mark, b.Tasks)
+		}
+		fmt.Fprintf(&buf, "\n**Done**: %d of %d (%.0f%%)\n\n", view.Done, view.Total, view.DoneRatio*100)
+	}
+	return buf.String()
+}
@@ -0,0 +1,63 @@
+package vikunja
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatter_FormatViewTasksAsMarkdown(t *testing.T) {
+	t.Parallel()
+	vt := &ViewTasks{
+		ViewID:    3,
+		ViewTitle: "Kanban",
+		Buckets: []BucketTasks{
+			{
+				Bucket: Bucket{ID: 10, Title: "Todo"},
+				Tasks: []*Task{{
+					ID: 1, Title: "Write | report", Description: "# Quarterly\nnumbers",
+					DueDate: "2026-03-10T12:00:00Z", Priority: 3,
+				}},
+			},
+			{
+				Bucket:       Bucket{ID: 12, Title: "Done"},
+				IsDoneBucket: true,
+			},
+		},
+	}
+
+	f := NewFormatter(false, nil)
+	f.clock = func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) }
+	got := f.FormatViewTasksAsMarkdown(vt)
+
+	assert.Equal(t, "# Kanban (ID: 3)\n\n"+
+		"## Todo (ID: 10)\n\n"+
+		"- ❌ [Task 1] Write \\| report (due 2026-03-10, priority 3)\n"+
+		"  \\# Quarterly\n"+
+		"  numbers\n\n"+
+		"## Done (ID: 12) ✅ Done bucket\n\n"+
+		"(no tasks)\n\n", got)
+}
+
+func TestFormatter_FormatBoardSummaryAsMarkdown(t *testing.T) {
+	t.Parallel()
+	view := &ProjectView{ID: 3, Title: "Kanban", DoneBucketID: 12}
+	summary := &BoardSummary{
+		ProjectID:    7,
+		ProjectTitle: "Launch",
+		Views: []ViewSummary{NewViewSummary(view, []*Bucket{
+			{ID: 10, Title: "Todo", Tasks: []*Task{{ID: 1}, {ID: 2}}},
+			{ID: 12, Title: "Done", Tasks: []*Task{{ID: 3, Done: true}}},
+		})},
+	}
+
+	got := NewFormatter(false, nil).FormatBoardSummaryAsMarkdown(summary)
+
+	assert.Equal(t, "# Board summary: Launch (ID: 7)\n\n"+
+		"## Kanban (ID: 3)\n\n"+
+		"| Bucket | Tasks |\n|--------|------:|\n"+
+		"| Todo | 2 |\n"+
+		"| Done ✅ | 1 |\n\n"+
+		"**Done**: 1 of 3 (33%)\n\n", got)
+}
@@ -0,0 +1,144 @@
+package vikunja
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DescriptionMode controls how task and project descriptions are embedded in markdown output.
+type DescriptionMode string
+
+// DescriptionMode constants.
+const (
+	// DescriptionEscaped escapes the markdown and HTML that could break the surrounding layout,
+	// such as table pipes, headings and code fences. It is the default.
+	DescriptionEscaped DescriptionMode = "escaped"
+	// DescriptionFenced wraps descriptions in a code fence, showing them exactly as stored.
+	DescriptionFenced DescriptionMode = "fenced"
+	// DescriptionVerbatim embeds descriptions unchanged, letting their markdown render.
+	DescriptionVerbatim DescriptionMode = "verbatim"
+)
+
+// SetDescriptionMode sets how descriptions are embedded in markdown output.
+func (f *Formatter) SetDescriptionMode(mode DescriptionMode) {
+	f.descriptionMode = mode
+}
+
+// WithDescriptionMode sets how descriptions are embedded and returns the formatter.
+func (f *MarkdownFormatter) WithDescriptionMode(mode DescriptionMode) *MarkdownFormatter {
+	f.formatter.SetDescriptionMode(mode)
+	return f
+}
+
+// SetDescriptionPreview trims descriptions in listings of tasks and projects to chars characters,
+// marking the cut with an ellipsis. Single tasks and projects keep their full descriptions. Zero
+// keeps every description whole.
+func (f *Formatter) SetDescriptionPreview(chars int) {
+	f.descriptionPreview = chars
+}
+
+// WithDescriptionPreview trims descriptions in listings to chars characters and returns the formatter.
+func (f *MarkdownFormatter) WithDescriptionPreview(chars int) *MarkdownFormatter {
+	f.formatter.SetDescriptionPreview(chars)
+	return f
+}
+
+// PreviewDescriptions makes the markdown part of formatter trim descriptions in listings to chars
+// characters. JSON output always keeps whole descriptions, so a JSON-only formatter is returned unchanged.
+func PreviewDescriptions(formatter OutputFormatter, chars int) OutputFormatter {
+	switch f := formatter.(type) {
+	case *MarkdownFormatter:
+		f.WithDescriptionPreview(chars)
+	case *BothFormatter:
+		f.markdownFormatter.WithDescriptionPreview(chars)
+	case *BothStructuredFormatter:
+		f.markdownFormatter.WithDescriptionPreview(chars)
+	}
+	return formatter
+}
+
+// previewDescription shortens a description shown in a listing to the preview length
+func (f *Formatter) previewDescription(description string) string {
+	if f.descriptionPreview <= 0 || utf8.RuneCountInString(description) <= f.descriptionPreview {
+		return description
+	}
+	runes := []rune(description)
+	return strings.TrimRightFunc(string(runes[:f.descriptionPreview]), unicode.IsSpace) + "…"
+}
+
+// writeDescription writes a description section using the formatter's description mode
+func (f *Formatter) writeDescription(buf *strings.Builder, description string) {
+	fmt.Fprintf(buf, "\n**Description**:\n%s\n", f.formatDescription(description))
+}
+
+func (f *Formatter) formatDescription(description string) string {
+	switch f.descriptionMode {
+	case DescriptionVerbatim:
+		return description
+	case DescriptionFenced:
+		return fenceMarkdown(description)
+	default:
+		return escapeMarkdownBlock(description)
+	}
+}
+
+// fenceMarkdown wraps text in a backtick fence longer than any backtick run inside it
+func fenceMarkdown(text string) string {
+	fence := "```"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	return fence + "\n" + strings.TrimRight(text, "\n") + "\n" + fence
+}
+
+// escapeMarkdownBlock escapes text line by line so it reads as plain paragraphs: pipes and HTML
+// are neutralized everywhere and block markers are escaped at the start of a line
+func escapeMarkdownBlock(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		line = strings.ReplaceAll(line, "|", `\|`)
+		line = strings.ReplaceAll(line, "<", "&lt;")
+		lines[i] = escapeBlockMarker(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// escapeBlockMarker escapes a line start that would turn the line into a heading, quote, code
+// fence, setext underline or thematic break
+func escapeBlockMarker(line string) string {
+	content := strings.TrimLeft(line, " \t")
+	if content == "" {
+		return line
+	}
+	indent := line[:len(line)-len(content)]
+
+	switch {
+	case strings.ContainsRune("#>`~=", rune(content[0])):
+		return indent + `\` + content
+	case isThematicBreak(content), strings.Trim(content, "- \t") == "":
+		return indent + `\` + content
+	default:
+		return line
+	}
+}
+
+// isThematicBreak reports whether a line is three or more -, * or _ characters, optionally spaced
+func isThematicBreak(content string) bool {
+	marker := content[0]
+	if marker != '-' && marker != '*' && marker != '_' {
+		return false
+	}
+	count := 0
+	for i := 0; i < len(content); i++ {
+		switch content[i] {
+		case marker:
+			count++
+		case ' ', '\t':
+		default:
+			return false
+		}
+	}
+	return count >= 3
+}
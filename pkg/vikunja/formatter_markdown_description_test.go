@@ -0,0 +1,117 @@
+package vikunja
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const unsafeDescription = "# Not a heading\n| a | b |\n```go\nfmt.Println(\"hi\")\n```\n<script>x</script>\n---\n- keep this list"
+
+func TestFormatter_DescriptionModes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		mode DescriptionMode
+		want string
+	}{
+		{
+			name: "escaped by default",
+			mode: "",
+			want: "\\# Not a heading\n\\| a \\| b \\|\n\\```go\nfmt.Println(\"hi\")\n\\```\n&lt;script>x&lt;/script>\n\\---\n- keep this list",
+		},
+		{
+			name: "fenced",
+			mode: DescriptionFenced,
+			want: "````\n" + unsafeDescription + "\n````",
+		},
+		{
+			name: "verbatim",
+			mode: DescriptionVerbatim,
+			want: unsafeDescription,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := NewFormatter(false, nil)
+			f.SetDescriptionMode(tt.mode)
+
+			out := f.FormatTaskAsMarkdown(&Task{ID: 1, Title: "Pipes", Description: unsafeDescription})
+			assert.Contains(t, out, "**Description**:\n"+tt.want+"\n")
+		})
+	}
+}
+
+func TestEscapeMarkdownBlock(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"indented heading", "  ## Title", `  \## Title`},
+		{"quote", "> quoted", `\> quoted`},
+		{"tilde fence", "~~~", `\~~~`},
+		{"setext underline", "===", `\===`},
+		{"short setext underline", "-", `\-`},
+		{"spaced thematic break", "* * *", `\* * *`},
+		{"list item", "* item", "* item"},
+		{"hash inside text", "issue #12", "issue #12"},
+		{"blank line", "a\n\nb", "a\n\nb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, escapeMarkdownBlock(tt.in))
+		})
+	}
+}
+
+func TestMarkdownFormatter_WithDescriptionMode(t *testing.T) {
+	t.Parallel()
+	out, err := NewMarkdownFormatter().WithDescriptionMode(DescriptionFenced).Format(&Project{ID: 3, Title: "Docs", Description: "| x |"})
+	if assert.NoError(t, err) {
+		assert.Contains(t, out, "```\n| x |\n```")
+	}
+}
+
+func TestFormatter_DescriptionPreview(t *testing.T) {
+	t.Parallel()
+	f := NewFormatter(false, nil)
+	f.SetDescriptionPreview(11)
+	task := &Task{ID: 7, Title: "Write docs", Description: "Cover every option of the server in detail"}
+
+	listed := f.FormatTasksAsMarkdown([]*Task{task})
+	assert.Contains(t, listed, "**Description**:\nCover every…\n")
+	assert.NotContains(t, listed, "in detail")
+
+	detail := f.FormatTaskAsMarkdown(task)
+	assert.Contains(t, detail, "**Description**:\nCover every option of the server in detail\n")
+}
+
+func TestFormatter_PreviewDescription(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		chars       int
+		description string
+		want        string
+	}{
+		{"disabled", 0, "A long description", "A long description"},
+		{"short enough", 20, "A long description", "A long description"},
+		{"trimmed at a space", 7, "A long description", "A long…"},
+		{"counts characters", 3, "Ünïcode", "Ünï…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := NewFormatter(false, nil)
+			f.SetDescriptionPreview(tt.chars)
+			assert.Equal(t, tt.want, f.previewDescription(tt.description))
+		})
+	}
+}
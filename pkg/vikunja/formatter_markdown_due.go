@@ -0,0 +1,51 @@
+package vikunja
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatDueReport groups the incomplete tasks by when they are due, relative to the formatter's
+// clock, and formats them as markdown
+func (f *Formatter) FormatDueReport(tasks []*Task) string {
+	report := NewDueReport(tasks, f.currentTime())
+	return f.FormatDueReportAsMarkdown(&report)
+}
+
+// FormatDueReportAsMarkdown formats a due report, one section per due group
+func (f *Formatter) FormatDueReportAsMarkdown(report *DueReport) string {
+	var buf strings.Builder
+	buf.WriteString("# 📅 Due report\n\n")
+
+	sections := []struct {
+		title string
+		tasks []*Task
+	}{
+		{"⚠️ Overdue", report.Overdue},
+		{"Today", report.Today},
+		{"This Week", report.ThisWeek},
+		{"Later", report.Later},
+		{"No Due Date", report.NoDueDate},
+	}
+	for _, section := range sections {
+		fmt.Fprintf(&buf, "## %s (%d)\n\n", section.title, len(section.tasks))
+		if len(section.tasks) == 0 {
+			buf.WriteString("(no tasks)\n\n")
+			continue
+		}
+		for _, task := range section.tasks {
+			buf.WriteString(f.formatDueReportLine(task))
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+// formatDueReportLine formats a task as a markdown list item with its due date, when it has one
+func (f *Formatter) formatDueReportLine(task *Task) string {
+	line := fmt.Sprintf("- [Task %d] %s", task.ID, strings.ReplaceAll(task.Title, "|", "\\|"))
+	if due := parseDate(task.DueDate); !due.IsZero() {
+		line += " (due: " + f.formatTime(due, "2006-01-02 15:04") + ")"
+	}
+	return line + "\n"
+}
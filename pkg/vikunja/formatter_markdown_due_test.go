@@ -0,0 +1,86 @@
+package vikunja
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// dueReportSection returns the markdown section of a due report titled title
+func dueReportSection(report, title string) string {
+	_, section, _ := strings.Cut(report, "## "+title+" (")
+	section, _, _ = strings.Cut(section, "\n## ")
+	return section
+}
+
+func TestFormatter_FormatDueReport(t *testing.T) {
+	t.Parallel()
+	// Wednesday noon
+	now := time.Date(2026, 3, 11, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		due     string
+		section string
+	}{
+		{"earlier today", "2026-03-11T11:59:00Z", "⚠️ Overdue"},
+		{"last week", "2026-03-02T09:00:00Z", "⚠️ Overdue"},
+		{"later today", "2026-03-11T12:01:00Z", "Today"},
+		{"last minute of today", "2026-03-11T23:59:00Z", "Today"},
+		{"midnight tonight", "2026-03-12T00:00:00Z", "This Week"},
+		{"last minute of Sunday", "2026-03-15T23:59:00Z", "This Week"},
+		{"next Monday", "2026-03-16T00:00:00Z", "Later"},
+		{"no due date", "", "No Due Date"},
+		{"Vikunja's empty due date", "0001-01-01T00:00:00Z", "No Due Date"},
+	}
+	sections := []string{"⚠️ Overdue", "Today", "This Week", "Later", "No Due Date"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := NewFormatter(false, nil)
+			f.clock = func() time.Time { return now }
+
+			report := f.FormatDueReport([]*Task{{ID: 7, Title: "Pay invoice", DueDate: tt.due}})
+			for _, section := range sections {
+				if section == tt.section {
+					assert.Contains(t, dueReportSection(report, section), "[Task 7] Pay invoice", "expected in %s", section)
+				} else {
+					assert.NotContains(t, dueReportSection(report, section), "[Task 7]", "unexpected in %s", section)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatter_FormatDueReport_ExcludesDoneAndSorts(t *testing.T) {
+	t.Parallel()
+	f := NewFormatter(false, nil)
+	f.clock = func() time.Time { return time.Date(2026, 3, 11, 12, 0, 0, 0, time.UTC) }
+
+	report := f.FormatDueReport([]*Task{
+		{ID: 1, Title: "Finished", DueDate: "2026-03-11T18:00:00Z", Done: true},
+		{ID: 2, Title: "Evening", DueDate: "2026-03-11T20:00:00Z"},
+		{ID: 3, Title: "Afternoon", DueDate: "2026-03-11T15:00:00Z"},
+	})
+
+	assert.NotContains(t, report, "Finished")
+	today := dueReportSection(report, "Today")
+	assert.Less(t, strings.Index(today, "Afternoon"), strings.Index(today, "Evening"))
+	assert.Contains(t, report, "## Today (2)")
+	assert.Contains(t, report, "## Later (0)\n\n(no tasks)")
+}
+
+func TestNewDueReport_SundayWeekEndsTonight(t *testing.T) {
+	t.Parallel()
+	sunday := time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)
+	report := NewDueReport([]*Task{
+		{ID: 1, DueDate: "2026-03-15T22:00:00Z"},
+		{ID: 2, DueDate: "2026-03-16T09:00:00Z"},
+	}, sunday)
+
+	assert.Len(t, report.Today, 1)
+	assert.Empty(t, report.ThisWeek)
+	assert.Len(t, report.Later, 1)
+}
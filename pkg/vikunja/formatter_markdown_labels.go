@@ -0,0 +1,32 @@
+package vikunja
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatTaskLabelGroupsAsMarkdown formats a project's tasks grouped by label, one section per label
+func (f *Formatter) FormatTaskLabelGroupsAsMarkdown(lg *TaskLabelGroups) string {
+	var buf strings.Builder
+
+	fmt.Fprintf(&buf, "# 🏷️ %s by label (ID: %d)\n\n", lg.ProjectTitle, lg.ProjectID)
+
+	for _, group := range lg.Groups {
+		if group.LabelID != 0 {
+			fmt.Fprintf(&buf, "## 🏷️ %s (ID: %d)\n\n", group.Label, group.LabelID)
+		} else {
+			fmt.Fprintf(&buf, "## %s\n\n", group.Label)
+		}
+
+		if len(group.Tasks) == 0 {
+			buf.WriteString("(no tasks)\n\n")
+			continue
+		}
+		for _, task := range group.Tasks {
+			buf.WriteString(f.formatTaskSummaryLine(task))
+		}
+		buf.WriteString("\n")
+	}
+
+	return buf.String()
+}
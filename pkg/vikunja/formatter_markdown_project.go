@@ -0,0 +1,65 @@
+package vikunja
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// projectHeading returns the project title, tagged when the project is archived.
+func projectHeading(project *Project) string {
+	if project.IsArchived {
+		return project.Title + " 🗄️ (archived)"
+	}
+	return project.Title
+}
+
+func (f *Formatter) formatProjectDetails(project *Project, buf *strings.Builder) {
+	if project.Identifier != nil && strings.TrimSpace(*project.Identifier) != "" {
+		fmt.Fprintf(buf, "- **Identifier**: `%s`\n", *project.Identifier)
+	}
+
+	f.formatDateField(project.Created, time.RFC3339, "Created", buf)
+
+	f.formatDateField(project.Updated, time.RFC3339, "Updated", buf)
+
+	if project.Description != "" {
+		f.writeDescription(buf, project.Description)
+	}
+}
+
+// FormatProjectAndViewListMarkdown formats a project and multiple views as markdown
+func (f *Formatter) FormatProjectAndViewListMarkdown(project *Project, views []*ProjectView) string {
+	var buf strings.Builder
+
+	fmt.Fprintf(&buf, "# 📁 %s\n\n", project.Title)
+	fmt.Fprintf(&buf, "- **ID**: %d\n", project.ID)
+	fmt.Fprintf(&buf, "- **URI**: [%[1]s](%[1]s)\n", ProjectURI(project.ID))
+
+	f.formatProjectDetails(project, &buf)
+
+	fmt.Fprintf(&buf, "\n## Views (%d)\n\n", len(views))
+	buf.WriteString("| 📋 View | ID | Type | Position |\n")
+	buf.WriteString("|---|---|---|---|\n")
+
+	for _, view := range views {
+		title := strings.ReplaceAll(view.Title, "|", "\\|")
+		viewEmoji := getViewEmoji(string(view.ViewKind))
+
+		fmt.Fprintf(&buf, "| %s%s | %d | %s | %.2f |\n", viewEmoji, title, view.ID, view.ViewKind, view.Position)
+	}
+
+	return buf.String()
+}
+
+// FormatViewsOutputMarkdown formats a project and its views, followed by the buckets of each
+// kanban view when they were read.
+func (f *Formatter) FormatViewsOutputMarkdown(data *ViewsOutput) string {
+	var buf strings.Builder
+	buf.WriteString(f.FormatProjectAndViewListMarkdown(&data.Project, data.Views))
+	for _, vb := range data.Buckets {
+		fmt.Fprintf(&buf, "\n---\n\n# %s%s (view %d)\n\n", getViewEmoji(string(ViewKindKanban)), vb.ViewTitle, vb.ViewID)
+		buf.WriteString(f.FormatBucketsAsMarkdown(vb.Buckets))
+	}
+	return buf.String()
+}
@@ -0,0 +1,47 @@
+package vikunja
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatProjectsAsMarkdown_ArchivedTag(t *testing.T) {
+	t.Parallel()
+	f := NewFormatter(false, nil)
+
+	out := f.FormatProjectsAsMarkdown([]*Project{
+		{ID: 1, Title: "Inbox"},
+		{ID: 2, Title: "Old", IsArchived: true},
+	})
+
+	assert.Contains(t, out, "## 📁 Inbox\n")
+	assert.Contains(t, out, "## 📁 Old 🗄️ (archived)\n")
+	assert.Contains(t, f.FormatProjectAsMarkdown(&Project{ID: 2, Title: "Old", IsArchived: true}), "# Old 🗄️ (archived)\n")
+}
+
+func TestFormatProjectAsMarkdown_HexColor(t *testing.T) {
+	t.Parallel()
+	f := NewFormatter(false, nil)
+
+	assert.Contains(t, f.FormatProjectAsMarkdown(&Project{ID: 7, Title: "Board", HexColor: "e8e8e8"}), "- **Color**: #e8e8e8\n")
+	assert.NotContains(t, f.FormatProjectAsMarkdown(&Project{ID: 7, Title: "Board"}), "Color")
+}
+
+func TestFormatViewsOutputMarkdown_Buckets(t *testing.T) {
+	t.Parallel()
+	f := NewFormatter(false, nil)
+	views := []*ProjectView{{ID: 3, Title: "Board", ViewKind: string(ViewKindKanban)}}
+
+	out := f.FormatViewsOutputMarkdown(&ViewsOutput{
+		Project: Project{ID: 7, Title: "Work"},
+		Views:   views,
+		Buckets: []ViewBuckets{{ViewID: 3, ViewTitle: "Board", Buckets: []*Bucket{{ID: 10, Title: "Todo"}}}},
+	})
+	assert.Contains(t, out, "## Views (1)")
+	assert.Contains(t, out, "# 📋 Board (view 3)\n")
+	assert.Contains(t, out, "| Todo | 10 | 0 | - |")
+
+	withoutBuckets := f.FormatViewsOutputMarkdown(&ViewsOutput{Project: Project{ID: 7, Title: "Work"}, Views: views})
+	assert.Equal(t, f.FormatProjectAndViewListMarkdown(&Project{ID: 7, Title: "Work"}, views), withoutBuckets)
+}
@@ -0,0 +1,51 @@
+package vikunja
+
+import (
+	"fmt"
+	"strings"
+)
+
+// formatTaskSummaryLine formats a task summary as a markdown list item, followed by whichever
+// optional fields the summary carries
+func (f *Formatter) formatTaskSummaryLine(task TaskSummary) string {
+	var buf strings.Builder
+	buf.WriteString("- ")
+	if task.ID != 0 {
+		fmt.Fprintf(&buf, "[Task %d] ", task.ID)
+	}
+	buf.WriteString(strings.ReplaceAll(task.Title, "|", "\\|")) // Escape pipe characters
+
+	if details := taskSummaryDetails(task); len(details) > 0 {
+		fmt.Fprintf(&buf, " (%s)", strings.Join(details, ", "))
+	}
+	buf.WriteString("\n")
+	if task.Description != "" {
+		fmt.Fprintf(&buf, "  %s\n", strings.ReplaceAll(f.previewDescription(task.Description), "\n", "\n  "))
+	}
+	return buf.String()
+}
+
+// taskSummaryDetails lists the short optional fields of a task summary as "name: value" pairs
+func taskSummaryDetails(task TaskSummary) []string {
+	var details []string
+	if task.URI != "" {
+		details = append(details, task.URI)
+	}
+	if task.Done != nil {
+		details = append(details, fmt.Sprintf("done: %t", *task.Done))
+	}
+	for _, date := range []struct{ name, value string }{
+		{"due", task.DueDate}, {"start", task.StartDate}, {"end", task.EndDate},
+	} {
+		if date.value != "" {
+			details = append(details, date.name+": "+date.value)
+		}
+	}
+	if task.Priority != nil {
+		details = append(details, fmt.Sprintf("priority: %s", priorityName(*task.Priority)))
+	}
+	if task.PercentDone != nil {
+		details = append(details, fmt.Sprintf("%.0f%% done", *task.PercentDone*100))
+	}
+	return details
+}
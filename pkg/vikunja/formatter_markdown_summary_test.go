@@ -0,0 +1,32 @@
+package vikunja
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatTaskSummaryLine(t *testing.T) {
+	t.Parallel()
+	done := true
+	priority := int64(4)
+	tests := []struct {
+		name string
+		task TaskSummary
+		want string
+	}{
+		{name: "default", task: TaskSummary{ID: 1, Title: "A | B"}, want: "- [Task 1] A \\| B\n"},
+		{name: "without id", task: TaskSummary{Title: "One"}, want: "- One\n"},
+		{
+			name: "selected fields",
+			task: TaskSummary{ID: 2, Title: "Two", Done: &done, DueDate: "2026-11-01T00:00:00Z", Priority: &priority, Description: "line 1\nline 2"},
+			want: "- [Task 2] Two (done: true, due: 2026-11-01T00:00:00Z, priority: Urgent)\n  line 1\n  line 2\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, NewFormatter(false, nil).formatTaskSummaryLine(tt.task))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package vikunja
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// progressBarWidth is the number of cells in a task's markdown progress bar
+const progressBarWidth = 10
+
+// priorityName returns Vikunja's display name for a task priority level.
+func priorityName(priority int64) string {
+	switch priority {
+	case 1:
+		return "Low"
+	case 2:
+		return "Medium"
+	case 3:
+		return "High"
+	case 4:
+		return "Urgent"
+	case 5:
+		return "DO NOW"
+	default:
+		return "Unset"
+	}
+}
+
+func formatTaskPriority(task *Task, buf *strings.Builder) {
+	if task.Priority <= 0 {
+		return
+	}
+	fmt.Fprintf(buf, "- **Priority**: %s (%d)\n", priorityName(task.Priority), task.Priority)
+}
+
+// formatDueDate writes a task's due date, flagging it when the task is overdue
+func (f *Formatter) formatDueDate(task *Task, buf *strings.Builder) {
+	due := parseDate(task.DueDate)
+	if due.IsZero() {
+		return
+	}
+	fmt.Fprintf(buf, "- **Due Date**: %s%s\n", f.formatTime(due, "2006-01-02"), f.overdueSuffix(task))
+}
+
+// overdueSuffix returns the overdue marker, preceded by a space, for an overdue task and "" otherwise
+func (f *Formatter) overdueSuffix(task *Task) string {
+	if !isOverdueAt(task, f.currentTime()) {
+		return ""
+	}
+	return " " + overdueMarker
+}
+
+// formatTaskProgress writes a task's percent done, stored by Vikunja as a fraction from 0 to 1,
+// as a progress bar
+func formatTaskProgress(task *Task, buf *strings.Builder) {
+	if task.PercentDone <= 0 {
+		return
+	}
+	percent := math.Min(task.PercentDone, 1)
+	filled := int(math.Round(percent * progressBarWidth))
+	bar := strings.Repeat("▓", filled) + strings.Repeat("░", progressBarWidth-filled)
+	fmt.Fprintf(buf, "- **Progress**: %s %.0f%%\n", bar, percent*100)
+}
+
+// formatHexColor writes a task or project color, which Vikunja stores without the leading #
+func formatHexColor(hexColor string, buf *strings.Builder) {
+	if hexColor == "" {
+		return
+	}
+	fmt.Fprintf(buf, "- **Color**: #%s\n", strings.TrimPrefix(hexColor, "#"))
+}
+
+func formatLabels(labels []*Label, buf *strings.Builder) {
+	if len(labels) == 0 {
+		return
+	}
+
+	buf.WriteString("\n**Labels**:\n")
+	for _, label := range labels {
+		if label.HexColor != "" {
+			fmt.Fprintf(buf, "- %s (#%s)\n", label.Title, strings.TrimPrefix(label.HexColor, "#"))
+		} else {
+			fmt.Fprintf(buf, "- %s\n", label.Title)
+		}
+	}
+}
+
+func formatSubtasks(subtasks []*Task, buf *strings.Builder) {
+	if len(subtasks) == 0 {
+		return
+	}
+
+	buf.WriteString("\n**Subtasks**:\n")
+	for _, task := range subtasks {
+		done := "❌"
+		if task.Done {
+			done = "✅"
+		}
+		fmt.Fprintf(buf, "- %s [Task %d] %s\n", done, task.ID, task.Title)
+	}
+}
+
+// formatCommentSummary writes how many comments a task has and who wrote the latest, when known
+func (f *Formatter) formatCommentSummary(summary *CommentSummary, buf *strings.Builder) {
+	if summary == nil {
+		return
+	}
+	if summary.Count == 0 {
+		buf.WriteString("\n**Comments**: none\n")
+		return
+	}
+
+	fmt.Fprintf(buf, "\n**Comments**: %d", summary.Count)
+	if latest := parseDate(summary.LatestAt); !latest.IsZero() {
+		fmt.Fprintf(buf, ", latest %s", f.formatTime(latest, "2006-01-02 15:04"))
+		if summary.LatestAuthor != "" {
+			fmt.Fprintf(buf, " by @%s", summary.LatestAuthor)
+		}
+	}
+	buf.WriteString("\n")
+}
+
+func formatAssignees(users []*User, buf *strings.Builder) {
+	if len(users) == 0 {
+		return
+	}
+
+	buf.WriteString("\n**Assignees**:\n")
+	for _, user := range users {
+		if user.Name != "" {
+			fmt.Fprintf(buf, "- %s (@%s)\n", user.Name, user.Username)
+		} else {
+			fmt.Fprintf(buf, "- @%s\n", user.Username)
+		}
+	}
+}
+
+// FormatTaskOutputMarkdown formats a task with its optional bucket, label and assignee sections as markdown
+func (f *Formatter) FormatTaskOutputMarkdown(out *TaskOutput) string {
+	var buf strings.Builder
+
+	buf.WriteString(f.FormatTaskWithBucketsMarkdown(&out.Task, out.Buckets))
+	formatLabels(out.Labels, &buf)
+	formatAssignees(out.Assignees, &buf)
+	formatSubtasks(out.Subtasks, &buf)
+	f.formatCommentSummary(out.Comments, &buf)
+	if out.Subscribed != nil && *out.Subscribed {
+		buf.WriteString("\n**Watching**: 👁️ Subscribed to notifications\n")
+	}
+
+	return buf.String()
+}
+
+// FormatTaskCountsMarkdown formats a project's task counts as markdown.
+func (f *Formatter) FormatTaskCountsMarkdown(counts *TaskCounts) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "# Task counts: %s\n\n", counts.ProjectTitle)
+	fmt.Fprintf(&buf, "- **Project ID**: %d\n", counts.ProjectID)
+	fmt.Fprintf(&buf, "- **Total**: %d\n", counts.Total)
+	if counts.Open != nil {
+		fmt.Fprintf(&buf, "- **Open**: %d\n", *counts.Open)
+	}
+	if counts.Done != nil {
+		fmt.Fprintf(&buf, "- **Done**: %d\n", *counts.Done)
+	}
+	return buf.String()
+}
@@ -0,0 +1,34 @@
+package vikunja
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatTaskWithBucketsMarkdown_Progress(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		percentDone float64
+		want        string
+	}{
+		{"half done", 0.5, "- **Progress**: ▓▓▓▓▓░░░░░ 50%\n"},
+		{"finished", 1, "- **Progress**: ▓▓▓▓▓▓▓▓▓▓ 100%\n"},
+		{"rounded", 0.333, "- **Progress**: ▓▓▓░░░░░░░ 33%\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			out := NewFormatter(false, nil).FormatTaskWithBucketsMarkdown(&Task{ID: 5, Title: "Ship it", PercentDone: tt.percentDone}, nil)
+			assert.Contains(t, out, tt.want)
+		})
+	}
+}
+
+func TestFormatTaskWithBucketsMarkdown_NoProgress(t *testing.T) {
+	t.Parallel()
+	out := NewFormatter(false, nil).FormatTaskWithBucketsMarkdown(&Task{ID: 5, Title: "Ship it"}, nil)
+	assert.NotContains(t, out, "Progress")
+}
@@ -0,0 +1,92 @@
+package vikunja
+
+import (
+	"fmt"
+	"time"
+)
+
+// SetHumanizeTimes makes markdown output render timestamps relative to now, e.g. "2 days ago"
+// or "in 3 hours". A nil now restores absolute timestamps.
+func (f *Formatter) SetHumanizeTimes(now func() time.Time) {
+	f.humanizeTimes = now != nil
+	f.clock = now
+}
+
+// WithHumanizedTimes makes markdown output render timestamps relative to now and returns the formatter.
+func (f *MarkdownFormatter) WithHumanizedTimes(now func() time.Time) *MarkdownFormatter {
+	f.formatter.SetHumanizeTimes(now)
+	return f
+}
+
+// HumanizeTimes makes the markdown part of formatter render timestamps relative to now.
+// JSON output always keeps absolute timestamps, so a JSON-only formatter is returned unchanged.
+func HumanizeTimes(formatter OutputFormatter, now func() time.Time) OutputFormatter {
+	switch f := formatter.(type) {
+	case *MarkdownFormatter:
+		f.WithHumanizedTimes(now)
+	case *BothFormatter:
+		f.markdownFormatter.WithHumanizedTimes(now)
+	case *BothStructuredFormatter:
+		f.markdownFormatter.WithHumanizedTimes(now)
+	}
+	return formatter
+}
+
+// currentTime returns the time relative and overdue markers are judged against
+func (f *Formatter) currentTime() time.Time {
+	if f.clock != nil {
+		return f.clock()
+	}
+	return time.Now()
+}
+
+// formatTime renders t with layout, or relative to the current time when humanized times are enabled
+func (f *Formatter) formatTime(t time.Time, layout string) string {
+	if !f.humanizeTimes {
+		return t.Format(layout)
+	}
+	return humanizeTime(t, f.currentTime())
+}
+
+// timeUnit is a unit relative times are expressed in
+type timeUnit struct {
+	size time.Duration
+	name string
+}
+
+// timeUnits returns the units relative times are expressed in, largest first
+func timeUnits() []timeUnit {
+	return []timeUnit{
+		{365 * 24 * time.Hour, "year"},
+		{30 * 24 * time.Hour, "month"},
+		{7 * 24 * time.Hour, "week"},
+		{24 * time.Hour, "day"},
+		{time.Hour, "hour"},
+		{time.Minute, "minute"},
+	}
+}
+
+// humanizeTime describes t relative to now in its largest whole unit, e.g. "3 days ago" or "in 1 hour"
+func humanizeTime(t, now time.Time) string {
+	d := t.Sub(now)
+	future := d > 0
+	if d < 0 {
+		d = -d
+	}
+
+	for _, unit := range timeUnits() {
+		if d < unit.size {
+			continue
+		}
+		n := int64(d / unit.size)
+		amount := fmt.Sprintf("%d %s", n, unit.name)
+		if n != 1 {
+			amount += "s"
+		}
+		if future {
+			return "in " + amount
+		}
+		return amount + " ago"
+	}
+	return "just now"
+}
@@ -0,0 +1,71 @@
+package vikunja
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHumanizeTime(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		at   time.Time
+		want string
+	}{
+		{name: "moments ago", at: now.Add(-30 * time.Second), want: "just now"},
+		{name: "one minute ago", at: now.Add(-time.Minute), want: "1 minute ago"},
+		{name: "hours ago", at: now.Add(-5 * time.Hour), want: "5 hours ago"},
+		{name: "days ago", at: now.Add(-2 * 24 * time.Hour), want: "2 days ago"},
+		{name: "weeks ago", at: now.Add(-15 * 24 * time.Hour), want: "2 weeks ago"},
+		{name: "years ago", at: now.AddDate(-3, 0, 0), want: "3 years ago"},
+		{name: "in hours", at: now.Add(3 * time.Hour), want: "in 3 hours"},
+		{name: "in one day", at: now.Add(24 * time.Hour), want: "in 1 day"},
+		{name: "in months", at: now.Add(65 * 24 * time.Hour), want: "in 2 months"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, humanizeTime(tt.at, now))
+		})
+	}
+}
+
+func TestFormatter_HumanizedTaskTimes(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	task := &Task{
+		ID:      42,
+		Title:   "Write docs",
+		Created: "2026-03-08T12:00:00Z",
+		Updated: "0001-01-01T00:00:00Z",
+		DueDate: "2026-03-10T15:00:00Z",
+	}
+
+	f := NewFormatter(false, nil)
+	f.SetHumanizeTimes(func() time.Time { return now })
+	got := f.FormatTaskAsMarkdown(task)
+
+	assert.Contains(t, got, "- **Created**: 2 days ago\n", "past")
+	assert.Contains(t, got, "- **Due Date**: in 3 hours\n", "future")
+	assert.NotContains(t, got, "**Updated**", "zero times render nothing")
+}
+
+func TestHumanizeTimes_JSONStaysAbsolute(t *testing.T) {
+	t.Parallel()
+	now := func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) }
+	task := &Task{ID: 42, Title: "Write docs", DueDate: "2026-03-12T12:00:00Z"}
+
+	out, err := HumanizeTimes(NewBothStructuredFormatter(), now).Format(task)
+	require.NoError(t, err)
+	assert.Contains(t, out, `"due_date": "2026-03-12T12:00:00Z"`)
+	assert.Contains(t, out, `**Due Date**: in 2 days`)
+
+	out, err = HumanizeTimes(NewJSONFormatter(), now).Format(task)
+	require.NoError(t, err)
+	assert.Contains(t, out, `"due_date": "2026-03-12T12:00:00Z"`)
+}
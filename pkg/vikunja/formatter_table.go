@@ -27,7 +27,7 @@ func (f *Formatter) FormatProjects(projects []*Project) error {
 	}
 
 	for _, p := range projects {
-		uri := fmt.Sprintf("vikunja://projects/%d", p.ID)
+		uri := ProjectURI(p.ID)
 		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\n", p.Title, p.ID, uri)
 	}
 
@@ -43,14 +43,14 @@ func (f *Formatter) FormatProject(project *Project) error {
 		labelColor := color.New(color.FgYellow)
 		_, _ = fmt.Fprintf(f.output, "%s\n\n", titleColor.Sprint(project.Title))
 		_, _ = fmt.Fprintf(f.output, "%s %d\n", labelColor.Sprint("ID:"), project.ID)
-		_, _ = fmt.Fprintf(f.output, "%s %s\n", labelColor.Sprint("URI:"), fmt.Sprintf("vikunja://projects/%d", project.ID))
+		_, _ = fmt.Fprintf(f.output, "%s %s\n", labelColor.Sprint("URI:"), ProjectURI(project.ID))
 		if project.Description != "" {
 			_, _ = fmt.Fprintf(f.output, "\n%s\n%s\n", labelColor.Sprint("Description:"), project.Description)
 		}
 	} else {
 		_, _ = fmt.Fprintf(f.output, "%s\n\n", project.Title)
 		_, _ = fmt.Fprintf(f.output, "ID: %d\n", project.ID)
-		_, _ = fmt.Fprintf(f.output, "URI: %s\n", fmt.Sprintf("vikunja://projects/%d", project.ID))
+		_, _ = fmt.Fprintf(f.output, "URI: %s\n", ProjectURI(project.ID))
 		if project.Description != "" {
 			_, _ = fmt.Fprintf(f.output, "\nDescription:\n%s\n", project.Description)
 		}
@@ -78,7 +78,7 @@ func (f *Formatter) FormatTasks(tasks []*Task) error {
 	}
 
 	for _, t := range tasks {
-		uri := fmt.Sprintf("vikunja://tasks/%d", t.ID)
+		uri := TaskURI(t.ID)
 		bucket := "-"
 		if len(t.Buckets) > 0 {
 			bucket = t.Buckets[0].Title
@@ -99,7 +99,7 @@ func (f *Formatter) FormatTask(task *Task) error {
 		labelColor := color.New(color.FgYellow)
 		_, _ = fmt.Fprintf(f.output, "%s\n\n", titleColor.Sprint(task.Title))
 		_, _ = fmt.Fprintf(f.output, "%s %d\n", labelColor.Sprint("ID:"), task.ID)
-		_, _ = fmt.Fprintf(f.output, "%s %s\n", labelColor.Sprint("URI:"), fmt.Sprintf("vikunja://tasks/%d", task.ID))
+		_, _ = fmt.Fprintf(f.output, "%s %s\n", labelColor.Sprint("URI:"), TaskURI(task.ID))
 		if task.ProjectID > 0 {
 			_, _ = fmt.Fprintf(f.output, "%s %d\n", labelColor.Sprint("Project ID:"), task.ProjectID)
 		}
@@ -109,7 +109,7 @@ func (f *Formatter) FormatTask(task *Task) error {
 	} else {
 		_, _ = fmt.Fprintf(f.output, "%s\n\n", task.Title)
 		_, _ = fmt.Fprintf(f.output, "ID: %d\n", task.ID)
-		_, _ = fmt.Fprintf(f.output, "URI: %s\n", fmt.Sprintf("vikunja://tasks/%d", task.ID))
+		_, _ = fmt.Fprintf(f.output, "URI: %s\n", TaskURI(task.ID))
 		if task.ProjectID > 0 {
 			_, _ = fmt.Fprintf(f.output, "Project ID: %d\n", task.ProjectID)
 		}
@@ -184,7 +184,7 @@ func (f *Formatter) formatTaskBucketViews(label string, views []TaskViewInfo) {
 //nolint:errcheck
 //revive:disable-next-line:dupl
 func (f *Formatter) FormatTaskWithBuckets(task *Task, bucketInfo *TaskBucketInfo) error {
-	uri := fmt.Sprintf("vikunja://tasks/%d", task.ID)
+	uri := TaskURI(task.ID)
 	if f.useColor {
 		labelColor := color.New(color.FgYellow)
 		_, _ = fmt.Fprintf(f.output, "%s\n\n", color.New(color.FgCyan, color.Bold).Sprint(task.Title))
@@ -0,0 +1,29 @@
+package vikunja
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldUseColor_NonTerminal(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "out.txt"))
+	require.NoError(t, err)
+	t.Cleanup(func() { file.Close() }) //nolint:errcheck
+
+	assert.False(t, ShouldUseColor(&bytes.Buffer{}), "writers without a file descriptor are not terminals")
+	assert.False(t, ShouldUseColor(file), "regular files are not terminals")
+}
+
+func TestShouldUseColor_NoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	assert.False(t, ShouldUseColor(os.Stdout))
+	assert.False(t, ShouldUseColor(&bytes.Buffer{}))
+}
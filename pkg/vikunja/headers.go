@@ -0,0 +1,38 @@
+package vikunja
+
+import (
+	"net/http"
+	"slices"
+)
+
+// reservedHeaders are set by the client itself. Extra headers never replace them, so a
+// misconfigured header cannot break authentication or the encoding of requests.
+var reservedHeaders = []string{"Authorization", "Accept", "Content-Type", "Content-Length", "Host"}
+
+// IsReservedHeader reports whether the client sets the named header itself, which makes it
+// unavailable as an extra header.
+func IsReservedHeader(name string) bool {
+	return slices.Contains(reservedHeaders, http.CanonicalHeaderKey(name))
+}
+
+// headerTransport adds fixed headers to every request, e.g. the credentials a reverse proxy in
+// front of Vikunja requires. Reserved headers are left as the client set them.
+type headerTransport struct {
+	next    http.RoundTripper
+	headers http.Header
+}
+
+// RoundTrip sends a copy of req carrying the extra headers.
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, values := range t.headers {
+		if IsReservedHeader(name) {
+			continue
+		}
+		req.Header.Del(name)
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	return t.next.RoundTrip(req)
+}
@@ -19,6 +19,10 @@ const (
 	OutputFormatJSON     OutputFormat = "json"
 	OutputFormatMarkdown OutputFormat = "markdown"
 	OutputFormatBoth     OutputFormat = "both"
+	// OutputFormatBothStructured returns both formats as one JSON object so consumers can pick one
+	OutputFormatBothStructured OutputFormat = "both-json"
+	// OutputFormatJSONL returns lists as JSON Lines, one compact JSON value per line
+	OutputFormatJSONL OutputFormat = "jsonl"
 )
 
 // MarkdownFormatter formats data as markdown using the Formatter
@@ -43,6 +47,15 @@ func (f *MarkdownFormatter) formatSliceAsMarkdown(v interface{}) (string, error)
 			result += f.formatter.FormatViewAsMarkdown(view)
 		}
 		return result, nil
+	case []ViewOutput:
+		var result string
+		for i, found := range data {
+			if i > 0 {
+				result += "\n---\n\n"
+			}
+			result += f.formatter.FormatProjectAndViewMarkdown(&found.Project, &found.View)
+		}
+		return result, nil
 	default:
 		return "", fmt.Errorf("unsupported slice type for markdown")
 	}
@@ -53,7 +66,7 @@ func (f *MarkdownFormatter) formatPointerAsMarkdown(v interface{}) (string, erro
 	case *Task, *Project, *Bucket, *ProjectView, *ViewTasks, *ViewTasksSummary:
 		return f.formatViaReflect(data)
 	case TaskOutput:
-		return f.formatter.FormatTaskWithBucketsMarkdown(&data.Task, data.Buckets), nil
+		return f.formatter.FormatTaskOutputMarkdown(&data), nil
 	case ViewOutput:
 		return f.formatter.FormatProjectAndViewMarkdown(&data.Project, &data.View), nil
 	default:
@@ -85,7 +98,17 @@ func (f *MarkdownFormatter) formatValueAsMarkdown(v interface{}) (string, error)
 	case ViewTasksSummary:
 		return f.formatter.FormatViewTasksSummaryAsMarkdown(&data), nil
 	case ViewsOutput:
-		return f.formatter.FormatProjectAndViewListMarkdown(&data.Project, data.Views), nil
+		return f.formatter.FormatViewsOutputMarkdown(&data), nil
+	case TaskCounts:
+		return f.formatter.FormatTaskCountsMarkdown(&data), nil
+	case TaskLabelGroups:
+		return f.formatter.FormatTaskLabelGroupsAsMarkdown(&data), nil
+	case BucketTasks:
+		return f.formatter.FormatBucketTasksAsMarkdown(&data), nil
+	case DueReport:
+		return f.formatter.FormatDueReportAsMarkdown(&data), nil
+	case BoardSummary:
+		return f.formatter.FormatBoardSummaryAsMarkdown(&data), nil
 	default:
 		if f.isHandlersProject(data) {
 			return f.formatHandlersProject(data), nil
@@ -103,16 +126,44 @@ func (f *MarkdownFormatter) formatFallbackMarkdown(v interface{}) (string, error
 }
 
 // JSONFormatter formats data as JSON
-type JSONFormatter struct{}
+type JSONFormatter struct {
+	// compact drops the indentation, trading readability for size
+	compact bool
+}
 
 // NewJSONFormatter creates a new JSON formatter
 func NewJSONFormatter() *JSONFormatter {
 	return &JSONFormatter{}
 }
 
+// WithCompact makes the formatter emit JSON without indentation or newlines and returns it.
+func (f *JSONFormatter) WithCompact() *JSONFormatter {
+	f.compact = true
+	return f
+}
+
+// CompactJSON makes the JSON part of formatter compact. A markdown-only formatter is returned unchanged.
+func CompactJSON(formatter OutputFormatter) OutputFormatter {
+	switch f := formatter.(type) {
+	case *JSONFormatter:
+		f.WithCompact()
+	case *BothFormatter:
+		f.jsonFormatter.WithCompact()
+	case *BothStructuredFormatter:
+		f.jsonFormatter.WithCompact()
+	}
+	return formatter
+}
+
 // Format formats data as JSON
 func (f *JSONFormatter) Format(data interface{}) (string, error) {
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+	var jsonData []byte
+	var err error
+	if f.compact {
+		jsonData, err = json.Marshal(data)
+	} else {
+		jsonData, err = json.MarshalIndent(data, "", "  ")
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal JSON: %w", err)
 	}
@@ -130,11 +181,11 @@ func NewMarkdownFormatter() *MarkdownFormatter {
 // Format formats data as markdown based on the data type.
 func (f *MarkdownFormatter) Format(data interface{}) (string, error) {
 	switch v := data.(type) {
-	case []*Task, []*Project, []*Bucket, []*ProjectView:
+	case []*Task, []*Project, []*Bucket, []*ProjectView, []ViewOutput:
 		return f.formatSliceAsMarkdown(v)
 	case *Task, *Project, *Bucket, *ProjectView, *ViewTasks, *ViewTasksSummary, TaskOutput, ViewOutput:
 		return f.formatPointerAsMarkdown(v)
-	case ViewTasksSummary, ViewsOutput:
+	case ViewTasksSummary, ViewsOutput, TaskCounts, TaskLabelGroups, BucketTasks, DueReport, BoardSummary:
 		return f.formatValueAsMarkdown(v)
 	default:
 		if f.isHandlersProject(v) {
@@ -204,6 +255,45 @@ func (f *BothFormatter) Format(data interface{}) (string, error) {
 		markdownOutput, jsonOutput), nil
 }
 
+// BothStructuredFormatter returns both formats as a single JSON object,
+// {"json": ..., "markdown": "..."}, so a consumer can parse the result and pick a representation.
+type BothStructuredFormatter struct {
+	jsonFormatter     *JSONFormatter
+	markdownFormatter *MarkdownFormatter
+}
+
+// structuredOutput is the document BothStructuredFormatter produces
+type structuredOutput struct {
+	JSON     json.RawMessage `json:"json"`
+	Markdown string          `json:"markdown"`
+}
+
+// NewBothStructuredFormatter creates a new formatter that returns both formats in one JSON object
+func NewBothStructuredFormatter() *BothStructuredFormatter {
+	return &BothStructuredFormatter{
+		jsonFormatter:     NewJSONFormatter(),
+		markdownFormatter: NewMarkdownFormatter(),
+	}
+}
+
+// Format formats data as a JSON object holding both its JSON and markdown forms
+func (f *BothStructuredFormatter) Format(data interface{}) (string, error) {
+	jsonOutput, err := f.jsonFormatter.Format(data)
+	if err != nil {
+		return "", err
+	}
+
+	markdownOutput, err := f.markdownFormatter.Format(data)
+	if err != nil {
+		return "", err
+	}
+
+	return f.jsonFormatter.Format(structuredOutput{
+		JSON:     json.RawMessage(jsonOutput),
+		Markdown: markdownOutput,
+	})
+}
+
 // GetFormatter returns the appropriate formatter based on the output format
 func GetFormatter(format OutputFormat) OutputFormatter {
 	switch format {
@@ -213,6 +303,10 @@ func GetFormatter(format OutputFormat) OutputFormatter {
 		return NewMarkdownFormatter()
 	case OutputFormatBoth:
 		return NewBothFormatter()
+	case OutputFormatBothStructured:
+		return NewBothStructuredFormatter()
+	case OutputFormatJSONL:
+		return NewJSONLFormatter()
 	default:
 		return NewJSONFormatter() // Default to JSON
 	}
@@ -0,0 +1,73 @@
+package vikunja
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBothStructuredFormatter_ParsesAsJSON(t *testing.T) {
+	t.Parallel()
+	out, err := GetFormatter(OutputFormatBothStructured).Format(&Task{ID: 42, Title: "Write docs", ProjectID: 7})
+	require.NoError(t, err)
+
+	var combined struct {
+		JSON     Task   `json:"json"`
+		Markdown string `json:"markdown"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &combined))
+	assert.Equal(t, int64(42), combined.JSON.ID)
+	assert.Equal(t, "Write docs", combined.JSON.Title)
+	assert.Contains(t, combined.Markdown, "# Write docs")
+	assert.Contains(t, combined.Markdown, TaskURI(42))
+
+	var keys map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(out), &keys))
+	assert.Len(t, keys, 2)
+	assert.Contains(t, keys, "json")
+	assert.Contains(t, keys, "markdown")
+}
+
+func TestBothStructuredFormatter_Slices(t *testing.T) {
+	t.Parallel()
+	out, err := NewBothStructuredFormatter().Format([]*Project{{ID: 1, Title: "Inbox"}, {ID: 2, Title: "Work"}})
+	require.NoError(t, err)
+
+	var combined struct {
+		JSON     []Project `json:"json"`
+		Markdown string    `json:"markdown"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &combined))
+	assert.Len(t, combined.JSON, 2)
+	assert.Contains(t, combined.Markdown, "Work")
+}
+
+func TestJSONFormatter_Compact(t *testing.T) {
+	t.Parallel()
+	tasks := []*Task{{ID: 1, Title: "Write docs"}, {ID: 2, Title: "Review"}}
+
+	pretty, err := NewJSONFormatter().Format(tasks)
+	require.NoError(t, err)
+	assert.Contains(t, pretty, "\n")
+	assert.Contains(t, pretty, `  "id": 1`)
+
+	compact, err := CompactJSON(GetFormatter(OutputFormatJSON)).Format(tasks)
+	require.NoError(t, err)
+	assert.NotContains(t, compact, "\n")
+	assert.NotContains(t, compact, "  ")
+	assert.Contains(t, compact, `"id":1`)
+	assert.JSONEq(t, pretty, compact)
+}
+
+func TestCompactJSON_BothStructured(t *testing.T) {
+	t.Parallel()
+	out, err := CompactJSON(GetFormatter(OutputFormatBothStructured)).Format(&Task{ID: 42, Title: "Write docs"})
+	require.NoError(t, err)
+	assert.NotContains(t, out, "\n", "newlines of the markdown part must stay escaped")
+	assert.True(t, json.Valid([]byte(out)))
+
+	markdown := NewMarkdownFormatter()
+	assert.Same(t, markdown, CompactJSON(markdown))
+}
@@ -0,0 +1,21 @@
+package vikunja
+
+import "time"
+
+// overdueMarker flags overdue tasks in markdown output
+const overdueMarker = "⚠️ OVERDUE"
+
+// IsOverdue reports whether task is not done and has a due date that has passed.
+// Tasks without a due date, and completed tasks, are never overdue.
+func IsOverdue(task *Task) bool {
+	return isOverdueAt(task, time.Now())
+}
+
+// isOverdueAt reports whether task is overdue at now
+func isOverdueAt(task *Task, now time.Time) bool {
+	if task.Done {
+		return false
+	}
+	due := parseDate(task.DueDate)
+	return !due.IsZero() && due.Before(now)
+}
@@ -0,0 +1,57 @@
+package vikunja
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsOverdue(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		task Task
+		want bool
+	}{
+		{name: "overdue and open", task: Task{DueDate: "2026-03-09T12:00:00Z"}, want: true},
+		{name: "overdue but done", task: Task{DueDate: "2026-03-09T12:00:00Z", Done: true}, want: false},
+		{name: "due in the future", task: Task{DueDate: "2026-03-11T12:00:00Z"}, want: false},
+		{name: "no due date", task: Task{}, want: false},
+		{name: "zero due date", task: Task{DueDate: "0001-01-01T00:00:00Z"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, isOverdueAt(&tt.task, now))
+		})
+	}
+}
+
+func TestIsOverdue_UsesCurrentTime(t *testing.T) {
+	t.Parallel()
+	assert.True(t, IsOverdue(&Task{DueDate: "2000-01-01T00:00:00Z"}))
+	assert.False(t, IsOverdue(&Task{DueDate: "2999-01-01T00:00:00Z"}))
+}
+
+func TestFormatter_FlagsOverdueTasks(t *testing.T) {
+	t.Parallel()
+	f := NewFormatter(false, nil)
+	f.clock = func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+	overdue := &Task{ID: 1, Title: "Late", DueDate: "2026-03-09T12:00:00Z"}
+	done := &Task{ID: 2, Title: "Finished late", DueDate: "2026-03-09T12:00:00Z", Done: true}
+	upcoming := &Task{ID: 3, Title: "Soon", DueDate: "2026-03-11T12:00:00Z"}
+
+	table := f.FormatTasksAsMarkdown([]*Task{overdue, done, upcoming})
+	assert.Contains(t, table, "| 1 | Late | ❌ | 2026-03-09 ⚠️ OVERDUE |")
+	assert.Contains(t, table, "| 2 | Finished late | ✅ | 2026-03-09 |")
+	assert.Contains(t, table, "| 3 | Soon | ❌ | 2026-03-11 |")
+
+	details := f.FormatTaskOutputMarkdown(&TaskOutput{Task: *overdue})
+	assert.Contains(t, details, "- **Due Date**: 2026-03-09 ⚠️ OVERDUE\n")
+	assert.NotContains(t, f.FormatTaskOutputMarkdown(&TaskOutput{Task: *done}), "OVERDUE")
+	assert.NotContains(t, f.FormatTaskOutputMarkdown(&TaskOutput{Task: *upcoming}), "OVERDUE")
+}
@@ -0,0 +1,101 @@
+package vikunja
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// retryBackoff is the wait before the first retry of a request; each further retry waits longer.
+const retryBackoff = 50 * time.Millisecond
+
+// RetryBudget bounds the retries shared by every request sent with one context, so a tool call
+// fanning out many requests cannot multiply its latency by retrying each of them.
+type RetryBudget struct {
+	remaining atomic.Int64
+}
+
+// NewRetryBudget returns a budget allowing n retries in total.
+func NewRetryBudget(n int) *RetryBudget {
+	b := &RetryBudget{}
+	b.remaining.Store(int64(n))
+	return b
+}
+
+// Remaining returns how many retries the budget still allows.
+func (b *RetryBudget) Remaining() int {
+	return int(max(b.remaining.Load(), 0))
+}
+
+// take spends one retry, reporting false when the budget is exhausted.
+func (b *RetryBudget) take() bool {
+	return b.remaining.Add(-1) >= 0
+}
+
+// retryBudgetKey is the context key under which WithRetryBudget stores a budget.
+type retryBudgetKey struct{}
+
+// WithRetryBudget returns a copy of ctx carrying budget. Failed idempotent requests sent with the
+// returned context are retried while the budget lasts; without a budget they are not retried.
+func WithRetryBudget(ctx context.Context, budget *RetryBudget) context.Context {
+	return context.WithValue(ctx, retryBudgetKey{}, budget)
+}
+
+func retryBudgetFrom(ctx context.Context) *RetryBudget {
+	budget, _ := ctx.Value(retryBudgetKey{}).(*RetryBudget)
+	return budget
+}
+
+// retryTransport retries GET requests that failed in transit or with a status suggesting the
+// server may recover, drawing each retry from the budget carried by the request's context.
+type retryTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip sends req, retrying it while it is retryable and the budget allows.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	budget := retryBudgetFrom(req.Context())
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if budget == nil || !retryable(req, resp, err) || !budget.take() {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close() //nolint:errcheck,gosec // the response is discarded for a retry
+		}
+		if err := sleepContext(req.Context(), retryBackoff*time.Duration(attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable reports whether a request's outcome is worth retrying. Only requests without side
+// effects are retried, and never once the request's context is done.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Method != http.MethodGet || req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleepContext waits for d, returning early with the context's error when ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
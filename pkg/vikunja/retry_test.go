@@ -0,0 +1,69 @@
+package vikunja
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RetriesWithinBudget(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		budget       *RetryBudget
+		failures     int64
+		wantRequests int64
+		wantErr      bool
+	}{
+		{"no budget", nil, 1, 1, true},
+		{"recovers within budget", NewRetryBudget(3), 2, 3, false},
+		{"budget exhausted", NewRetryBudget(1), 5, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var requests atomic.Int64
+			client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if requests.Add(1) <= tt.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprint(w, `{"message":"unavailable"}`) //nolint:errcheck
+					return
+				}
+				fmt.Fprint(w, `{"id":3,"title":"Work"}`) //nolint:errcheck
+			})
+
+			ctx := t.Context()
+			if tt.budget != nil {
+				ctx = WithRetryBudget(ctx, tt.budget)
+			}
+			_, err := client.GetProject(ctx, 3)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	t.Parallel()
+	get, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://vikunja.test/api/v1/projects", nil)
+	require.NoError(t, err)
+	put, err := http.NewRequestWithContext(t.Context(), http.MethodPut, "http://vikunja.test/api/v1/projects", nil)
+	require.NoError(t, err)
+
+	assert.True(t, retryable(get, nil, fmt.Errorf("connection reset")))
+	assert.True(t, retryable(get, &http.Response{StatusCode: http.StatusBadGateway}, nil))
+	assert.False(t, retryable(get, &http.Response{StatusCode: http.StatusNotFound}, nil))
+	assert.False(t, retryable(get, nil, context.DeadlineExceeded))
+	assert.False(t, retryable(put, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil), "writes are never retried")
+}
@@ -0,0 +1,67 @@
+package vikunja
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// traceIDKey is the context key under which WithTraceID stores a trace ID.
+type traceIDKey struct{}
+
+// WithTraceID returns a copy of ctx carrying traceID. Every request the client sends with the
+// returned context is logged with it, so a tool call can be correlated with its Vikunja requests.
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, traceID)
+}
+
+// TraceID returns the trace ID carried by ctx, or "" when there is none.
+func TraceID(ctx context.Context) string {
+	id, _ := ctx.Value(traceIDKey{}).(string)
+	return id
+}
+
+// instrumentedTransport logs every request sent to Vikunja at debug level and reports its
+// latency to an optional observer.
+type instrumentedTransport struct {
+	next     http.RoundTripper
+	logger   *slog.Logger
+	observer RequestObserver
+}
+
+// RoundTrip sends req and logs its method, path, outcome and duration along with the trace ID
+// of the request's context.
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+
+	status := 0
+	if err == nil {
+		status = resp.StatusCode
+	}
+	if t.observer != nil {
+		t.observer.ObserveRequest(req.Method, status, duration)
+	}
+
+	logger := t.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("duration", duration),
+	}
+	if id := TraceID(req.Context()); id != "" {
+		attrs = append(attrs, slog.String("trace_id", id))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	} else {
+		attrs = append(attrs, slog.Int("status", status))
+	}
+	logger.LogAttrs(req.Context(), slog.LevelDebug, "vikunja request", attrs...)
+	return resp, err
+}
@@ -21,6 +21,24 @@ const (
 	ViewKindTable  ViewKind = "table"
 )
 
+// ViewKinds lists the kinds of view a project can have.
+func ViewKinds() []ViewKind {
+	return []ViewKind{ViewKindList, ViewKindKanban, ViewKindGantt, ViewKindTable}
+}
+
+// DefaultView returns the view Vikunja opens a project with: the one with the lowest position, the
+// earliest listed winning ties. The API has no default-view flag, so the order of views is what
+// the web UI goes by. It returns nil when views is empty.
+func DefaultView(views []*ProjectView) *ProjectView {
+	var chosen *ProjectView
+	for _, v := range views {
+		if v != nil && (chosen == nil || v.Position < chosen.Position) {
+			chosen = v
+		}
+	}
+	return chosen
+}
+
 // BucketConfigurationMode represents how buckets are configured in a view.
 type BucketConfigurationMode = string
 
@@ -31,6 +49,11 @@ const (
 	BucketConfigurationModeFilter BucketConfigurationMode = "filter"
 )
 
+// BucketConfigurationModes lists the ways a view's buckets can be configured.
+func BucketConfigurationModes() []BucketConfigurationMode {
+	return []BucketConfigurationMode{BucketConfigurationModeNone, BucketConfigurationModeManual, BucketConfigurationModeFilter}
+}
+
 // ProjectView represents a view within a Vikunja project.
 type ProjectView = models.ModelsProjectView
 
@@ -59,8 +82,9 @@ type TaskBucketInfo struct {
 
 // BucketTasks represents a bucket and its associated tasks.
 type BucketTasks struct {
-	Bucket Bucket  `json:"bucket"`
-	Tasks  []*Task `json:"tasks"`
+	Bucket       Bucket  `json:"bucket"`
+	Tasks        []*Task `json:"tasks"`
+	IsDoneBucket bool    `json:"is_done_bucket,omitempty"`
 }
 
 // ViewTasks represents tasks organized by buckets within a view.
@@ -76,10 +100,23 @@ type ViewTasksResponse struct {
 	Tasks   []*Task   `json:"tasks,omitempty"`
 }
 
+// Label represents a Vikunja label.
+type Label = models.ModelsLabel
+
+// User represents a Vikunja user, e.g. a task assignee.
+type User = models.UserUser
+
 // TaskOutput represents a task with its associated bucket information.
 type TaskOutput struct {
-	Task    Task            `json:"task"`
-	Buckets *TaskBucketInfo `json:"buckets,omitempty"`
+	Task      Task            `json:"task"`
+	Buckets   *TaskBucketInfo `json:"buckets,omitempty"`
+	Labels    []*Label        `json:"labels,omitempty"`
+	Assignees []*User         `json:"assignees,omitempty"`
+	Subtasks  []*Task         `json:"subtasks,omitempty"`
+	// Comments summarizes the task's discussion, when asked for
+	Comments *CommentSummary `json:"comments,omitempty"`
+	// Subscribed reports whether the user watches the task, when Vikunja said so
+	Subscribed *bool `json:"subscribed,omitempty"`
 }
 
 // ViewOutput represents a project with a single view.
@@ -88,17 +125,33 @@ type ViewOutput struct {
 	View    ProjectView `json:"view"`
 }
 
-// ViewsOutput represents a project with all its views.
+// ViewsOutput represents a project with all its views, and optionally the buckets of its kanban views.
 type ViewsOutput struct {
 	Project Project        `json:"project"`
 	Views   []*ProjectView `json:"views"`
+	Buckets []ViewBuckets  `json:"buckets,omitempty"`
+}
+
+// ViewBuckets holds the buckets of one kanban view.
+type ViewBuckets struct {
+	ViewID    int64     `json:"view_id"`
+	ViewTitle string    `json:"view_title"`
+	Buckets   []*Bucket `json:"buckets"`
 }
 
-// TaskSummary provides a minimal representation of a task.
+// TaskSummary provides a minimal representation of a task. Only ID and Title are set by
+// default; the other fields are set when a caller selects them.
 type TaskSummary struct {
-	ID    int64  `json:"id"`
-	Title string `json:"title"`
-	URI   string `json:"uri"`
+	ID          int64    `json:"id,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	URI         string   `json:"uri,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Done        *bool    `json:"done,omitempty"`
+	DueDate     string   `json:"due_date,omitempty"`
+	StartDate   string   `json:"start_date,omitempty"`
+	EndDate     string   `json:"end_date,omitempty"`
+	Priority    *int64   `json:"priority,omitempty"`
+	PercentDone *float64 `json:"percent_done,omitempty"`
 }
 
 // BucketSummary provides a minimal representation of a bucket.
@@ -119,3 +172,112 @@ type ViewTasksSummary struct {
 	ViewTitle string               `json:"view_title"`
 	Buckets   []BucketTasksSummary `json:"buckets,omitempty"`
 }
+
+// Subscription is a user's subscription to notifications about a task or project.
+type Subscription = models.ModelsSubscription
+
+// Webhook represents a webhook target registered on a Vikunja project.
+type Webhook = models.ModelsWebhook
+
+// Webhook event names Vikunja can deliver to a project webhook.
+const (
+	WebhookEventTaskCreated           = "task.created"
+	WebhookEventTaskUpdated           = "task.updated"
+	WebhookEventTaskDeleted           = "task.deleted"
+	WebhookEventTaskAssigneeCreated   = "task.assignee.created"
+	WebhookEventTaskAssigneeDeleted   = "task.assignee.deleted"
+	WebhookEventTaskCommentCreated    = "task.comment.created"
+	WebhookEventTaskCommentEdited     = "task.comment.edited"
+	WebhookEventTaskCommentDeleted    = "task.comment.deleted"
+	WebhookEventTaskAttachmentCreated = "task.attachment.created"
+	WebhookEventTaskAttachmentDeleted = "task.attachment.deleted"
+	WebhookEventTaskRelationCreated   = "task.relation.created"
+	WebhookEventTaskRelationDeleted   = "task.relation.deleted"
+	WebhookEventProjectUpdated        = "project.updated"
+	WebhookEventProjectDeleted        = "project.deleted"
+	WebhookEventProjectSharedUser     = "project.shared.user"
+	WebhookEventProjectSharedTeam     = "project.shared.team"
+)
+
+// WebhookEvents lists every event name accepted when registering a webhook.
+func WebhookEvents() []string {
+	return []string{
+		WebhookEventTaskCreated,
+		WebhookEventTaskUpdated,
+		WebhookEventTaskDeleted,
+		WebhookEventTaskAssigneeCreated,
+		WebhookEventTaskAssigneeDeleted,
+		WebhookEventTaskCommentCreated,
+		WebhookEventTaskCommentEdited,
+		WebhookEventTaskCommentDeleted,
+		WebhookEventTaskAttachmentCreated,
+		WebhookEventTaskAttachmentDeleted,
+		WebhookEventTaskRelationCreated,
+		WebhookEventTaskRelationDeleted,
+		WebhookEventProjectUpdated,
+		WebhookEventProjectDeleted,
+		WebhookEventProjectSharedUser,
+		WebhookEventProjectSharedTeam,
+	}
+}
+
+// Link share rights accepted when sharing a project by link.
+const (
+	ShareRightRead      = "read"
+	ShareRightReadWrite = "read_write"
+	ShareRightAdmin     = "admin"
+)
+
+// ShareRights lists the rights a link share can be created with, ordered by Vikunja permission level.
+func ShareRights() []string {
+	return []string{ShareRightRead, ShareRightReadWrite, ShareRightAdmin}
+}
+
+// LinkShare is a public link granting access to a project.
+// The generated model cannot decode the permission field, so this mirrors the wire format directly.
+type LinkShare struct {
+	ID          int64                   `json:"id,omitempty"`
+	Hash        string                  `json:"hash,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Permission  models.ModelsPermission `json:"permission"`
+	SharingType int64                   `json:"sharing_type,omitempty"`
+	Created     string                  `json:"created,omitempty"`
+	Updated     string                  `json:"updated,omitempty"`
+}
+
+// SavedFilter is a stored task query. Vikunja lists saved filters among the projects
+// as pseudo-projects with negative IDs; see SavedFilterProjectID.
+type SavedFilter struct {
+	ID          int64  `json:"id"`
+	ProjectID   int64  `json:"project_id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Filter      string `json:"filter,omitempty"`
+	IsFavorite  bool   `json:"is_favorite,omitempty"`
+}
+
+// TaskCounts holds the number of tasks in a project. Done and Open are nil when not counted.
+type TaskCounts struct {
+	ProjectID    int64  `json:"project_id"`
+	ProjectTitle string `json:"project_title"`
+	Total        int64  `json:"total"`
+	Done         *int64 `json:"done,omitempty"`
+	Open         *int64 `json:"open,omitempty"`
+}
+
+// UnlabeledGroup is the title of the TaskLabelGroup holding tasks without labels.
+const UnlabeledGroup = "(unlabeled)"
+
+// TaskLabelGroup holds the tasks carrying one label. LabelID is 0 for the UnlabeledGroup.
+type TaskLabelGroup struct {
+	LabelID int64         `json:"label_id,omitempty"`
+	Label   string        `json:"label"`
+	Tasks   []TaskSummary `json:"tasks"`
+}
+
+// TaskLabelGroups holds a project's tasks grouped by label.
+type TaskLabelGroups struct {
+	ProjectID    int64            `json:"project_id"`
+	ProjectTitle string           `json:"project_title"`
+	Groups       []TaskLabelGroup `json:"groups"`
+}
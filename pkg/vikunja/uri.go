@@ -0,0 +1,93 @@
+package vikunja
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// uriScheme prefixes every resource URI the server hands out.
+const uriScheme = "vikunja://"
+
+// TaskURI returns the canonical URI of a task, e.g. vikunja://task/42.
+func TaskURI(id int64) string {
+	return fmt.Sprintf("%stask/%d", uriScheme, id)
+}
+
+// ProjectURI returns the canonical URI of a project, e.g. vikunja://project/7.
+func ProjectURI(id int64) string {
+	return fmt.Sprintf("%sproject/%d", uriScheme, id)
+}
+
+// ViewURI returns the canonical URI of a project view, e.g. vikunja://project/7/view/12.
+func ViewURI(projectID, viewID int64) string {
+	return fmt.Sprintf("%s/view/%d", ProjectURI(projectID), viewID)
+}
+
+// ResourceKind names the kind of resource a URI refers to.
+type ResourceKind string
+
+const (
+	// ResourceTask is a task URI, vikunja://task/{id}.
+	ResourceTask ResourceKind = "task"
+	// ResourceProject is a project URI, vikunja://project/{id}.
+	ResourceProject ResourceKind = "project"
+	// ResourceView is a project view URI, vikunja://project/{project_id}/view/{id}.
+	ResourceView ResourceKind = "view"
+)
+
+// ResourceRef identifies the resource a URI refers to. ProjectID is only set for views.
+type ResourceRef struct {
+	Kind      ResourceKind
+	ID        int64
+	ProjectID int64
+}
+
+// ParseURI parses a URI built by TaskURI, ProjectURI or ViewURI back into the resource it names.
+func ParseURI(uri string) (ResourceRef, error) {
+	path, ok := strings.CutPrefix(uri, uriScheme)
+	if !ok {
+		return ResourceRef{}, fmt.Errorf("invalid URI %q: must start with %s", uri, uriScheme)
+	}
+
+	parts := strings.Split(path, "/")
+	switch len(parts) {
+	case 2:
+		return parseResourceURI(uri, ResourceKind(parts[0]), parts[1])
+	case 4:
+		if parts[0] == string(ResourceProject) && parts[2] == string(ResourceView) {
+			return parseViewURI(uri, parts[1], parts[3])
+		}
+	}
+	return ResourceRef{}, invalidURIError(uri)
+}
+
+// parseResourceURI parses a task or project URI. Project IDs may be negative,
+// as pseudo projects such as saved filters are, but never zero.
+func parseResourceURI(uri string, kind ResourceKind, segment string) (ResourceRef, error) {
+	id, err := strconv.ParseInt(segment, 10, 64)
+	switch {
+	case kind != ResourceTask && kind != ResourceProject:
+		return ResourceRef{}, invalidURIError(uri)
+	case err != nil || id == 0 || (kind == ResourceTask && id < 0):
+		return ResourceRef{}, fmt.Errorf("invalid URI %q: %q is not a valid %s ID", uri, segment, kind)
+	}
+	return ResourceRef{Kind: kind, ID: id}, nil
+}
+
+// parseViewURI parses the project and view segments of a view URI.
+func parseViewURI(uri, projectSegment, viewSegment string) (ResourceRef, error) {
+	project, err := parseResourceURI(uri, ResourceProject, projectSegment)
+	if err != nil {
+		return ResourceRef{}, err
+	}
+	viewID, err := strconv.ParseInt(viewSegment, 10, 64)
+	if err != nil || viewID <= 0 {
+		return ResourceRef{}, fmt.Errorf("invalid URI %q: %q is not a valid view ID", uri, viewSegment)
+	}
+	return ResourceRef{Kind: ResourceView, ID: viewID, ProjectID: project.ID}, nil
+}
+
+func invalidURIError(uri string) error {
+	return fmt.Errorf("invalid URI %q: expected %s, %s or %s", uri, TaskURI(123), ProjectURI(1), ViewURI(1, 2))
+}
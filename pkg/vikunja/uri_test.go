@@ -0,0 +1,81 @@
+package vikunja
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURIs_CanonicalForms(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "vikunja://task/42", TaskURI(42))
+	assert.Equal(t, "vikunja://project/7", ProjectURI(7))
+	assert.Equal(t, "vikunja://project/7/view/12", ViewURI(7, 12))
+}
+
+func TestFormatter_MarkdownUsesCanonicalURIs(t *testing.T) {
+	t.Parallel()
+	f := NewFormatter(false, nil)
+
+	task := f.FormatTaskAsMarkdown(&Task{ID: 42, Title: "Write docs", ProjectID: 7})
+	assert.Contains(t, task, "[vikunja://task/42](vikunja://task/42)")
+	assert.Contains(t, task, "[7](vikunja://project/7)")
+
+	view := f.FormatViewAsMarkdown(&ProjectView{ID: 12, ProjectID: 7, Title: "Kanban"})
+	assert.Contains(t, view, "[vikunja://project/7/view/12](vikunja://project/7/view/12)")
+}
+
+func TestParseURI(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		uri  string
+		want ResourceRef
+	}{
+		{uri: "vikunja://task/123", want: ResourceRef{Kind: ResourceTask, ID: 123}},
+		{uri: "vikunja://project/1", want: ResourceRef{Kind: ResourceProject, ID: 1}},
+		{uri: "vikunja://project/-2", want: ResourceRef{Kind: ResourceProject, ID: -2}},
+		{uri: "vikunja://project/1/view/2", want: ResourceRef{Kind: ResourceView, ID: 2, ProjectID: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseURI(tt.uri)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseURI_RoundTrips(t *testing.T) {
+	t.Parallel()
+	for _, uri := range []string{TaskURI(42), ProjectURI(7), ViewURI(7, 12)} {
+		_, err := ParseURI(uri)
+		require.NoError(t, err, uri)
+	}
+}
+
+func TestParseURI_Invalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		uri     string
+		wantErr string
+	}{
+		{uri: "https://vikunja.example.com/tasks/1", wantErr: "must start with vikunja://"},
+		{uri: "vikunja://tasks/1", wantErr: "expected vikunja://task/123"},
+		{uri: "vikunja://task/abc", wantErr: `"abc" is not a valid task ID`},
+		{uri: "vikunja://task/-1", wantErr: `"-1" is not a valid task ID`},
+		{uri: "vikunja://project/0", wantErr: `"0" is not a valid project ID`},
+		{uri: "vikunja://project/1/view/x", wantErr: `"x" is not a valid view ID`},
+		{uri: "vikunja://project/1/bucket/2", wantErr: "expected vikunja://task/123"},
+		{uri: "vikunja://task/1/", wantErr: "expected vikunja://task/123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			t.Parallel()
+			_, err := ParseURI(tt.uri)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}